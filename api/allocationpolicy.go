@@ -0,0 +1,11 @@
+package api
+
+// AllocationPolicy is the policy for allocating surplus power among loadpoints. Valid values are priority, fair and soc
+type AllocationPolicy int
+
+//go:generate go tool enumer -type AllocationPolicy -trimprefix Allocation -transform=lower -text
+const (
+	AllocationPriority AllocationPolicy = iota // strict loadpoint priority
+	AllocationFair                             // equal share among loadpoints of same priority
+	AllocationSoc                              // loadpoints with higher remaining energy first among loadpoints of same priority
+)
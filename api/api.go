@@ -7,7 +7,7 @@ import (
 	"time"
 )
 
-//go:generate go tool mockgen -package api -destination mock.go github.com/evcc-io/evcc/api Charger,ChargeState,CurrentLimiter,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff
+//go:generate go tool mockgen -package api -destination mock.go github.com/evcc-io/evcc/api Charger,ChargeState,CurrentLimiter,Indicator,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff
 
 // Meter provides total active power in W
 type Meter interface {
@@ -59,6 +59,11 @@ type MaxACPowerGetter interface {
 	MaxACPower() float64
 }
 
+// ProductionLimiter limits the inverter's AC production power in W
+type ProductionLimiter interface {
+	SetProductionLimit(power float64) error
+}
+
 // ChargeState provides current charging status
 type ChargeState interface {
 	Status() (ChargeStatus, error)
@@ -110,6 +115,11 @@ type Diagnosis interface {
 	Diagnose()
 }
 
+// DiagnosticValues provides device status values like firmware version or signal strength
+type DiagnosticValues interface {
+	DiagnosticValues() (map[string]any, error)
+}
+
 // ChargeTimer provides current charge cycle duration
 type ChargeTimer interface {
 	ChargeDuration() (time.Duration, error)
@@ -120,11 +130,32 @@ type ChargeRater interface {
 	ChargedEnergy() (float64, error)
 }
 
+// ChargerConfigReader reads the charger's device configuration
+type ChargerConfigReader interface {
+	ChargerConfig() (map[string]string, error)
+}
+
+// ChargerConfigWriter writes a single charger device configuration value
+type ChargerConfigWriter interface {
+	SetChargerConfig(key, value string) error
+}
+
 // Identifier identifies a vehicle and is implemented by the charger
 type Identifier interface {
 	Identify() (string, error)
 }
 
+// ISO 15118 Plug & Charge certificate types
+const (
+	CertificateV2GRoot = "V2GRootCertificate" // V2G root certificate for validating the charger's TLS certificate
+	CertificateMORoot  = "MORootCertificate"  // mobility operator root certificate for validating contract certificates
+)
+
+// CertificateInstaller installs ISO 15118 Plug & Charge certificates and is implemented by the charger
+type CertificateInstaller interface {
+	InstallCertificate(typ, pem string) error
+}
+
 // Authorizer authorizes a charging session by supplying RFID credentials
 type Authorizer interface {
 	Authorize(key string) error
@@ -196,6 +227,18 @@ type ChargeController interface {
 	ChargeEnable(bool) error
 }
 
+// Indication is a charger LED or display indication
+type Indication struct {
+	Color      string `json:"color,omitempty"`      // hex rgb color, e.g. #00ff00
+	Brightness int    `json:"brightness,omitempty"` // 0-100%
+	Text       string `json:"text,omitempty"`
+}
+
+// Indicator sets the charger's LED color/brightness or display text
+type Indicator interface {
+	Indicate(Indication) error
+}
+
 // Resurrector provides wakeup calls to the vehicle with an API call or a CP interrupt from the charger
 type Resurrector interface {
 	WakeUp() error
@@ -1,6 +1,6 @@
 package api
 
-// BatteryMode is the home battery operation mode. Valid values are normal, locked and charge
+// BatteryMode is the home battery operation mode. Valid values are normal, locked, charge and discharge
 type BatteryMode int
 
 //go:generate go tool enumer -type BatteryMode -trimprefix Battery -transform=lower
@@ -9,4 +9,5 @@ const (
 	BatteryNormal
 	BatteryHold
 	BatteryCharge
+	BatteryDischarge
 )
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/evcc-io/evcc/api (interfaces: Charger,ChargeState,CurrentLimiter,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff)
+// Source: github.com/evcc-io/evcc/api (interfaces: Charger,ChargeState,CurrentLimiter,Indicator,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff)
 //
 // Generated by this command:
 //
-//	mockgen -package api -destination mock.go github.com/evcc-io/evcc/api Charger,ChargeState,CurrentLimiter,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff
+//	mockgen -package api -destination mock.go github.com/evcc-io/evcc/api Charger,ChargeState,CurrentLimiter,Indicator,CurrentGetter,PhaseSwitcher,PhaseGetter,FeatureDescriber,Identifier,Meter,MeterEnergy,PhaseCurrents,Vehicle,ChargeRater,Battery,BatteryController,BatterySocLimiter,Circuit,Tariff
 //
 
 // Package api is a generated GoMock package.
@@ -176,6 +176,44 @@ func (mr *MockCurrentLimiterMockRecorder) GetMinMaxCurrent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMinMaxCurrent", reflect.TypeOf((*MockCurrentLimiter)(nil).GetMinMaxCurrent))
 }
 
+// MockIndicator is a mock of Indicator interface.
+type MockIndicator struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndicatorMockRecorder
+	isgomock struct{}
+}
+
+// MockIndicatorMockRecorder is the mock recorder for MockIndicator.
+type MockIndicatorMockRecorder struct {
+	mock *MockIndicator
+}
+
+// NewMockIndicator creates a new mock instance.
+func NewMockIndicator(ctrl *gomock.Controller) *MockIndicator {
+	mock := &MockIndicator{ctrl: ctrl}
+	mock.recorder = &MockIndicatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndicator) EXPECT() *MockIndicatorMockRecorder {
+	return m.recorder
+}
+
+// Indicate mocks base method.
+func (m *MockIndicator) Indicate(arg0 Indication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Indicate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Indicate indicates an expected call of Indicate.
+func (mr *MockIndicatorMockRecorder) Indicate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Indicate", reflect.TypeOf((*MockIndicator)(nil).Indicate), arg0)
+}
+
 // MockCurrentGetter is a mock of CurrentGetter interface.
 type MockCurrentGetter struct {
 	ctrl     *gomock.Controller
@@ -8,3 +8,31 @@ type RepeatingPlanStruct struct {
 	Precondition int64  `json:"precondition"` // precondition duration in seconds
 	Active       bool   `json:"active"`       // active flag
 }
+
+// MaintenanceStruct is the battery maintenance policy for vehicles parked for a longer period
+type MaintenanceStruct struct {
+	MinSoc int   `json:"minSoc"` // top-up below this soc
+	MaxSoc int   `json:"maxSoc"` // no charging above this soc
+	After  int64 `json:"after"`  // idle duration in seconds before the policy applies
+	Active bool  `json:"active"` // active flag
+}
+
+// Departure modes
+const (
+	DepartureModeSuggest = "suggest" // publish detected departure times for the ui to propose plans
+	DepartureModeAuto    = "auto"    // plan charging for the detected departure times
+)
+
+// DepartureStruct configures the use of the vehicle's typical departure times
+type DepartureStruct struct {
+	Mode string `json:"mode"` // suggest or auto, empty to disable
+	Soc  int    `json:"soc"`  // target soc of automatic plans
+}
+
+// DepartureTimeStruct is a typical departure time detected from the vehicle's plug-out history
+type DepartureTimeStruct struct {
+	Weekday int    `json:"weekday"` // 0-6 (Sunday-Saturday)
+	Time    string `json:"time"`    // HH:MM
+	Tz      string `json:"tz"`      // timezone in IANA format
+	Samples int    `json:"samples"` // number of departures the time is based on
+}
@@ -27,7 +27,8 @@ export async function updateAuthStatus() {
     }
     if (res.status === 200) {
       auth.configured = true;
-      auth.loggedIn = res.data === true;
+      // role of the logged in user, empty if not logged in
+      auth.loggedIn = typeof res.data === "string" && res.data !== "";
     }
     if (res.status === 403) {
       auth.configured = true;
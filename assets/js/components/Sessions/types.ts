@@ -12,6 +12,8 @@ export interface Session {
   chargeDuration: number;
   solarPercentage: number;
   price: number | null;
+  gridPrice?: number | null;
+  solarPrice?: number | null;
   pricePerKWh: number | null;
   co2PerKWh?: number | null;
 }
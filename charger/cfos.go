@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
@@ -213,3 +214,46 @@ func (wb *CfosPowerBrain) Identify() (string, error) {
 
 	return bytesAsString(b), nil
 }
+
+// cfosConfigRegisters are the configuration registers kept in charger configuration snapshots
+var cfosConfigRegisters = map[string]uint16{
+	"meter":       cfosRegMeter,
+	"meterFlags":  cfosRegMeterFlags,
+	"solarEnable": cfosRegSolarEnabled,
+}
+
+var _ api.ChargerConfigReader = (*CfosPowerBrain)(nil)
+
+// ChargerConfig implements the api.ChargerConfigReader interface
+func (wb *CfosPowerBrain) ChargerConfig() (map[string]string, error) {
+	res := make(map[string]string, len(cfosConfigRegisters))
+
+	for key, reg := range cfosConfigRegisters {
+		b, err := wb.conn.ReadHoldingRegisters(reg, 1)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		res[key] = strconv.Itoa(int(binary.BigEndian.Uint16(b)))
+	}
+
+	return res, nil
+}
+
+var _ api.ChargerConfigWriter = (*CfosPowerBrain)(nil)
+
+// SetChargerConfig implements the api.ChargerConfigWriter interface
+func (wb *CfosPowerBrain) SetChargerConfig(key, value string) error {
+	reg, ok := cfosConfigRegisters[key]
+	if !ok {
+		return fmt.Errorf("invalid key: %s", key)
+	}
+
+	u, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return err
+	}
+
+	_, err = wb.conn.WriteSingleRegister(reg, uint16(u))
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	meter "github.com/evcc-io/evcc/meter/measurement"
 	"github.com/evcc-io/evcc/plugin"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/diagnostics"
 )
 
 // Charger is an api.Charger implementation with configurable getters and setters.
@@ -46,6 +47,9 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 		return nil, err
 	}
 
+	// collect plugin diagnostics
+	ctx, diag := diagnostics.WithCollector(ctx)
+
 	status, err := cc.Status.StringGetter(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("status: %w", err)
@@ -137,7 +141,10 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 		return nil, err
 	}
 
-	return decorateCustom(c, maxcurrentmillis, identify, phases1p3p, wakeup, soc, limitsoc, powerG, energyG, currentsG, voltagesG), nil
+	res := decorateCustom(c, maxcurrentmillis, identify, phases1p3p, wakeup, soc, limitsoc, powerG, energyG, currentsG, voltagesG)
+	diag.Register(res)
+
+	return res, nil
 }
 
 // NewConfigurable creates a new charger
@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -710,6 +712,35 @@ func (c *Easee) TotalEnergy() (float64, error) {
 	return c.totalEnergy, nil
 }
 
+var _ api.Indicator = (*Easee)(nil)
+
+// Indicate implements the api.Indicator interface. Only LED strip brightness is supported.
+// The setting is not confirmed by the charger to avoid blocking the loadpoint.
+func (c *Easee) Indicate(ind api.Indication) error {
+	if ind.Brightness == 0 {
+		return nil
+	}
+
+	brightness := min(ind.Brightness, 100)
+	data := easee.ChargerSettings{
+		LedStripBrightness: &brightness,
+	}
+
+	uri := fmt.Sprintf("%s/chargers/%s/settings", easee.API, c.charger)
+
+	resp, err := c.Post(uri, request.JSONContent, request.MarshalJSON(data))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("invalid status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
 var _ api.PhaseSwitcher = (*Easee)(nil)
 
 // Phases1p3p implements the api.PhaseSwitcher interface
@@ -804,6 +835,74 @@ func (c *Easee) Identify() (string, error) {
 	return c.rfid, nil
 }
 
+var _ api.ChargerConfigReader = (*Easee)(nil)
+
+// ChargerConfig implements the api.ChargerConfigReader interface
+func (c *Easee) ChargerConfig() (map[string]string, error) {
+	var res map[string]any
+	uri := fmt.Sprintf("%s/chargers/%s/config", easee.API, c.charger)
+	if err := c.GetJSON(uri, &res); err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]string, len(res))
+	for k, v := range res {
+		switch v.(type) {
+		case nil, map[string]any, []any:
+			continue
+		default:
+			config[k] = fmt.Sprint(v)
+		}
+	}
+
+	return config, nil
+}
+
+// easeeConfigSettings are the writable configuration keys and their setting type.
+// Enabled state, phase mode and currents are controlled by the loadpoint and not restored.
+var easeeConfigSettings = map[string]reflect.Kind{
+	"authorizationRequired":        reflect.Bool,
+	"remoteStartRequired":          reflect.Bool,
+	"smartButtonEnabled":           reflect.Bool,
+	"lockCablePermanently":         reflect.Bool,
+	"enableIdleCurrent":            reflect.Bool,
+	"localPreAuthorizeEnabled":     reflect.Bool,
+	"localAuthorizeOfflineEnabled": reflect.Bool,
+	"allowOfflineTxForUnknownId":   reflect.Bool,
+	"offlineChargingMode":          reflect.Int,
+	"ledStripBrightness":           reflect.Int,
+}
+
+var _ api.ChargerConfigWriter = (*Easee)(nil)
+
+// SetChargerConfig implements the api.ChargerConfigWriter interface
+func (c *Easee) SetChargerConfig(key, value string) error {
+	kind, ok := easeeConfigSettings[key]
+	if !ok {
+		return fmt.Errorf("not writable: %s", key)
+	}
+
+	var (
+		val any
+		err error
+	)
+
+	switch kind {
+	case reflect.Bool:
+		val, err = strconv.ParseBool(value)
+	default:
+		val, err = strconv.Atoi(value)
+	}
+	if err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("%s/chargers/%s/settings", easee.API, c.charger)
+	_, err = c.postJSONAndWait(uri, map[string]any{key: val})
+
+	return err
+}
+
 // Set smart charging status to update the chargers led (smart=blue, fast=white)
 func (c *Easee) updateSmartCharging() {
 	if c.lp == nil {
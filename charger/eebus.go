@@ -605,15 +605,30 @@ func (c *EEBus) Identify() (string, error) {
 		return "", nil
 	}
 
-	if identification, err := c.uc.EvCC.Identifications(evEntity); err == nil && len(identification) > 0 {
-		// return the first identification for now
-		// later this could be multiple, e.g. MAC Address and PCID
-		return identification[0].Value, nil
+	if identification, err := c.uc.EvCC.Identifications(evEntity); err == nil {
+		return evccID(identification), nil
 	}
 
 	return "", nil
 }
 
+// evccID returns the ISO 15118 EVCCID (the vehicle's MAC address) if available, otherwise the first identification
+func evccID(identification []ucapi.IdentificationItem) string {
+	for _, typ := range []model.IdentificationTypeType{model.IdentificationTypeTypeEui48, model.IdentificationTypeTypeEui64} {
+		for _, id := range identification {
+			if id.ValueType == typ && id.Value != "" {
+				return id.Value
+			}
+		}
+	}
+
+	if len(identification) > 0 {
+		return identification[0].Value
+	}
+
+	return ""
+}
+
 var _ api.Battery = (*EEBus)(nil)
 
 // Soc implements the api.Battery interface
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	ucapi "github.com/enbility/eebus-go/usecases/api"
 	evcemuc "github.com/enbility/eebus-go/usecases/cem/evcem"
 	"github.com/enbility/eebus-go/usecases/mocks"
 	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
 	"github.com/evcc-io/evcc/server/eebus"
 	"github.com/evcc-io/evcc/util"
 	"github.com/stretchr/testify/assert"
@@ -265,3 +267,19 @@ func TestEEBusCurrentPower_Elli(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 4002.0, power)
 }
+
+func TestEEBusEvccID(t *testing.T) {
+	for _, tc := range []struct {
+		ids []ucapi.IdentificationItem
+		res string
+	}{
+		{nil, ""},
+		{[]ucapi.IdentificationItem{{Value: "rfid", ValueType: model.IdentificationTypeTypeUserrfidtag}}, "rfid"},
+		{[]ucapi.IdentificationItem{
+			{Value: "rfid", ValueType: model.IdentificationTypeTypeUserrfidtag},
+			{Value: "0123456789ab", ValueType: model.IdentificationTypeTypeEui48},
+		}, "0123456789ab"},
+	} {
+		assert.Equal(t, tc.res, evccID(tc.ids))
+	}
+}
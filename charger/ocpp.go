@@ -46,6 +46,7 @@ type OCPP struct {
 
 	stackLevelZero      bool
 	profileKindRelative bool
+	txProfile           bool
 	lp                  loadpoint.API
 }
 
@@ -75,7 +76,10 @@ func NewOCPPFromConfig(ctx context.Context, other map[string]interface{}) (api.C
 		ForcePowerCtrl      bool
 		StackLevelZero      *bool
 		ProfileKindRelative bool
+		TxProfile           bool // use TxProfile for running transactions
 		RemoteStart         bool
+		IdTags              []string // local authorization list
+		RejectUnknown       bool     // reject id tags not on the authorization list
 	}{
 		Connector:      1,
 		MeterInterval:  10 * time.Second,
@@ -102,6 +106,9 @@ func NewOCPPFromConfig(ctx context.Context, other map[string]interface{}) (api.C
 		return nil, api.ErrSponsorRequired
 	}
 
+	c.txProfile = cc.TxProfile
+	c.cp.SetAuthorization(cc.IdTags, cc.RejectUnknown)
+
 	var (
 		powerG, totalEnergyG, socG func() (float64, error)
 		currentsG, voltagesG       func() (float64, float64, float64, error)
@@ -304,9 +311,18 @@ func (c *OCPP) Enable(enable bool) error {
 	return err
 }
 
-// setCurrent sets the TxDefaultChargingProfile with given current
+// setCurrent sets the TxDefaultChargingProfile with given current.
+// If configured, a TxProfile is used instead while a transaction is running.
 func (c *OCPP) setCurrent(current float64) error {
-	err := c.conn.SetChargingProfileRequest(c.createTxDefaultChargingProfile(math.Trunc(10*current) / 10))
+	profile := c.createTxDefaultChargingProfile(math.Trunc(10*current) / 10)
+
+	if c.txProfile {
+		if txn, err := c.conn.TransactionID(); err == nil && txn > 0 {
+			profile = c.createTxChargingProfile(profile, txn)
+		}
+	}
+
+	err := c.conn.SetChargingProfileRequest(profile)
 	if err != nil {
 		err = fmt.Errorf("set charging profile: %w", err)
 	}
@@ -352,6 +368,17 @@ func (c *OCPP) createTxDefaultChargingProfile(current float64) *types.ChargingPr
 	return res
 }
 
+// createTxChargingProfile returns a TxProfile for the given transaction based on the TxDefaultChargingProfile.
+// A separate profile id keeps the TxDefaultChargingProfile in place for subsequent transactions.
+func (c *OCPP) createTxChargingProfile(profile *types.ChargingProfile, txn int) *types.ChargingProfile {
+	res := *profile
+	res.ChargingProfileId = profile.ChargingProfileId + 1
+	res.ChargingProfilePurpose = types.ChargingProfilePurposeTxProfile
+	res.TransactionId = txn
+
+	return &res
+}
+
 // MaxCurrent implements the api.Charger interface
 func (c *OCPP) MaxCurrent(current int64) error {
 	return c.MaxCurrentMillis(float64(current))
@@ -392,6 +419,13 @@ func (c *OCPP) Identify() (string, error) {
 	return c.conn.IdTag(), nil
 }
 
+var _ api.CertificateInstaller = (*OCPP)(nil)
+
+// InstallCertificate implements the api.CertificateInstaller interface
+func (c *OCPP) InstallCertificate(typ, pem string) error {
+	return c.cp.InstallCertificateRequest(typ, pem)
+}
+
 var _ api.Diagnosis = (*OCPP)(nil)
 
 // Diagnose implements the api.Diagnosis interface
@@ -425,6 +459,32 @@ func (c *OCPP) Diagnose() {
 	}
 }
 
+var _ api.ChargerConfigReader = (*OCPP)(nil)
+
+// ChargerConfig implements the api.ChargerConfigReader interface
+func (c *OCPP) ChargerConfig() (map[string]string, error) {
+	resp, err := c.cp.GetConfigurationRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(resp.ConfigurationKey))
+	for _, opt := range resp.ConfigurationKey {
+		if opt.Value != nil && !opt.Readonly {
+			res[opt.Key] = *opt.Value
+		}
+	}
+
+	return res, nil
+}
+
+var _ api.ChargerConfigWriter = (*OCPP)(nil)
+
+// SetChargerConfig implements the api.ChargerConfigWriter interface
+func (c *OCPP) SetChargerConfig(key, value string) error {
+	return c.cp.ChangeConfigurationRequest(key, value)
+}
+
 var _ loadpoint.Controller = (*OCPP)(nil)
 
 // LoadpointControl implements loadpoint.Controller
@@ -97,7 +97,7 @@ func (conn *Connector) OnStartTransaction(request *core.StartTransactionRequest)
 
 	res := &core.StartTransactionConfirmation{
 		IdTagInfo: &types.IdTagInfo{
-			Status: types.AuthorizationStatusAccepted,
+			Status: conn.cp.authorize(request.IdTag),
 		},
 		TransactionId: conn.txnId,
 	}
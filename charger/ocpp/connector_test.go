@@ -8,6 +8,7 @@ import (
 	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
 	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -172,3 +173,28 @@ func (suite *connTestSuite) TestOnStopTransactionResetsReportedPower() {
 	suite.NoError(err, "CurrentPower")
 	suite.Equal(res, 0.0, "CurrentPower")
 }
+
+func (suite *connTestSuite) TestConnectorAuthorization() {
+	// accept all by default
+	res, err := suite.cp.OnAuthorize(&core.AuthorizeRequest{IdTag: "unknown"})
+	suite.NoError(err)
+	suite.Equal(types.AuthorizationStatusAccepted, res.IdTagInfo.Status)
+
+	suite.cp.SetAuthorization([]string{"known"}, true)
+
+	res, err = suite.cp.OnAuthorize(&core.AuthorizeRequest{IdTag: "known"})
+	suite.NoError(err)
+	suite.Equal(types.AuthorizationStatusAccepted, res.IdTagInfo.Status)
+
+	res, err = suite.cp.OnAuthorize(&core.AuthorizeRequest{IdTag: "unknown"})
+	suite.NoError(err)
+	suite.Equal(types.AuthorizationStatusInvalid, res.IdTagInfo.Status)
+
+	txn, err := suite.conn.OnStartTransaction(&core.StartTransactionRequest{IdTag: "unknown"})
+	suite.NoError(err)
+	suite.Equal(types.AuthorizationStatusInvalid, txn.IdTagInfo.Status)
+
+	txn, err = suite.conn.OnStartTransaction(&core.StartTransactionRequest{IdTag: "known"})
+	suite.NoError(err)
+	suite.Equal(types.AuthorizationStatusAccepted, txn.IdTagInfo.Status)
+}
@@ -37,6 +37,10 @@ type CP struct {
 	BootNotificationResult   *core.BootNotificationRequest
 
 	connectors map[int]*Connector
+
+	// local authorization list
+	idTags        []string
+	rejectUnknown bool
 }
 
 func NewChargePoint(log *util.Logger, id string) *CP {
@@ -0,0 +1,54 @@
+package ocpp
+
+import (
+	"slices"
+
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/core"
+	"github.com/lorenzodonini/ocpp-go/ocpp1.6/types"
+)
+
+// SetAuthorization adds id tags to the charge point's local authorization list.
+// If reject is set, id tags not on the list are reported as invalid.
+func (cp *CP) SetAuthorization(idTags []string, reject bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	for _, tag := range idTags {
+		if !slices.Contains(cp.idTags, tag) {
+			cp.idTags = append(cp.idTags, tag)
+		}
+	}
+
+	cp.rejectUnknown = cp.rejectUnknown || reject
+}
+
+// authorize checks the id tag against the local authorization list
+func (cp *CP) authorize(idTag string) types.AuthorizationStatus {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+
+	if !cp.rejectUnknown || slices.Contains(cp.idTags, idTag) {
+		return types.AuthorizationStatusAccepted
+	}
+
+	// id tags used by evcc for remote start are always accepted
+	for _, conn := range cp.connectors {
+		if conn.remoteIdTag != "" && conn.remoteIdTag == idTag {
+			return types.AuthorizationStatusAccepted
+		}
+	}
+
+	cp.log.WARN.Printf("rejecting unknown id tag: %s", idTag)
+
+	return types.AuthorizationStatusInvalid
+}
+
+func (cp *CP) OnAuthorize(request *core.AuthorizeRequest) (*core.AuthorizeConfirmation, error) {
+	res := &core.AuthorizeConfirmation{
+		IdTagInfo: &types.IdTagInfo{
+			Status: cp.authorize(request.IdTag),
+		},
+	}
+
+	return res, nil
+}
@@ -0,0 +1,39 @@
+package ocpp
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// VendorPnC is the data transfer vendor id of the OCPP 1.6 ISO 15118 Plug & Charge extension
+const VendorPnC = "org.openchargealliance.iso15118pnc"
+
+// InstallCertificateRequest installs a Plug & Charge root certificate using the ISO 15118 data transfer extension
+func (cp *CP) InstallCertificateRequest(typ, pem string) error {
+	data, err := json.Marshal(struct {
+		CertificateType string `json:"certificateType"`
+		Certificate     string `json:"certificate"`
+	}{
+		CertificateType: typ,
+		Certificate:     pem,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := cp.DataTransferRequest(VendorPnC, "InstallCertificate", string(data))
+	if err != nil {
+		return err
+	}
+
+	// installation status is returned as json encoded data
+	var status struct {
+		Status string `json:"status"`
+	}
+
+	if s, ok := res.Data.(string); ok && json.Unmarshal([]byte(s), &status) == nil && status.Status != "" && status.Status != "Accepted" {
+		return errors.New(status.Status)
+	}
+
+	return nil
+}
@@ -115,3 +115,23 @@ func (cp *CP) GetConfigurationRequest() (*core.GetConfigurationConfirmation, err
 
 	return res, wait(err, rc)
 }
+
+func (cp *CP) DataTransferRequest(vendorId, messageId string, data any) (*core.DataTransferConfirmation, error) {
+	var res *core.DataTransferConfirmation
+	rc := make(chan error, 1)
+
+	err := Instance().DataTransfer(cp.id, func(request *core.DataTransferConfirmation, err error) {
+		if err == nil && request != nil && request.Status != core.DataTransferStatusAccepted {
+			err = errors.New(string(request.Status))
+		}
+
+		res = request
+
+		rc <- err
+	}, vendorId, func(request *core.DataTransferRequest) {
+		request.MessageId = messageId
+		request.Data = data
+	})
+
+	return res, wait(err, rc)
+}
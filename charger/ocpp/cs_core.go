@@ -9,7 +9,9 @@ import (
 // cp actions
 
 func (cs *CS) OnAuthorize(id string, request *core.AuthorizeRequest) (*core.AuthorizeConfirmation, error) {
-	// no cp handler
+	if cp, err := cs.ChargepointByID(id); err == nil {
+		return cp.OnAuthorize(request)
+	}
 
 	res := &core.AuthorizeConfirmation{
 		IdTagInfo: &types.IdTagInfo{
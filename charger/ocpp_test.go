@@ -255,3 +255,25 @@ func (suite *ocppTestSuite) TestTimeout() {
 
 	suite.Require().NoError(err)
 }
+
+func TestOcppTxChargingProfile(t *testing.T) {
+	cp := ocpp.NewChargePoint(nil, "test")
+	cp.ChargingProfileId = 1
+
+	c := &OCPP{cp: cp}
+
+	def := c.createTxDefaultChargingProfile(10)
+	tx := c.createTxChargingProfile(def, 42)
+
+	if def.ChargingProfilePurpose != types.ChargingProfilePurposeTxDefaultProfile || def.TransactionId != 0 {
+		t.Errorf("default profile modified: %+v", def)
+	}
+
+	if tx.ChargingProfilePurpose != types.ChargingProfilePurposeTxProfile || tx.TransactionId != 42 || tx.ChargingProfileId != 2 {
+		t.Errorf("invalid tx profile: %+v", tx)
+	}
+
+	if tx.ChargingSchedule.ChargingSchedulePeriod[0].Limit != 10 {
+		t.Errorf("invalid tx profile limit: %+v", tx.ChargingSchedule)
+	}
+}
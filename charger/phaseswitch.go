@@ -0,0 +1,150 @@
+package charger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/plugin"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+)
+
+// PhaseSwitch adds phase switching to chargers lacking native support using an external
+// 1p3p contactor controlled by a relay, e.g. a Shelly, GPIO or Modbus coil.
+// For safety, the contactor is only switched while the charger is not charging.
+type PhaseSwitch struct {
+	api.Charger
+	log     *util.Logger
+	phasesS func(int64) error
+}
+
+func init() {
+	registry.AddCtx("phaseswitch", NewPhaseSwitchFromConfig)
+}
+
+//go:generate go tool decorate -f decoratePhaseSwitch -b *PhaseSwitch -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.ChargerEx,MaxCurrentMillis,func(float64) error" -t "api.Identifier,Identify,func() (string, error)" -t "api.CurrentGetter,GetMaxCurrent,func() (float64, error)" -t "api.StatusReasoner,StatusReason,func() (api.Reason, error)" -t "api.Resurrector,WakeUp,func() error" -t "api.Battery,Soc,func() (float64, error)"
+
+// NewPhaseSwitchFromConfig creates an external phase switch charger from generic config
+func NewPhaseSwitchFromConfig(ctx context.Context, other map[string]interface{}) (api.Charger, error) {
+	var cc struct {
+		Charger    config.Typed
+		Phases1p3p plugin.Config
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	charger, err := NewFromConfig(ctx, cc.Charger.Type, cc.Charger.Other)
+	if err != nil {
+		return nil, err
+	}
+
+	phasesS, err := cc.Phases1p3p.IntSetter(ctx, "phases")
+	if err != nil {
+		return nil, fmt.Errorf("phases1p3p: %w", err)
+	}
+
+	return NewPhaseSwitch(charger, phasesS)
+}
+
+// NewPhaseSwitch creates an external phase switch charger wrapping the given charger.
+// Chargers with capabilities that cannot be passed through are rejected instead of silently losing them.
+func NewPhaseSwitch(charger api.Charger, phasesS func(int64) error) (api.Charger, error) {
+	for _, unsupported := range []struct {
+		name string
+		ok   bool
+	}{
+		{"phase switching", implements[api.PhaseSwitcher](charger)},
+		{"phase detection", implements[api.PhaseGetter](charger)},
+		{"soc limit", implements[api.SocLimiter](charger)},
+		{"charged energy", implements[api.ChargeRater](charger)},
+		{"charge duration", implements[api.ChargeTimer](charger)},
+		{"loadpoint control", implements[loadpoint.Controller](charger)},
+	} {
+		if unsupported.ok {
+			return nil, fmt.Errorf("charger with %s is not supported", unsupported.name)
+		}
+	}
+
+	c := &PhaseSwitch{
+		Charger: charger,
+		log:     util.NewLogger("phaseswitch"),
+		phasesS: phasesS,
+	}
+
+	var (
+		power, energy, soc func() (float64, error)
+		currents, voltages func() (float64, float64, float64, error)
+		maxCurrentMillis   func(float64) error
+		identify           func() (string, error)
+		getMaxCurrent      func() (float64, error)
+		statusReason       func() (api.Reason, error)
+		wakeUp             func() error
+	)
+
+	if m, ok := charger.(api.Meter); ok {
+		power = m.CurrentPower
+	}
+	if m, ok := charger.(api.MeterEnergy); ok {
+		energy = m.TotalEnergy
+	}
+	if m, ok := charger.(api.PhaseCurrents); ok {
+		currents = m.Currents
+	}
+	if m, ok := charger.(api.PhaseVoltages); ok {
+		voltages = m.Voltages
+	}
+	if m, ok := charger.(api.ChargerEx); ok {
+		maxCurrentMillis = m.MaxCurrentMillis
+	}
+	if m, ok := charger.(api.Identifier); ok {
+		identify = m.Identify
+	}
+	if m, ok := charger.(api.CurrentGetter); ok {
+		getMaxCurrent = m.GetMaxCurrent
+	}
+	if m, ok := charger.(api.StatusReasoner); ok {
+		statusReason = m.StatusReason
+	}
+	if m, ok := charger.(api.Resurrector); ok {
+		wakeUp = m.WakeUp
+	}
+	if m, ok := charger.(api.Battery); ok {
+		soc = m.Soc
+	}
+
+	return decoratePhaseSwitch(c, power, energy, currents, voltages, maxCurrentMillis, identify, getMaxCurrent, statusReason, wakeUp, soc), nil
+}
+
+func implements[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+// Phases1p3p implements the api.PhaseSwitcher interface.
+// Interlock: the contactor is never switched under load, the loadpoint retries on the next cycle
+// after charging has stopped.
+func (c *PhaseSwitch) Phases1p3p(phases int) error {
+	status, err := c.Status()
+	if err != nil {
+		return err
+	}
+
+	if status == api.StatusC {
+		return fmt.Errorf("cannot switch phases while charging: %w", api.ErrMustRetry)
+	}
+
+	c.log.DEBUG.Printf("switching contactor: %dp", phases)
+
+	return c.phasesS(int64(phases))
+}
+
+// Diagnose implements the api.Diagnosis interface
+func (c *PhaseSwitch) Diagnose() {
+	if d, ok := c.Charger.(api.Diagnosis); ok {
+		d.Diagnose()
+	}
+}
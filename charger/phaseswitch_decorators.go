@@ -0,0 +1,16153 @@
+package charger
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decoratePhaseSwitch(base *PhaseSwitch, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), chargerEx func(float64) error, identifier func() (string, error), currentGetter func() (float64, error), statusReasoner func() (api.Reason, error), resurrector func() error, battery func() (float64, error)) api.Charger {
+	switch {
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return base
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery == nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector == nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner == nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx == nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages == nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+
+	case battery != nil && chargerEx != nil && currentGetter != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseVoltages != nil && resurrector != nil && statusReasoner != nil:
+		return &struct {
+			*PhaseSwitch
+			api.Battery
+			api.ChargerEx
+			api.CurrentGetter
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+			api.Resurrector
+			api.StatusReasoner
+		}{
+			PhaseSwitch: base,
+			Battery: &decoratePhaseSwitchBatteryImpl{
+				battery: battery,
+			},
+			ChargerEx: &decoratePhaseSwitchChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			CurrentGetter: &decoratePhaseSwitchCurrentGetterImpl{
+				currentGetter: currentGetter,
+			},
+			Identifier: &decoratePhaseSwitchIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePhaseSwitchMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePhaseSwitchMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePhaseSwitchPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decoratePhaseSwitchPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			Resurrector: &decoratePhaseSwitchResurrectorImpl{
+				resurrector: resurrector,
+			},
+			StatusReasoner: &decoratePhaseSwitchStatusReasonerImpl{
+				statusReasoner: statusReasoner,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decoratePhaseSwitchBatteryImpl struct {
+	battery func() (float64, error)
+}
+
+func (impl *decoratePhaseSwitchBatteryImpl) Soc() (float64, error) {
+	return impl.battery()
+}
+
+type decoratePhaseSwitchChargerExImpl struct {
+	chargerEx func(float64) error
+}
+
+func (impl *decoratePhaseSwitchChargerExImpl) MaxCurrentMillis(p0 float64) error {
+	return impl.chargerEx(p0)
+}
+
+type decoratePhaseSwitchCurrentGetterImpl struct {
+	currentGetter func() (float64, error)
+}
+
+func (impl *decoratePhaseSwitchCurrentGetterImpl) GetMaxCurrent() (float64, error) {
+	return impl.currentGetter()
+}
+
+type decoratePhaseSwitchIdentifierImpl struct {
+	identifier func() (string, error)
+}
+
+func (impl *decoratePhaseSwitchIdentifierImpl) Identify() (string, error) {
+	return impl.identifier()
+}
+
+type decoratePhaseSwitchMeterImpl struct {
+	meter func() (float64, error)
+}
+
+func (impl *decoratePhaseSwitchMeterImpl) CurrentPower() (float64, error) {
+	return impl.meter()
+}
+
+type decoratePhaseSwitchMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decoratePhaseSwitchMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
+
+type decoratePhaseSwitchPhaseCurrentsImpl struct {
+	phaseCurrents func() (float64, float64, float64, error)
+}
+
+func (impl *decoratePhaseSwitchPhaseCurrentsImpl) Currents() (float64, float64, float64, error) {
+	return impl.phaseCurrents()
+}
+
+type decoratePhaseSwitchPhaseVoltagesImpl struct {
+	phaseVoltages func() (float64, float64, float64, error)
+}
+
+func (impl *decoratePhaseSwitchPhaseVoltagesImpl) Voltages() (float64, float64, float64, error) {
+	return impl.phaseVoltages()
+}
+
+type decoratePhaseSwitchResurrectorImpl struct {
+	resurrector func() error
+}
+
+func (impl *decoratePhaseSwitchResurrectorImpl) WakeUp() error {
+	return impl.resurrector()
+}
+
+type decoratePhaseSwitchStatusReasonerImpl struct {
+	statusReasoner func() (api.Reason, error)
+}
+
+func (impl *decoratePhaseSwitchStatusReasonerImpl) StatusReason() (api.Reason, error) {
+	return impl.statusReasoner()
+}
@@ -0,0 +1,68 @@
+package charger
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPhaseSwitch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var phases int64
+	phasesS := func(p int64) error {
+		phases = p
+		return nil
+	}
+
+	wb := struct {
+		*api.MockCharger
+		*api.MockIdentifier
+		*api.MockCurrentGetter
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockIdentifier(ctrl),
+		api.NewMockCurrentGetter(ctrl),
+	}
+
+	c, err := NewPhaseSwitch(wb, phasesS)
+	require.NoError(t, err)
+
+	// capabilities are passed through
+	_, ok := c.(api.Identifier)
+	assert.True(t, ok)
+	_, ok = c.(api.CurrentGetter)
+	assert.True(t, ok)
+	_, ok = c.(api.Meter)
+	assert.False(t, ok)
+
+	ps, ok := c.(api.PhaseSwitcher)
+	require.True(t, ok)
+
+	// interlock: no switching while charging
+	wb.MockCharger.EXPECT().Status().Return(api.StatusC, nil)
+	assert.ErrorIs(t, ps.Phases1p3p(1), api.ErrMustRetry)
+	assert.Zero(t, phases)
+
+	wb.MockCharger.EXPECT().Status().Return(api.StatusB, nil)
+	require.NoError(t, ps.Phases1p3p(1))
+	assert.Equal(t, int64(1), phases)
+}
+
+func TestPhaseSwitchUnsupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	wb := struct {
+		*api.MockCharger
+		*api.MockPhaseSwitcher
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockPhaseSwitcher(ctrl),
+	}
+
+	_, err := NewPhaseSwitch(wb, func(int64) error { return nil })
+	assert.Error(t, err)
+}
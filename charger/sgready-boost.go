@@ -20,6 +20,7 @@ func NewSgReadyBoostFromConfig(ctx context.Context, other map[string]interface{}
 		embed                   `mapstructure:",squash"`
 		Charger                 config.Typed
 		measurement.Temperature `mapstructure:",squash"`
+		sgReadyRuntime          `mapstructure:",squash"`
 	}{
 		embed: embed{
 			Icon_:     "heatpump",
@@ -46,6 +47,8 @@ func NewSgReadyBoostFromConfig(ctx context.Context, other map[string]interface{}
 		return nil, err
 	}
 
+	res.runtime = cc.sgReadyRuntime
+
 	return decorateSgReady(res, nil, nil, tempG, limitTempG), nil
 }
 
@@ -20,7 +20,10 @@ package charger
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/charger/measurement"
 	"github.com/evcc-io/evcc/core/loadpoint"
@@ -39,6 +42,17 @@ type SgReady struct {
 	power     int64
 	lp        loadpoint.API
 	maxPowerS func(int64) error
+
+	// protect the compressor from frequent switching
+	clock    clock.Clock
+	switched time.Time // last switch between normal and boost mode
+	runtime  sgReadyRuntime
+}
+
+// sgReadyRuntime are the minimum durations for keeping the boost mode active or inactive
+type sgReadyRuntime struct {
+	MinRunTime  time.Duration // minimum duration of boost mode
+	MinLockTime time.Duration // minimum duration between leaving and re-entering boost mode
 }
 
 func init() {
@@ -63,6 +77,7 @@ func NewSgReadyFromConfig(ctx context.Context, other map[string]interface{}) (ap
 		SetMaxPower             *plugin.Config // optional
 		measurement.Temperature `mapstructure:",squash"`
 		measurement.Energy      `mapstructure:",squash"`
+		sgReadyRuntime          `mapstructure:",squash"`
 	}{
 		embed: embed{
 			Icon_:     "heatpump",
@@ -94,6 +109,8 @@ func NewSgReadyFromConfig(ctx context.Context, other map[string]interface{}) (ap
 		return nil, err
 	}
 
+	res.runtime = cc.sgReadyRuntime
+
 	powerG, energyG, err := cc.Energy.Configure(ctx)
 	if err != nil {
 		return nil, err
@@ -115,6 +132,7 @@ func NewSgReady(ctx context.Context, embed *embed, modeS func(int64) error, mode
 		modeS:     modeS,
 		modeG:     modeG,
 		maxPowerS: maxPowerS,
+		clock:     clock.New(),
 	}
 
 	return res, nil
@@ -148,14 +166,41 @@ func (wb *SgReady) Enabled() (bool, error) {
 	return mode == Boost, err
 }
 
+// switchable checks if the minimum run or lock time permits switching boost mode
+func (wb *SgReady) switchable(enable bool) error {
+	if wb.switched.IsZero() || enable == (wb.mode == Boost) {
+		return nil
+	}
+
+	elapsed := wb.clock.Since(wb.switched)
+
+	if !enable && elapsed < wb.runtime.MinRunTime {
+		return fmt.Errorf("minimum run time: %v remaining: %w", (wb.runtime.MinRunTime - elapsed).Round(time.Second), api.ErrMustRetry)
+	}
+
+	if enable && elapsed < wb.runtime.MinLockTime {
+		return fmt.Errorf("minimum lock time: %v remaining: %w", (wb.runtime.MinLockTime - elapsed).Round(time.Second), api.ErrMustRetry)
+	}
+
+	return nil
+}
+
 // Enable implements the api.Charger interface
 func (wb *SgReady) Enable(enable bool) error {
+	if err := wb.switchable(enable); err != nil {
+		return err
+	}
+
 	mode := map[bool]int64{false: Normal, true: Boost}[enable]
 
 	if err := wb.modeS(mode); err != nil {
 		return err
 	}
 
+	if (mode == Boost) != (wb.mode == Boost) {
+		wb.switched = wb.clock.Now()
+	}
+
 	wb.mode = mode
 
 	return wb.setMaxPower(wb.power)
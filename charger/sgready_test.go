@@ -0,0 +1,55 @@
+package charger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSgReadyRuntime(t *testing.T) {
+	var mode int64
+	modeS := func(m int64) error {
+		mode = m
+		return nil
+	}
+
+	wb, err := NewSgReady(context.TODO(), &embed{}, modeS, nil, nil)
+	require.NoError(t, err)
+
+	clk := clock.NewMock()
+	wb.clock = clk
+	wb.runtime = sgReadyRuntime{
+		MinRunTime:  10 * time.Minute,
+		MinLockTime: 20 * time.Minute,
+	}
+
+	// initial switch is not restricted
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, Boost, mode)
+
+	// repeating the current mode is not restricted
+	clk.Add(time.Minute)
+	require.NoError(t, wb.Enable(true))
+
+	// minimum run time
+	assert.ErrorIs(t, wb.Enable(false), api.ErrMustRetry)
+	assert.Equal(t, Boost, mode)
+
+	clk.Add(9 * time.Minute)
+	require.NoError(t, wb.Enable(false))
+	assert.Equal(t, Normal, mode)
+
+	// minimum lock time
+	clk.Add(19 * time.Minute)
+	assert.ErrorIs(t, wb.Enable(true), api.ErrMustRetry)
+	assert.Equal(t, Normal, mode)
+
+	clk.Add(time.Minute)
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, Boost, mode)
+}
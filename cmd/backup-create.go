@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/evcc-io/evcc/server/backup"
+	"github.com/spf13/cobra"
+)
+
+// backupCreateCmd represents the backup create command
+var backupCreateCmd = &cobra.Command{
+	Use:   "create [archive]",
+	Short: "Export config file and database including devices, plans and sessions",
+	Args:  cobra.MaximumNArgs(1),
+	Run:   runBackupCreate,
+}
+
+func init() {
+	backupCmd.AddCommand(backupCreateCmd)
+}
+
+func runBackupCreate(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf, !cmd.Flag(flagIgnoreDatabase).Changed); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// setup persistence
+	if err := configureDatabase(conf.Database); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	file := backup.Filename()
+	if len(args) > 0 {
+		file = args[0]
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if err := backup.Write(f, viper.ConfigFileUsed()); err != nil {
+		f.Close()
+		os.Remove(file)
+		log.FATAL.Fatal(err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	log.INFO.Println("created backup:", file)
+}
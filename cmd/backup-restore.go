@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/evcc-io/evcc/server/backup"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+)
+
+// backupRestoreCmd represents the backup restore command
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore <archive>",
+	Short: "Restore config file and database from archive",
+	Long: `Restore config file and database from archive. Existing files are kept with .bak extension.
+The config file is restored to the --config path, the existing config file or ~/evcc.yaml.
+Stop any running evcc instance before restoring.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runBackupRestore,
+}
+
+func init() {
+	backupCmd.AddCommand(backupRestoreCmd)
+	backupRestoreCmd.Flags().BoolP(flagForce, "f", false, "Force (no confirmation)")
+}
+
+// restoreConfigFile returns the config file path to restore to
+func restoreConfigFile() (string, error) {
+	if cfgFile != "" {
+		return cfgFile, nil
+	}
+
+	if err := viper.ReadInConfig(); err == nil {
+		return viper.ConfigFileUsed(), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, "evcc.yaml"), nil
+}
+
+func runBackupRestore(cmd *cobra.Command, args []string) {
+	f, err := os.Open(args[0])
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	archive, err := backup.Open(f, fi.Size())
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	log.INFO.Printf("archive created %s by evcc %s", archive.Created.Local().Format("2006-01-02 15:04"), archive.Version)
+
+	confirmation, _ := cmd.Flags().GetBool(flagForce)
+	if !confirmation {
+		prompt := &survey.Confirm{
+			Message: "Overwrite config file and database",
+		}
+
+		if err := survey.AskOne(prompt, &confirmation); err != nil {
+			log.FATAL.Fatal(err)
+		}
+	}
+
+	if !confirmation {
+		return
+	}
+
+	// database path may be part of the restored config
+	var configFile string
+	if archive.Config {
+		if configFile, err = restoreConfigFile(); err != nil {
+			log.FATAL.Fatal(err)
+		}
+
+		b, err := archive.ReadConfig()
+		if err != nil {
+			log.FATAL.Fatal(err)
+		}
+
+		viper.SetConfigType("yaml")
+		if err := viper.ReadConfig(bytes.NewReader(b)); err != nil {
+			log.FATAL.Fatal(err)
+		}
+
+		if err := viper.UnmarshalExact(&conf); err != nil {
+			log.FATAL.Fatal(err)
+		}
+	} else if err := loadConfigFile(&conf, false); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	dsn := conf.Database.Dsn
+	if dsn == "" {
+		dsn = userDB
+	}
+
+	dbFile, err := homedir.Expand(dsn)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// evcc must be stopped, database is not open
+	if err := archive.Restore(configFile, dbFile, func() error { return nil }); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if configFile != "" {
+		log.INFO.Println("restored config file:", configFile)
+	}
+	log.INFO.Println("restored database:", dbFile)
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Manage configuration backups",
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+}
@@ -48,6 +48,7 @@ var a struct {
 	api.PhaseVoltages
 	api.PhasePowers
 	api.MaxACPowerGetter
+	api.ProductionLimiter
 
 	api.PhaseSwitcher
 	api.PhaseGetter
@@ -65,10 +66,11 @@ func typ(i any) string {
 }
 
 var dependents = map[string][]string{
-	typ(&a.Meter):         {typ(&a.MeterEnergy), typ(&a.PhaseCurrents), typ(&a.PhaseVoltages), typ(&a.MaxACPowerGetter)},
-	typ(&a.PhaseCurrents): {typ(&a.PhasePowers)}, // phase powers are only used to determine currents sign
-	typ(&a.PhaseSwitcher): {typ(&a.PhaseGetter)},
-	typ(&a.Battery):       {typ(&a.BatteryCapacity), typ(&a.SocLimiter), typ(&a.BatteryController), typ(&a.BatterySocLimiter), typ(&a.BatteryPowerLimiter)},
+	typ(&a.Meter):            {typ(&a.MeterEnergy), typ(&a.PhaseCurrents), typ(&a.PhaseVoltages), typ(&a.MaxACPowerGetter)},
+	typ(&a.PhaseCurrents):    {typ(&a.PhasePowers)},       // phase powers are only used to determine currents sign
+	typ(&a.MaxACPowerGetter): {typ(&a.ProductionLimiter)}, // production limit requires max ac power
+	typ(&a.PhaseSwitcher):    {typ(&a.PhaseGetter)},
+	typ(&a.Battery):          {typ(&a.BatteryCapacity), typ(&a.SocLimiter), typ(&a.BatteryController), typ(&a.BatterySocLimiter), typ(&a.BatteryPowerLimiter)},
 }
 
 // hasIntersection returns if the slices intersect
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/evcc-io/evcc/cmd/doctor"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/spf13/cobra"
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check environment for common problems",
+	Long: `Check the environment for common problems like port conflicts, time synchronization,
+broken IPv6, name resolution and reachability of configured devices and database integrity.`,
+	Run: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().Duration(flagTimeout, 5*time.Second, flagTimeoutDescription)
+	doctorCmd.Flags().String("ntp", "pool.ntp.org", "NTP server")
+	doctorCmd.Flags().String("ipv6", "api.evcc.io", "Host for checking IPv6 connectivity")
+}
+
+func runDoctor(cmd *cobra.Command, args []string) {
+	timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+	ntp, _ := cmd.Flags().GetString("ntp")
+	ipv6, _ := cmd.Flags().GetString("ipv6")
+
+	if err := loadConfigFile(&conf, !cmd.Flag(flagIgnoreDatabase).Changed); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	var findings []doctor.Finding
+
+	findings = append(findings,
+		doctor.CheckPort(conf.Network.Port),
+		doctor.CheckNTP(ntp, timeout),
+		doctor.CheckIPv6(ipv6, timeout),
+	)
+
+	devices := doctorDevices()
+	if err := configureDatabase(conf.Database); err != nil {
+		findings = append(findings, doctor.Finding{
+			Check:    "database",
+			Severity: doctor.Error,
+			Message:  err.Error(),
+			Advice:   "check database path and permissions",
+		})
+	} else {
+		findings = append(findings, doctor.CheckDatabase(db.Instance))
+		devices = append(devices, doctorDatabaseDevices()...)
+	}
+
+	endpoints := doctor.Endpoints(devices)
+	findings = append(findings, doctor.CheckDNS(endpoints, timeout)...)
+	findings = append(findings, doctor.CheckReachability(endpoints, timeout)...)
+
+	if doctor.Print(os.Stdout, findings) == doctor.Error {
+		os.Exit(1)
+	}
+}
+
+// doctorDevices returns the devices of the config file
+func doctorDevices() []doctor.Device {
+	var res []doctor.Device
+
+	for _, cc := range [][]config.Named{conf.Meters, conf.Chargers, conf.Vehicles} {
+		for _, c := range cc {
+			res = append(res, doctor.Device{Name: c.Name, Config: c.Other})
+		}
+	}
+
+	if conf.Mqtt.Broker != "" {
+		res = append(res, doctor.Device{Name: "mqtt", Config: map[string]any{"broker": conf.Mqtt.Broker}})
+	}
+
+	return res
+}
+
+// doctorDatabaseDevices returns the devices configured via ui
+func doctorDatabaseDevices() []doctor.Device {
+	var res []doctor.Device
+
+	for _, class := range []templates.Class{templates.Meter, templates.Charger, templates.Vehicle} {
+		configs, err := config.ConfigurationsByClass(class)
+		if err != nil {
+			log.ERROR.Println(err)
+			continue
+		}
+
+		for _, c := range configs {
+			named := c.Named()
+			res = append(res, doctor.Device{Name: named.Name, Config: named.Other})
+		}
+	}
+
+	return res
+}
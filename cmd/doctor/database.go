@@ -0,0 +1,39 @@
+package doctor
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// CheckDatabase runs the sqlite integrity check
+func CheckDatabase(db *gorm.DB) Finding {
+	const check = "database"
+
+	advice := "stop evcc and restore the database from a backup"
+
+	rows, err := db.Raw("PRAGMA integrity_check").Rows()
+	if err != nil {
+		return problem(check, Error, advice, "integrity check failed: %v", err)
+	}
+	defer rows.Close()
+
+	var res []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return problem(check, Error, advice, "integrity check failed: %v", err)
+		}
+		res = append(res, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return problem(check, Error, advice, "integrity check failed: %v", err)
+	}
+
+	if len(res) != 1 || res[0] != "ok" {
+		return problem(check, Error, advice, "database corrupt: %s", strings.Join(res, "; "))
+	}
+
+	return ok(check, "database integrity ok")
+}
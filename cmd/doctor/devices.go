@@ -0,0 +1,262 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/spf13/cast"
+)
+
+// Device is a configured device
+type Device struct {
+	Name   string
+	Config map[string]any
+}
+
+// Endpoint is a network address used by a device
+type Endpoint struct {
+	Device     string
+	Host       string
+	Port       int              // zero if unknown or not connection oriented
+	SolarmanV5 *modbus.Settings // logger connection settings
+}
+
+func (e Endpoint) String() string {
+	if e.Port == 0 {
+		return e.Host
+	}
+	return net.JoinHostPort(e.Host, strconv.Itoa(e.Port))
+}
+
+var defaultPorts = map[string]int{
+	"http":       80,
+	"https":      443,
+	"ws":         80,
+	"wss":        443,
+	"tcp":        1883,
+	"mqtt":       1883,
+	"ssl":        8883,
+	"tls":        8883,
+	"mqtts":      8883,
+	"solarmanv5": 8899,
+}
+
+// Endpoints extracts the network addresses from the device configurations.
+// Nested plugin configurations are searched for uri, url, host and broker settings.
+func Endpoints(devices []Device) []Endpoint {
+	var res []Endpoint
+
+	for _, dev := range devices {
+		walk(dev.Config, func(m map[string]any) {
+			if e, ok := endpoint(m); ok {
+				e.Device = dev.Name
+				if !slices.ContainsFunc(res, func(o Endpoint) bool { return o.Device == e.Device && o.String() == e.String() }) {
+					res = append(res, e)
+				}
+			}
+		})
+	}
+
+	return res
+}
+
+// walk calls fun for the map and all nested maps
+func walk(v any, fun func(map[string]any)) {
+	switch v := v.(type) {
+	case map[string]any:
+		fun(v)
+		for _, vv := range v {
+			walk(vv, fun)
+		}
+	case []any:
+		for _, vv := range v {
+			walk(vv, fun)
+		}
+	}
+}
+
+func property(m map[string]any, key string) any {
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+func endpoint(m map[string]any) (Endpoint, bool) {
+	var res Endpoint
+
+	for _, key := range []string{"uri", "url", "broker"} {
+		if s, ok := property(m, key).(string); ok && s != "" {
+			res = parseAddress(s)
+			break
+		}
+	}
+
+	if host, ok := property(m, "host").(string); ok && res.Host == "" {
+		res.Host = host
+		res.Port = cast.ToInt(property(m, "port"))
+	}
+
+	// skip templated and serial device values
+	if res.Host == "" || strings.Contains(res.Host, "{{") || strings.HasPrefix(res.Host, "/") {
+		return res, false
+	}
+
+	if udp, _ := cast.ToBoolE(property(m, "udp")); udp {
+		res.Port = 0
+	}
+
+	s, _ := property(m, "uri").(string)
+	if property(m, "solarmanv5") != nil || strings.HasPrefix(strings.ToLower(s), "solarmanv5://") {
+		if cc, err := modbusSettings(m); err == nil && cc.Protocol() == modbus.SolarmanV5 {
+			res.SolarmanV5 = &cc
+		}
+	}
+
+	return res, true
+}
+
+// modbusSettings decodes the modbus settings of a plugin configuration, ignoring other keys
+func modbusSettings(m map[string]any) (modbus.Settings, error) {
+	typ := reflect.TypeFor[modbus.Settings]()
+
+	other := make(map[string]any)
+	for k, v := range m {
+		if _, ok := typ.FieldByNameFunc(func(s string) bool { return strings.EqualFold(s, k) }); ok {
+			other[k] = v
+		}
+	}
+
+	var cc modbus.Settings
+	err := util.DecodeOther(other, &cc)
+
+	return cc, err
+}
+
+// parseAddress parses url or host:port addresses
+func parseAddress(s string) Endpoint {
+	if strings.Contains(s, "://") {
+		u, err := url.Parse(s)
+		if err != nil {
+			return Endpoint{}
+		}
+
+		port, _ := strconv.Atoi(u.Port())
+		if port == 0 {
+			port = defaultPorts[strings.ToLower(u.Scheme)]
+		}
+
+		return Endpoint{Host: u.Hostname(), Port: port}
+	}
+
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return Endpoint{Host: s}
+	}
+
+	p, _ := strconv.Atoi(port)
+	return Endpoint{Host: host, Port: p}
+}
+
+// CheckDNS resolves the endpoints' host names
+func CheckDNS(endpoints []Endpoint, timeout time.Duration) []Finding {
+	const check = "dns"
+
+	var (
+		res  []Finding
+		seen = make(map[string]bool)
+	)
+
+	for _, e := range endpoints {
+		if net.ParseIP(e.Host) != nil || seen[e.Host] {
+			continue
+		}
+		seen[e.Host] = true
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		addrs, err := net.DefaultResolver.LookupHost(ctx, e.Host)
+		cancel()
+
+		if err != nil {
+			res = append(res, problem(check, Error, "check the host name or configure a static ip address",
+				"%s: cannot resolve %s: %v", e.Device, e.Host, err))
+			continue
+		}
+
+		res = append(res, ok(check, "%s: %s resolves to %s", e.Device, e.Host, strings.Join(addrs, ", ")))
+	}
+
+	return res
+}
+
+// CheckReachability connects to each endpoint. SolarmanV5 loggers must answer a Modbus request.
+func CheckReachability(endpoints []Endpoint, timeout time.Duration) []Finding {
+	const check = "reachability"
+
+	var res []Finding
+
+	for _, e := range endpoints {
+		if e.Port == 0 {
+			continue
+		}
+
+		if e.SolarmanV5 != nil {
+			if err := solarmanV5Handshake(*e.SolarmanV5, timeout); err != nil {
+				res = append(res, problem(check, Error, "check the logger serial and slave id, some logger firmwares require transport udp",
+					"%s: no SolarmanV5 response from %s: %v", e.Device, e, err))
+				continue
+			}
+
+			res = append(res, ok(check, "%s: SolarmanV5 logger %s responding", e.Device, e))
+			continue
+		}
+
+		conn, err := net.DialTimeout("tcp", e.String(), timeout)
+		if err != nil {
+			res = append(res, problem(check, Error, "check that the device is powered and connected to the network",
+				"%s: %s not reachable: %v", e.Device, e, err))
+			continue
+		}
+		conn.Close()
+
+		res = append(res, ok(check, "%s: %s reachable", e.Device, e))
+	}
+
+	return res
+}
+
+// solarmanV5Handshake sends a single Modbus request through the logger
+func solarmanV5Handshake(cc modbus.Settings, timeout time.Duration) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := modbus.NewConnectionWithSettings(ctx, cc)
+	if err != nil {
+		return err
+	}
+	conn.Timeout(timeout)
+	defer conn.Close()
+
+	c, ok := conn.Connection.(*modbus.SolarmanV5Connection)
+	if !ok {
+		return errors.New("not a SolarmanV5 connection")
+	}
+
+	if err := c.Ping(); err != nil {
+		return fmt.Errorf("slave %d: %w", cc.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Severity is the severity of a finding
+type Severity int
+
+const (
+	OK Severity = iota
+	Warning
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case OK:
+		return "ok"
+	case Warning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// Finding is the result of a single check
+type Finding struct {
+	Check    string
+	Severity Severity
+	Message  string
+	Advice   string // actionable hint for warnings and errors
+}
+
+func ok(check, format string, a ...any) Finding {
+	return Finding{Check: check, Severity: OK, Message: fmt.Sprintf(format, a...)}
+}
+
+func problem(check string, severity Severity, advice, format string, a ...any) Finding {
+	return Finding{Check: check, Severity: severity, Message: fmt.Sprintf(format, a...), Advice: advice}
+}
+
+// Print writes the findings and returns the highest severity
+func Print(w io.Writer, findings []Finding) Severity {
+	var res Severity
+
+	for _, f := range findings {
+		res = max(res, f.Severity)
+
+		fmt.Fprintf(w, "[%-7s] %-12s %s\n", f.Severity, f.Check, f.Message)
+		if f.Advice != "" {
+			fmt.Fprintf(w, "%s→ %s\n", strings.Repeat(" ", 23), f.Advice)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,131 @@
+package doctor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoints(t *testing.T) {
+	devices := []Device{
+		{"grid", map[string]any{"type": "template", "template": "shelly", "host": "192.168.1.10"}},
+		{"pv", map[string]any{"type": "custom", "power": map[string]any{
+			"source": "modbus", "uri": "inverter.local:502", "id": 1,
+		}}},
+		{"wb", map[string]any{"type": "custom", "status": map[string]any{"source": "http", "uri": "https://wallbox/api"}}},
+		{"logger", map[string]any{"type": "custom", "power": map[string]any{
+			"source": "modbus", "uri": "solarmanv5://192.168.1.20?serial=2712345678", "id": 1,
+		}}},
+		{"udp", map[string]any{"type": "custom", "power": map[string]any{"source": "modbus", "uri": "192.168.1.30:502", "udp": true}}},
+		{"serial", map[string]any{"type": "custom", "power": map[string]any{"source": "modbus", "device": "/dev/ttyUSB0"}}},
+		{"mqtt", map[string]any{"broker": "tcp://broker:1884"}},
+	}
+
+	res := Endpoints(devices)
+	require.Len(t, res, 6)
+
+	for i, s := range []string{"192.168.1.10", "inverter.local:502", "wallbox:443", "192.168.1.20:8899", "192.168.1.30", "broker:1884"} {
+		assert.Equal(t, s, res[i].String())
+	}
+
+	require.NotNil(t, res[3].SolarmanV5)
+	assert.Equal(t, uint8(1), res[3].SolarmanV5.ID)
+}
+
+func TestCheckPort(t *testing.T) {
+	l, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	port := l.Addr().(*net.TCPAddr).Port
+	assert.Equal(t, Error, CheckPort(port).Severity)
+
+	l.Close()
+	assert.Equal(t, OK, CheckPort(port).Severity)
+}
+
+func TestNTPOffset(t *testing.T) {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	const skew = 10 * time.Second
+
+	go func() {
+		b := make([]byte, 48)
+		_, addr, err := l.ReadFrom(b)
+		if err != nil {
+			return
+		}
+
+		ts := time.Now().Add(skew)
+		sec := uint32(ts.Unix() + ntpEpochOffset)
+		frac := uint32(uint64(ts.Nanosecond()) << 32 / 1e9)
+
+		for _, i := range []int{32, 40} {
+			binary.BigEndian.PutUint32(b[i:], sec)
+			binary.BigEndian.PutUint32(b[i+4:], frac)
+		}
+
+		_, _ = l.WriteTo(b, addr)
+	}()
+
+	offset, err := ntpOffset(l.LocalAddr().String(), time.Second)
+	require.NoError(t, err)
+	assert.InDelta(t, skew.Seconds(), offset.Seconds(), 0.1)
+}
+
+func TestCheckReachability(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closed.Close()
+
+	const loggerSerial = 2712345720
+
+	e, err := modbus.NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 0, 1)
+
+	devices := []Device{
+		{"open", map[string]any{"uri": l.Addr().String()}},
+		{"closed", map[string]any{"uri": closed.Addr().String()}},
+		{"logger", map[string]any{"uri": fmt.Sprintf("solarmanv5://%s?serial=%d", e.Addr(), loggerSerial), "id": 1}},
+		{"dead slave", map[string]any{"uri": fmt.Sprintf("solarmanv5://%s?serial=%d", e.Addr(), loggerSerial), "id": 2}},
+	}
+
+	e.SetOffline(2, true)
+
+	res := CheckReachability(Endpoints(devices), 100*time.Millisecond)
+	require.Len(t, res, 4)
+
+	for i, sev := range []Severity{OK, Error, OK, Error} {
+		assert.Equal(t, sev, res[i].Severity, res[i].Message)
+	}
+
+	out := new(bytes.Buffer)
+	assert.Equal(t, Error, Print(out, res))
+	assert.Contains(t, out.String(), "SolarmanV5 logger")
+}
+
+func TestCheckDatabase(t *testing.T) {
+	d, err := db.New("sqlite", filepath.Join(t.TempDir(), "evcc.db"))
+	require.NoError(t, err)
+
+	require.NoError(t, d.Exec("CREATE TABLE foo (id INTEGER)").Error)
+	assert.Equal(t, OK, CheckDatabase(d).Severity)
+}
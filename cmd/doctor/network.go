@@ -0,0 +1,132 @@
+package doctor
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	ntpEpochOffset = 2208988800 // seconds between 1900 and 1970
+	ntpMaxOffset   = 2 * time.Second
+)
+
+// CheckPort checks that the ui port is available
+func CheckPort(port int) Finding {
+	const check = "port"
+
+	l, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+	if err != nil {
+		return problem(check, Error, "stop the other evcc instance or change network.port",
+			"port %d not available: %v", port, err)
+	}
+	l.Close()
+
+	return ok(check, "port %d available", port)
+}
+
+// CheckNTP compares the system clock with the given NTP server
+func CheckNTP(server string, timeout time.Duration) Finding {
+	const check = "ntp"
+
+	offset, err := ntpOffset(server, timeout)
+	if err != nil {
+		return problem(check, Warning, "allow outgoing udp port 123 to verify time synchronization",
+			"could not query %s: %v", server, err)
+	}
+
+	if offset.Abs() > ntpMaxOffset {
+		return problem(check, Error, "enable time synchronization, e.g. systemd-timesyncd or chrony; tariffs and plans depend on correct time",
+			"system clock off by %v", offset.Round(time.Millisecond))
+	}
+
+	return ok(check, "system clock in sync (offset %v)", offset.Round(time.Millisecond))
+}
+
+// ntpOffset returns the offset of the system clock using a single SNTP request
+func ntpOffset(server string, timeout time.Duration) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	// version 3, client mode
+	req := make([]byte, 48)
+	req[0] = 0x1B
+
+	t1 := time.Now()
+	if _, err := conn.Write(req); err != nil {
+		return 0, err
+	}
+
+	res := make([]byte, 48)
+	if n, err := conn.Read(res); err != nil {
+		return 0, err
+	} else if n < len(res) {
+		return 0, fmt.Errorf("short response: %d bytes", n)
+	}
+	t4 := time.Now()
+
+	t2, t3 := ntpTime(res[32:40]), ntpTime(res[40:48])
+
+	return (t2.Sub(t1) + t3.Sub(t4)) / 2, nil
+}
+
+func ntpTime(b []byte) time.Time {
+	sec, frac := binary.BigEndian.Uint32(b), binary.BigEndian.Uint32(b[4:])
+	return time.Unix(int64(sec)-ntpEpochOffset, int64(frac)*1e9>>32)
+}
+
+// CheckIPv6 checks that the host is reachable via IPv6 if the system has a global IPv6 address.
+// Broken IPv6 connectivity causes slow fallbacks and timeouts of cloud services.
+func CheckIPv6(host string, timeout time.Duration) Finding {
+	const check = "ipv6"
+
+	if !hasGlobalIPv6() {
+		return ok(check, "no global ipv6 address")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip6", host)
+	if err != nil || len(ips) == 0 {
+		return ok(check, "%s has no ipv6 address", host)
+	}
+
+	conn, err := net.DialTimeout("tcp6", net.JoinHostPort(ips[0].String(), "443"), timeout)
+	if err != nil {
+		return problem(check, Warning, "fix ipv6 routing or disable ipv6 on this host",
+			"global ipv6 address configured but %s not reachable via ipv6: %v", host, err)
+	}
+	conn.Close()
+
+	return ok(check, "%s reachable via ipv6", host)
+}
+
+func hasGlobalIPv6() bool {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return false
+	}
+
+	for _, addr := range addrs {
+		if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.To4() == nil && ipnet.IP.IsGlobalUnicast() && !ipnet.IP.IsPrivate() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+// modbusReadCmd represents the modbus read command
+var modbusReadCmd = &cobra.Command{
+	Use:   "read <function> <address> [quantity]",
+	Short: "Read registers (function 0x01 coils, 0x02 discrete inputs, 0x03 holding, 0x04 input registers)",
+	Run:   runModbusRead,
+	Args:  cobra.RangeArgs(2, 3),
+}
+
+func init() {
+	modbusCmd.AddCommand(modbusReadCmd)
+}
+
+func runModbusRead(cmd *cobra.Command, args []string) {
+	fn, err := parseUint16(args[0])
+	if err != nil {
+		log.FATAL.Fatalf("invalid function: %s", args[0])
+	}
+
+	addr, err := parseUint16(args[1])
+	if err != nil {
+		log.FATAL.Fatalf("invalid address: %s", args[1])
+	}
+
+	qty := uint16(1)
+	if len(args) > 2 {
+		if qty, err = parseUint16(args[2]); err != nil || qty == 0 {
+			log.FATAL.Fatalf("invalid quantity: %s", args[2])
+		}
+	}
+
+	conn, err := modbusConnection(cmd)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	var b []byte
+	switch fn {
+	case 1:
+		b, err = conn.ReadCoils(addr, qty)
+	case 2:
+		b, err = conn.ReadDiscreteInputs(addr, qty)
+	case 3:
+		b, err = conn.ReadHoldingRegisters(addr, qty)
+	case 4:
+		b, err = conn.ReadInputRegisters(addr, qty)
+	default:
+		log.FATAL.Fatalf("invalid function: %s", args[0])
+	}
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+
+	if fn <= 2 {
+		for i := range qty {
+			bit := b[i/8] >> (i % 8) & 1
+			fmt.Fprintf(tw, "%d\t0x%04x\t%d\n", addr+i, addr+i, bit)
+		}
+	} else {
+		fmt.Fprintln(tw, "address\t\thex\tuint16\tint16")
+		for i := range qty {
+			v := uint16(b[2*i])<<8 | uint16(b[2*i+1])
+			fmt.Fprintf(tw, "%d\t0x%04x\t0x%04x\t%d\t%d\n", addr+i, addr+i, v, v, int16(v))
+		}
+	}
+
+	tw.Flush()
+}
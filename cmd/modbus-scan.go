@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	sunspec "github.com/andig/gosunspec"
+	bus "github.com/andig/gosunspec/modbus"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/spf13/cobra"
+)
+
+// modbusScanCmd represents the modbus scan command
+var modbusScanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Scan slave ids for responding devices and SunSpec models",
+	Run:   runModbusScan,
+	Args:  cobra.NoArgs,
+}
+
+func init() {
+	modbusCmd.AddCommand(modbusScanCmd)
+
+	modbusScanCmd.Flags().Int("from", 1, "First slave id")
+	modbusScanCmd.Flags().Int("to", 247, "Last slave id")
+}
+
+// scanResult classifies the result of the probe read. Modbus exceptions indicate a responding device,
+// except for gateways reporting an unreachable slave.
+func scanResult(err error) (string, bool) {
+	if err == nil {
+		return "ok", true
+	}
+
+	code, ok := modbus.ExceptionCode(err)
+	if !ok || code == modbus.ExceptionGatewayPathUnavailable || code == modbus.ExceptionGatewayTargetFailed {
+		return "", false
+	}
+
+	return code.Error(), true
+}
+
+// sunspecModels returns the SunSpec models of the device or nil if the device does not support SunSpec
+func sunspecModels(conn *modbus.Connection) []string {
+	in, err := bus.Open(conn)
+	if in == nil {
+		log.DEBUG.Printf("sunspec: %v", err)
+		return nil
+	}
+
+	var res []string
+	in.Do(func(d sunspec.Device) {
+		d.Do(func(m sunspec.Model) {
+			if name := modelName(m); name != "" {
+				res = append(res, fmt.Sprintf("%d (%s)", m.Id(), name))
+			} else {
+				res = append(res, fmt.Sprintf("%d", m.Id()))
+			}
+		})
+	})
+
+	return res
+}
+
+func runModbusScan(cmd *cobra.Command, args []string) {
+	from, _ := cmd.Flags().GetInt("from")
+	to, _ := cmd.Flags().GetInt("to")
+
+	if from < 1 || to > 247 || from > to {
+		log.FATAL.Fatalf("invalid slave id range: %d-%d", from, to)
+	}
+
+	conn, err := modbusConnection(cmd)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// absent slaves never respond, keep the scan short unless configured otherwise
+	timeout := time.Second
+	if cmd.Flags().Changed(flagTimeout) {
+		timeout, _ = cmd.Flags().GetDuration(flagTimeout)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(tw, "id\tresponse\tsunspec models")
+
+	var found int
+	for id := from; id <= to; id++ {
+		fmt.Fprintf(os.Stderr, "\rscanning id %d ", id)
+
+		slave := conn.Clone(uint8(id))
+		slave.Logger(log.TRACE)
+		slave.Timeout(timeout)
+
+		// harmless read of a single holding register
+		_, err := slave.ReadHoldingRegisters(0, 1)
+
+		res, ok := scanResult(err)
+		if !ok {
+			log.DEBUG.Printf("id %d: %v", id, err)
+			continue
+		}
+
+		found++
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", id, res, strings.Join(sunspecModels(slave), ", "))
+	}
+
+	fmt.Fprint(os.Stderr, "\r\033[K")
+
+	if found == 0 {
+		log.FATAL.Fatal("no responding slave ids found")
+	}
+
+	tw.Flush()
+}
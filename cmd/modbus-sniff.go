@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/grid-x/serial"
+	"github.com/spf13/cobra"
+)
+
+// modbusSniffCmd represents the modbus sniff command
+var modbusSniffCmd = &cobra.Command{
+	Use:   "sniff [device]",
+	Short: "Passively log the Modbus RTU transactions of other devices on the bus",
+	Long: `Passively log the Modbus RTU transactions between other devices, e.g. an EMS and an inverter, for discovering register maps.
+The bus is attached using a serial device or, with --uri, a transparent RS485 to TCP adapter. Nothing is ever written to the bus.`,
+	Run:  runModbusSniff,
+	Args: cobra.MaximumNArgs(1),
+}
+
+func init() {
+	modbusCmd.AddCommand(modbusSniffCmd)
+	modbusSniffCmd.Flags().Duration("silence", 100*time.Millisecond, "Bus silence after which incomplete frames are discarded")
+}
+
+// sniffReader reads from a tcp connection with read timeout
+type sniffReader struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (r *sniffReader) Read(b []byte) (int, error) {
+	if err := r.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		return 0, err
+	}
+	return r.Conn.Read(b)
+}
+
+func sniffSource(cmd *cobra.Command, args []string, silence time.Duration) (io.ReadCloser, error) {
+	flags := cmd.Flags()
+
+	device, _ := flags.GetString("device")
+	if len(args) > 0 {
+		device = args[0]
+	}
+
+	if device != "" {
+		baudrate, _ := flags.GetInt("baudrate")
+		comset, _ := flags.GetString("comset")
+
+		cfg := serial.Config{
+			Address:  device,
+			BaudRate: baudrate,
+			DataBits: 8,
+			StopBits: 1,
+			Parity:   "N",
+			Timeout:  silence,
+		}
+
+		switch strings.ToUpper(comset) {
+		case "8N1":
+		case "8N2":
+			cfg.StopBits = 2
+		case "8E1":
+			cfg.Parity = "E"
+		default:
+			return nil, fmt.Errorf("invalid comset: %s", comset)
+		}
+
+		return serial.Open(&cfg)
+	}
+
+	uri, _ := flags.GetString("uri")
+	if uri == "" {
+		return nil, errors.New("missing device or uri")
+	}
+
+	conn, err := net.Dial("tcp", uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sniffReader{Conn: conn, timeout: silence}, nil
+}
+
+func printTransaction(tx modbus.Transaction) {
+	for _, f := range []*modbus.Frame{tx.Request, tx.Response} {
+		if f == nil {
+			continue
+		}
+
+		dir := "req"
+		if f.Response {
+			dir = "res"
+		}
+
+		fmt.Printf("%s %s %s\n", f.Time.Format("15:04:05.000"), dir, f)
+	}
+
+	if tx.Response == nil {
+		fmt.Println("             no response")
+	}
+
+	for _, r := range tx.Registers() {
+		fmt.Printf("             %5d 0x%04x: 0x%04x %6d %6d\n", r.Address, r.Address, r.Value, r.Value, int16(r.Value))
+	}
+}
+
+func runModbusSniff(cmd *cobra.Command, args []string) {
+	silence, _ := cmd.Flags().GetDuration("silence")
+
+	src, err := sniffSource(cmd, args, silence)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+	defer src.Close()
+
+	fmt.Fprintln(os.Stderr, "sniffing, press Ctrl-C to stop")
+
+	var s modbus.Sniffer
+	b := make([]byte, 512)
+
+	for {
+		n, err := src.Read(b)
+
+		var txs []modbus.Transaction
+		if n > 0 {
+			txs = s.Decode(b[:n], time.Now())
+		}
+
+		if err != nil {
+			var ne net.Error
+			if !errors.Is(err, serial.ErrTimeout) && !(errors.As(err, &ne) && ne.Timeout()) {
+				log.FATAL.Fatal(err)
+			}
+
+			// bus silence
+			txs = append(txs, s.Flush()...)
+		}
+
+		for _, tx := range txs {
+			printTransaction(tx)
+		}
+	}
+}
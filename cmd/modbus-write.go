@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/spf13/cobra"
+)
+
+// modbusWriteCmd represents the modbus write command
+var modbusWriteCmd = &cobra.Command{
+	Use:   "write <address> <value>...",
+	Short: "Write holding registers or coil",
+	Run:   runModbusWrite,
+	Args:  cobra.MinimumNArgs(2),
+}
+
+func init() {
+	modbusCmd.AddCommand(modbusWriteCmd)
+	modbusWriteCmd.Flags().Bool("coil", false, "Write single coil (value 0 or 1)")
+	modbusWriteCmd.Flags().BoolP(flagForce, "f", false, "Force (no confirmation)")
+}
+
+func runModbusWrite(cmd *cobra.Command, args []string) {
+	addr, err := parseUint16(args[0])
+	if err != nil {
+		log.FATAL.Fatalf("invalid address: %s", args[0])
+	}
+
+	// accept int16 and uint16 values
+	values := make([]uint16, 0, len(args)-1)
+	for _, s := range args[1:] {
+		v, err := strconv.ParseInt(s, 0, 32)
+		if err != nil || v < -1<<15 || v >= 1<<16 {
+			log.FATAL.Fatalf("invalid value: %s", s)
+		}
+		values = append(values, uint16(v))
+	}
+
+	coil, _ := cmd.Flags().GetBool("coil")
+	if coil && (len(values) > 1 || values[0] > 1) {
+		log.FATAL.Fatal("coil requires single value 0 or 1")
+	}
+
+	confirmation, _ := cmd.Flags().GetBool(flagForce)
+	if !confirmation {
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Write %v to address %d", args[1:], addr),
+		}
+
+		if err := survey.AskOne(prompt, &confirmation); err != nil {
+			log.FATAL.Fatal(err)
+		}
+	}
+
+	if !confirmation {
+		return
+	}
+
+	conn, err := modbusConnection(cmd)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	switch {
+	case coil:
+		var v uint16
+		if values[0] == 1 {
+			v = modbus.CoilOn
+		}
+		_, err = conn.WriteSingleCoil(addr, v)
+	case len(values) == 1:
+		_, err = conn.WriteSingleRegister(addr, values[0])
+	default:
+		b := make([]byte, 0, 2*len(values))
+		for _, v := range values {
+			b = binary.BigEndian.AppendUint16(b, v)
+		}
+		_, err = conn.WriteMultipleRegisters(addr, uint16(len(values)), b)
+	}
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/spf13/cobra"
+)
+
+// modbusCmd represents the modbus command
+var modbusCmd = &cobra.Command{
+	Use:   "modbus",
+	Short: "Read and write Modbus registers",
+}
+
+func init() {
+	rootCmd.AddCommand(modbusCmd)
+
+	flags := modbusCmd.PersistentFlags()
+	flags.String("uri", "", "Modbus TCP, RTU over TCP, UDP or SolarmanV5 address (host:port)")
+	flags.String("device", "", "Serial device")
+	flags.Int("baudrate", 9600, "Serial baudrate")
+	flags.String("comset", "8N1", "Serial communication settings")
+	flags.IntP("id", "i", 1, "Slave id")
+	flags.Bool("rtu", false, "Use Modbus RTU over TCP")
+	flags.Bool("ascii", false, "Use Modbus ASCII on serial device or over TCP")
+	flags.Bool("udp", false, "Use Modbus UDP or SolarmanV5 over UDP")
+	flags.Bool("solarmanv5", false, "Use SolarmanV5 logger protocol")
+	flags.String("localaddress", "", "Local ip address or interface for outgoing Modbus TCP and SolarmanV5 connections")
+	flags.String("serial", "auto", "SolarmanV5 logger serial")
+	flags.Bool("lenient", false, "Accept SolarmanV5 responses with unexpected control code or logger serial")
+	flags.Bool("strictstatus", false, "Reject SolarmanV5 responses with status other than 0x01")
+	flags.String("doublecrc", "auto", "SolarmanV5 duplicate CRC handling (auto, on, off)")
+	flags.Duration(flagTimeout, 5*time.Second, flagTimeoutDescription)
+}
+
+// modbusConnection creates a modbus connection from the command's flags
+func modbusConnection(cmd *cobra.Command) (*modbus.Connection, error) {
+	util.LogLevel(viper.GetString("log"), nil)
+
+	flags := cmd.Flags()
+
+	var cfg modbus.Settings
+	cfg.URI, _ = flags.GetString("uri")
+	cfg.Device, _ = flags.GetString("device")
+	cfg.Baudrate, _ = flags.GetInt("baudrate")
+	cfg.Comset, _ = flags.GetString("comset")
+	cfg.UDP, _ = flags.GetBool("udp")
+	cfg.Ascii, _ = flags.GetBool("ascii")
+	cfg.LocalAddress, _ = flags.GetString("localaddress")
+
+	// uri parameters take precedence over flags
+	if solarmanV5, _ := flags.GetBool("solarmanv5"); solarmanV5 || strings.HasPrefix(strings.ToLower(cfg.URI), "solarmanv5://") {
+		sv := new(modbus.SolarmanV5Settings)
+		sv.Lenient, _ = flags.GetBool("lenient")
+		sv.StrictStatus, _ = flags.GetBool("strictstatus")
+
+		if cfg.UDP {
+			sv.Transport = "udp"
+		}
+
+		if doubleCRC, _ := flags.GetString("doublecrc"); doubleCRC != "" {
+			if err := sv.DoubleCRC.UnmarshalText([]byte(doubleCRC)); err != nil {
+				return nil, err
+			}
+		}
+
+		serial, _ := flags.GetString("serial")
+		if err := sv.LoggerSerial.UnmarshalText([]byte(serial)); err != nil {
+			return nil, err
+		}
+
+		cfg.SolarmanV5 = sv
+	}
+
+	if rtu, _ := flags.GetBool("rtu"); rtu {
+		cfg.RTU = &rtu
+	}
+
+	id, _ := flags.GetInt("id")
+	cfg.ID = uint8(id)
+
+	conn, err := modbus.NewConnectionWithSettings(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, _ := flags.GetDuration(flagTimeout)
+	conn.Timeout(timeout)
+	conn.Logger(log.TRACE)
+
+	return conn, nil
+}
+
+// parseUint16 parses decimal or 0x prefixed hex values
+func parseUint16(s string) (uint16, error) {
+	v, err := strconv.ParseUint(s, 0, 16)
+	return uint16(v), err
+}
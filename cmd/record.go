@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evcc-io/evcc/plugin"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/spf13/cobra"
+)
+
+// recordCmd represents the record command
+var recordCmd = &cobra.Command{
+	Use:   "record <name>",
+	Short: "Record device responses for reproducing device behavior",
+	Long: `Record the plugin responses of a meter, charger or vehicle over the given duration.
+The recording can be replayed using the device type playback, e.g.
+
+  meters:
+  - name: pv
+    type: playback
+    recording: pv.json
+
+Only devices using plugins (custom devices and yaml templates) can be recorded.
+Secret values of the device configuration are redacted, check the recording before sharing.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runRecord,
+}
+
+// recording records the plugin responses of a single device
+var (
+	recordDevice string
+	recording    *plugin.Recording
+)
+
+func init() {
+	rootCmd.AddCommand(recordCmd)
+	recordCmd.Flags().Duration("duration", 10*time.Minute, "Recording duration")
+	recordCmd.Flags().Duration("interval", 10*time.Second, "Query interval")
+	recordCmd.Flags().StringP("file", "f", "", "Recording file (default \"<name>.json\")")
+	recordCmd.Flags().Duration(flagTimeout, 10*time.Second, flagTimeoutDescription)
+}
+
+// deviceContext returns the context for creating a device, recording the device if requested
+func deviceContext(class templates.Class, name, typ string, other map[string]any) context.Context {
+	ctx := util.WithLogger(context.TODO(), util.NewLogger(name))
+
+	if recordDevice != "" && name == recordDevice {
+		recording = plugin.NewRecording(name, class.String(), typ, other)
+		ctx = plugin.WithRecording(ctx, recording)
+	}
+
+	return ctx
+}
+
+func runRecord(cmd *cobra.Command, args []string) {
+	duration, _ := cmd.Flags().GetDuration("duration")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+	file, _ := cmd.Flags().GetString("file")
+	if file == "" {
+		file = args[0] + ".json"
+	}
+
+	// load config
+	if err := loadConfigFile(&conf, !cmd.Flag(flagIgnoreDatabase).Changed); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, &conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	recordDevice = args[0]
+
+	if err := configureMeters(conf.Meters, recordDevice); err != nil {
+		log.FATAL.Fatal(err)
+	}
+	if err := configureChargers(conf.Chargers, recordDevice); err != nil {
+		log.FATAL.Fatal(err)
+	}
+	if err := configureVehicles(conf.Vehicles, recordDevice); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if recording == nil {
+		log.FATAL.Fatalf("device not found: %s", recordDevice)
+	}
+
+	var instance any
+	for _, dev := range config.Meters().Devices() {
+		instance = dev.Instance()
+	}
+	for _, dev := range config.Chargers().Devices() {
+		instance = dev.Instance()
+	}
+	for _, dev := range config.Vehicles().Devices() {
+		instance = dev.Instance()
+	}
+
+	log.INFO.Printf("recording %s for %v", recordDevice, duration)
+
+	// query all device interfaces
+	d := dumper{len: 1, timeout: timeout}
+	end := time.Now().Add(duration)
+
+	for {
+		d.DumpWithHeader(recordDevice, instance)
+
+		if time.Now().Add(interval).After(end) {
+			break
+		}
+
+		time.Sleep(interval)
+	}
+
+	if err := recording.Save(file); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	fmt.Printf("recorded %d values to %s\n", recording.Samples(), file)
+}
@@ -14,10 +14,13 @@ import (
 	"github.com/evcc-io/evcc/util/templates"
 )
 
-var references struct {
+// deviceRefs are the names of devices referenced by site, circuits or loadpoints
+type deviceRefs struct {
 	meter, charger, vehicle, circuit []string
 }
 
+var references deviceRefs
+
 func collectRefs(conf globalconfig.All) error {
 	// site
 	if err := collectSiteRefs(conf); err != nil {
@@ -96,3 +99,37 @@ func collectLoadpointRefs(named iter.Seq[config.Named]) error {
 
 	return nil
 }
+
+// liveRefs collects the device references of the running site, circuits and loadpoints
+func liveRefs(site *core.Site) deviceRefs {
+	var res deviceRefs
+
+	if site != nil {
+		res.meter = append(res.meter, site.GetGridMeterRef())
+		res.meter = append(res.meter, site.GetPVMeterRefs()...)
+		res.meter = append(res.meter, site.GetBatteryMeterRefs()...)
+		res.meter = append(res.meter, site.GetExtMeterRefs()...)
+		res.meter = append(res.meter, site.GetAuxMeterRefs()...)
+	}
+
+	for _, dev := range config.Circuits().Devices() {
+		var cc struct {
+			MeterRef string         `mapstructure:"meter"`
+			Other    map[string]any `mapstructure:",remain"`
+		}
+
+		if err := util.DecodeOther(dev.Config().Other, &cc); err == nil {
+			res.meter = append(res.meter, cc.MeterRef)
+		}
+	}
+
+	for _, dev := range config.Loadpoints().Devices() {
+		lp := dev.Instance()
+		res.meter = append(res.meter, lp.GetMeterRef(), lp.GetBillingMeterRef())
+		res.charger = append(res.charger, lp.GetChargerRef())
+		res.vehicle = append(res.vehicle, lp.GetDefaultVehicleRef())
+		res.circuit = append(res.circuit, lp.GetCircuitRef())
+	}
+
+	return res
+}
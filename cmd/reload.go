@@ -0,0 +1,372 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/api/globalconfig"
+	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/meter"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/diagnostics"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce delays the reload until editors have finished writing the config file
+const reloadDebounce = time.Second
+
+// fileConfig is the config file content as of the last (re)load
+type fileConfig struct {
+	settings map[string]any
+	devices  map[templates.Class][]config.Named
+}
+
+var (
+	reloadMu sync.Mutex
+	loaded   fileConfig
+)
+
+// reloadKeys are config file sections that are applied at runtime
+var reloadKeys = []string{"meters", "chargers", "vehicles", "log", "levels"}
+
+// readFileConfig decodes the current config file content
+func readFileConfig() (fileConfig, error) {
+	var conf globalconfig.All
+	if err := viper.UnmarshalExact(&conf); err != nil {
+		return fileConfig{}, fmt.Errorf("failed parsing config file: %w", err)
+	}
+
+	return fileConfig{
+		settings: viper.AllSettings(),
+		devices: map[templates.Class][]config.Named{
+			templates.Meter:   conf.Meters,
+			templates.Charger: conf.Chargers,
+			templates.Vehicle: conf.Vehicles,
+		},
+	}, nil
+}
+
+// reloadConfig re-reads the config file and database device configurations and applies
+// device additions, removals and updates where safe. Devices referenced by the running site,
+// circuits or loadpoints and all other config sections require a restart.
+func reloadConfig(site *core.Site) (server.ReloadResult, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	var res server.ReloadResult
+
+	refs := liveRefs(site)
+
+	if viper.ConfigFileUsed() != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			return res, fmt.Errorf("failed reading config file: %w", err)
+		}
+
+		next, err := readFileConfig()
+		if err != nil {
+			return res, err
+		}
+
+		if !reflect.DeepEqual(loaded.settings["log"], next.settings["log"]) || !reflect.DeepEqual(loaded.settings["levels"], next.settings["levels"]) {
+			parseLogLevels()
+			res.Updated = append(res.Updated, "log")
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(mergeKeys(loaded.settings, next.settings))) {
+			if !slices.Contains(reloadKeys, key) && !reflect.DeepEqual(loaded.settings[key], next.settings[key]) {
+				res.Restart = append(res.Restart, key)
+			}
+		}
+
+		reloadStatic(&res, templates.Meter, config.Meters(), createMeter, refs.meter, loaded.devices[templates.Meter], next.devices[templates.Meter])
+		reloadStatic(&res, templates.Charger, config.Chargers(), createCharger, refs.charger, loaded.devices[templates.Charger], next.devices[templates.Charger])
+		reloadStatic(&res, templates.Vehicle, config.Vehicles(), vehicleInstance, refs.vehicle, loaded.devices[templates.Vehicle], next.devices[templates.Vehicle])
+
+		loaded = next
+	}
+
+	err := errors.Join(
+		reloadConfigurable(&res, templates.Meter, config.Meters(), createMeter, refs.meter),
+		reloadConfigurable(&res, templates.Charger, config.Chargers(), createCharger, refs.charger),
+		reloadConfigurable(&res, templates.Vehicle, config.Vehicles(), vehicleInstance, refs.vehicle),
+	)
+
+	if res.RestartRequired() {
+		server.SetConfigDirty()
+	}
+
+	return res, err
+}
+
+func mergeKeys(a, b map[string]any) map[string]any {
+	res := maps.Clone(a)
+	if res == nil {
+		res = make(map[string]any)
+	}
+	maps.Copy(res, b)
+	return res
+}
+
+func createMeter(cc config.Named) (api.Meter, error) {
+	ctx := deviceContext(templates.Meter, cc.Name, cc.Type, cc.Other)
+	return meter.NewFromConfig(ctx, cc.Type, cc.Other)
+}
+
+func createCharger(cc config.Named) (api.Charger, error) {
+	ctx := deviceContext(templates.Charger, cc.Name, cc.Type, cc.Other)
+	return charger.NewFromConfig(ctx, cc.Type, cc.Other)
+}
+
+// reloadStatic applies config file device changes
+func reloadStatic[T any](res *server.ReloadResult, class templates.Class, h config.Handler[T], create func(config.Named) (T, error), refs []string, prev, next []config.Named) {
+	byName := func(devs []config.Named, name string) (config.Named, bool) {
+		idx := slices.IndexFunc(devs, func(cc config.Named) bool { return cc.Name == name })
+		if idx < 0 {
+			return config.Named{}, false
+		}
+		return devs[idx], true
+	}
+
+	for _, cc := range prev {
+		if _, ok := byName(next, cc.Name); !ok {
+			removeDevice(res, class, h, refs, cc.Name)
+		}
+	}
+
+	for _, cc := range next {
+		old, ok := byName(prev, cc.Name)
+		if ok && reflect.DeepEqual(old, cc) {
+			continue
+		}
+
+		id := deviceID(class, cc.Name)
+
+		dev, err := h.ByName(cc.Name)
+		switch {
+		case err == nil && slices.Contains(refs, cc.Name):
+			res.Restart = append(res.Restart, id)
+			continue
+		case err == nil:
+			// name taken by database device
+			if _, ok := dev.(config.ConfigurableDevice[T]); ok {
+				res.Failed = append(res.Failed, fmt.Sprintf("%s: duplicate name", id))
+				continue
+			}
+		}
+
+		instance, err := create(cc)
+		if err != nil {
+			res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+
+		replaceDevice(res, h, dev, config.NewStaticDevice(cc, instance), id)
+	}
+}
+
+// reloadConfigurable applies database device changes that were not made via the api
+func reloadConfigurable[T any](res *server.ReloadResult, class templates.Class, h config.Handler[T], create func(config.Named) (T, error), refs []string) error {
+	configurable, err := config.ConfigurationsByClass(class)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[string]config.ConfigurableDevice[T])
+	for _, dev := range h.Devices() {
+		if cd, ok := dev.(config.ConfigurableDevice[T]); ok {
+			running[cd.Config().Name] = cd
+		}
+	}
+
+	for _, conf := range configurable {
+		cc := conf.Named()
+		id := deviceID(class, cc.Name)
+
+		dev, ok := running[cc.Name]
+		delete(running, cc.Name)
+
+		switch {
+		case ok && reflect.DeepEqual(dev.Config(), cc):
+			continue
+		case ok && slices.Contains(refs, cc.Name):
+			res.Restart = append(res.Restart, id)
+			continue
+		}
+
+		props, err := customDevice(cc.Other)
+		if err != nil {
+			res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+
+		instance, err := create(config.Named{Name: cc.Name, Type: cc.Type, Other: props})
+		if err != nil {
+			res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+
+		var old config.Device[T]
+		if ok {
+			old = dev
+		}
+
+		replaceDevice(res, h, old, config.NewConfigurableDevice(&conf, instance), id)
+	}
+
+	for name := range running {
+		removeDevice(res, class, h, refs, name)
+	}
+
+	return nil
+}
+
+// replaceDevice adds the device or replaces the running device of the same name
+func replaceDevice[T any](res *server.ReloadResult, h config.Handler[T], old, dev config.Device[T], id string) {
+	if old != nil {
+		if err := h.Delete(old.Config().Name); err != nil {
+			res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+			return
+		}
+	}
+
+	if old != nil {
+		closeDevice(old.Instance(), id)
+	}
+
+	if err := h.Add(dev); err != nil {
+		res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+		return
+	}
+
+	if old != nil {
+		res.Updated = append(res.Updated, id)
+	} else {
+		res.Added = append(res.Added, id)
+	}
+}
+
+// removeDevice removes the running device unless it is in use
+func removeDevice[T any](res *server.ReloadResult, class templates.Class, h config.Handler[T], refs []string, name string) {
+	id := deviceID(class, name)
+
+	dev, err := h.ByName(name)
+	if err != nil {
+		return
+	}
+
+	if slices.Contains(refs, name) {
+		res.Restart = append(res.Restart, id)
+		return
+	}
+
+	if err := h.Delete(name); err != nil {
+		res.Failed = append(res.Failed, fmt.Sprintf("%s: %v", id, err))
+		return
+	}
+
+	closeDevice(dev.Instance(), id)
+
+	res.Removed = append(res.Removed, id)
+}
+
+// closeDevice releases the resources of a replaced or removed device instance
+func closeDevice(instance any, id string) {
+	diagnostics.Unregister(instance)
+
+	if c, ok := instance.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			log.ERROR.Printf("config reload: close %s: %v", id, err)
+		}
+	}
+}
+
+func deviceID(class templates.Class, name string) string {
+	return class.String() + "/" + name
+}
+
+// logReload logs the reload result
+func logReload(res server.ReloadResult, err error) {
+	if err != nil {
+		log.ERROR.Printf("config reload: %v", err)
+		return
+	}
+
+	for _, v := range []struct {
+		action string
+		ids    []string
+	}{
+		{"added", res.Added},
+		{"updated", res.Updated},
+		{"removed", res.Removed},
+		{"failed", res.Failed},
+	} {
+		if len(v.ids) > 0 {
+			log.INFO.Printf("config reload: %s %v", v.action, v.ids)
+		}
+	}
+
+	if res.RestartRequired() {
+		log.WARN.Printf("config reload: restart required to apply %v", res.Restart)
+	}
+}
+
+// watchConfig reloads the configuration when the config file changes
+func watchConfig(file string, site *core.Site) error {
+	file, err := filepath.Abs(file)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	// watch directory since editors may replace the file
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	timer := time.AfterFunc(reloadDebounce, func() {
+		logReload(reloadConfig(site))
+	})
+	timer.Stop()
+
+	go func() {
+		defer watcher.Close()
+
+		for {
+			select {
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if filepath.Clean(ev.Name) == file && ev.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					timer.Reset(reloadDebounce)
+				}
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				log.ERROR.Printf("config watch: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type closingMeter struct {
+	*api.MockMeter
+	closed bool
+}
+
+func (m *closingMeter) Close() error {
+	m.closed = true
+	return nil
+}
+
+func TestReloadStatic(t *testing.T) {
+	config.Reset()
+
+	ctrl := gomock.NewController(t)
+	create := func(config.Named) (api.Meter, error) {
+		return api.NewMockMeter(ctrl), nil
+	}
+
+	named := func(name string, power float64) config.Named {
+		return config.Named{Name: name, Type: "custom", Other: map[string]any{"power": power}}
+	}
+
+	prev := []config.Named{named("grid", 1), named("pv", 1), named("aux", 1), named("unused", 1)}
+	next := []config.Named{named("grid", 2), named("pv", 1), named("aux", 2), named("new", 1)}
+
+	closers := make(map[string]*closingMeter)
+	for _, cc := range prev {
+		m := &closingMeter{MockMeter: api.NewMockMeter(ctrl)}
+		closers[cc.Name] = m
+		require.NoError(t, config.Meters().Add(config.NewStaticDevice(cc, api.Meter(m))))
+	}
+
+	refs := []string{"grid", "pv"}
+
+	var res server.ReloadResult
+	reloadStatic(&res, templates.Meter, config.Meters(), create, refs, prev, next)
+
+	require.Equal(t, []string{"meter/new"}, res.Added)
+	require.Equal(t, []string{"meter/aux"}, res.Updated)
+	require.Equal(t, []string{"meter/unused"}, res.Removed)
+	require.Equal(t, []string{"meter/grid"}, res.Restart)
+	require.Empty(t, res.Failed)
+
+	dev, err := config.Meters().ByName("aux")
+	require.NoError(t, err)
+	require.Equal(t, named("aux", 2), dev.Config())
+
+	_, err = config.Meters().ByName("unused")
+	require.Error(t, err)
+
+	// replaced and removed instances are closed
+	require.True(t, closers["aux"].closed)
+	require.True(t, closers["unused"].closed)
+	require.False(t, closers["grid"].closed)
+	require.False(t, closers["pv"].closed)
+}
+
+func TestLiveRefs(t *testing.T) {
+	config.Reset()
+
+	require.NoError(t, config.Circuits().Add(config.NewStaticDevice(config.Named{
+		Name:  "main",
+		Other: map[string]any{"meter": "grid", "maxPower": 10000},
+	}, api.Circuit(nil))))
+
+	refs := liveRefs(nil)
+	require.Equal(t, []string{"grid"}, refs.meter)
+	require.Empty(t, refs.charger)
+}
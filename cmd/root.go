@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/core/chargerconfig"
+	"github.com/evcc-io/evcc/core/donation"
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
@@ -21,10 +23,12 @@ import (
 	"github.com/evcc-io/evcc/server/updater"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/auth"
+	"github.com/evcc-io/evcc/util/feature"
 	"github.com/evcc-io/evcc/util/pipe"
 	"github.com/evcc-io/evcc/util/sponsor"
 	"github.com/evcc-io/evcc/util/telemetry"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -168,9 +172,22 @@ func runRoot(cmd *cobra.Command, args []string) {
 	cache := util.NewParamCache()
 	go cache.Run(pipe.NewDropper(ignoreLogs...).Pipe(tee.Attach()))
 
+	authObject := auth.New()
+	if ok, _ := cmd.Flags().GetBool(flagDisableAuth); ok {
+		log.WARN.Println("❗❗❗ Authentication is disabled. This is dangerous. Your data and credentials are not protected.")
+		authObject.SetAuthMode(auth.Disabled)
+		valueChan <- util.Param{Key: keys.AuthDisabled, Val: true}
+	}
+
+	if ok, _ := cmd.Flags().GetBool(flagDemoMode); ok {
+		log.WARN.Println("Authentication is locked in demo mode. Login-features are disabled.")
+		authObject.SetAuthMode(auth.Locked)
+		valueChan <- util.Param{Key: keys.DemoMode, Val: true}
+	}
+
 	// create web server
 	socketHub := server.NewSocketHub()
-	httpd := server.NewHTTPd(fmt.Sprintf(":%d", conf.Network.Port), socketHub, customCssFile)
+	httpd := server.NewHTTPd(fmt.Sprintf(":%d", conf.Network.Port), socketHub, authObject, customCssFile)
 
 	// metrics
 	if viper.GetBool("metrics") {
@@ -196,25 +213,55 @@ func runRoot(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// setup feature flags
+	if err == nil {
+		feature.Create(valueChan)
+	}
+
+	// setup data donation
+	if err == nil {
+		err = wrapErrorWithClass(ClassDonation, donation.Configure(conf.Donation))
+	}
+
 	// setup modbus proxy
 	if err == nil {
 		err = wrapErrorWithClass(ClassModbusProxy, configureModbusProxy(&conf.ModbusProxy))
 	}
 
 	// setup site and loadpoints
-	var site *core.Site
+	var (
+		site     *core.Site
+		observer *server.Observer
+	)
 	if err == nil {
-		site, err = configureSiteAndLoadpoints(&conf)
+		if conf.Observer.URI != "" {
+			observer, err = configureObserver(conf.Observer, valueChan)
+		} else {
+			site, err = configureSiteAndLoadpoints(&conf)
+		}
 	}
 
-	// setup influx
-	if err == nil {
+	// setup time-series recorders
+	if err == nil && site != nil {
+		var recorders []server.Recorder
+
 		influx, ierr := configureInflux(&conf.Influx)
 		if ierr != nil {
 			err = wrapErrorWithClass(ClassInflux, ierr)
+		} else if influx != nil {
+			recorders = append(recorders, influx)
+		}
+
+		if err == nil {
+			postgres, perr := configurePostgres(&conf.Postgres)
+			if perr != nil {
+				err = wrapErrorWithClass(ClassDatabase, perr)
+			} else if postgres != nil {
+				recorders = append(recorders, postgres)
+			}
 		}
 
-		if err == nil && influx != nil {
+		for _, recorder := range recorders {
 			// eliminate duplicate values
 			dedupe := pipe.NewDeduplicator(30*time.Minute,
 				keys.VehicleSoc,
@@ -230,7 +277,7 @@ func runRoot(cmd *cobra.Command, args []string) {
 				keys.TariffSolar,
 				keys.ChargedEnergy,
 				keys.ChargeRemainingEnergy)
-			go influx.Run(site, dedupe.Pipe(
+			go recorder.Run(site, dedupe.Pipe(
 				pipe.NewDropper(append(ignoreLogs, ignoreEmpty, keys.Forecast)...).Pipe(tee.Attach()),
 			))
 		}
@@ -240,7 +287,7 @@ func runRoot(cmd *cobra.Command, args []string) {
 	valueChan <- util.Param{Key: keys.Startup, Val: true}
 
 	// setup mqtt publisher
-	if err == nil && conf.Mqtt.Broker != "" && conf.Mqtt.Topic != "" {
+	if err == nil && site != nil && conf.Mqtt.Broker != "" && conf.Mqtt.Topic != "" {
 		var mqtt *server.MQTT
 		mqtt, err = server.NewMQTT(strings.Trim(conf.Mqtt.Topic, "/"), site)
 		if err == nil {
@@ -248,18 +295,24 @@ func runRoot(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// setup prometheus publisher
+	if err == nil && site != nil && viper.GetBool("metrics") {
+		prom := server.NewPrometheus(prometheus.DefaultRegisterer)
+		go prom.Run(site, pipe.NewDropper(append(ignoreLogs, ignoreEmpty)...).Pipe(tee.Attach()))
+	}
+
 	// announce on mDNS
 	if err == nil && strings.HasSuffix(conf.Network.Host, ".local") {
 		err = configureMDNS(conf.Network)
 	}
 
 	// start SHM server
-	if err == nil {
+	if err == nil && site != nil {
 		err = wrapErrorWithClass(ClassSHM, configureSHM(&conf.SHM, site, httpd))
 	}
 
 	// start HEMS server
-	if err == nil {
+	if err == nil && site != nil {
 		err = wrapErrorWithClass(ClassHEMS, configureHEMS(&conf.HEMS, site))
 	}
 
@@ -273,9 +326,14 @@ func runRoot(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// charger configuration snapshots
+	if err == nil {
+		go chargerconfig.Run(util.NewLogger("charger"), chargerconfig.Interval)
+	}
+
 	// setup messaging
 	var pushChan chan push.Event
-	if err == nil {
+	if err == nil && site != nil {
 		pushChan, err = configureMessengers(&conf.Messaging, site.Vehicles(), valueChan, cache)
 		err = wrapErrorWithClass(ClassMessenger, err)
 	}
@@ -326,24 +384,13 @@ func runRoot(cmd *cobra.Command, args []string) {
 	// allow web access for vehicles
 	configureAuth(httpd.Router(), valueChan)
 
-	authObject := auth.New()
-	if ok, _ := cmd.Flags().GetBool(flagDisableAuth); ok {
-		log.WARN.Println("❗❗❗ Authentication is disabled. This is dangerous. Your data and credentials are not protected.")
-		authObject.SetAuthMode(auth.Disabled)
-		valueChan <- util.Param{Key: keys.AuthDisabled, Val: true}
-	}
-
-	if ok, _ := cmd.Flags().GetBool(flagDemoMode); ok {
-		log.WARN.Println("Authentication is locked in demo mode. Login-features are disabled.")
-		authObject.SetAuthMode(auth.Locked)
-		valueChan <- util.Param{Key: keys.DemoMode, Val: true}
-	}
-
 	httpd.RegisterSystemHandler(site, valueChan, cache, authObject, func() {
 		log.INFO.Println("evcc was stopped by user. OS should restart the service. Or restart manually.")
 		err = errors.New("restart required") // https://gokrazy.org/development/process-interface/
 		once.Do(func() { close(stopC) })     // signal loop to end
-	}, viper.ConfigFileUsed())
+	}, func() (server.ReloadResult, error) {
+		return reloadConfig(site)
+	}, viper.ConfigFileUsed(), conf.Observer.URI != "")
 
 	// show and check version, reduce api load during development
 	if util.Version != util.DevVersion {
@@ -351,13 +398,20 @@ func runRoot(cmd *cobra.Command, args []string) {
 	}
 
 	// setup site
-	if err == nil {
+	if err == nil && site != nil {
 		// set channels
 		site.DumpConfig()
 		site.Prepare(valueChan, pushChan)
 
 		httpd.RegisterSiteHandlers(site, valueChan)
 
+		// apply config file changes at runtime
+		if cfgFile := viper.ConfigFileUsed(); cfgFile != "" {
+			if err := watchConfig(cfgFile, site); err != nil {
+				log.ERROR.Printf("config watch: %v", err)
+			}
+		}
+
 		go func() {
 			site.Run(stopC, conf.Interval)
 		}()
@@ -380,8 +434,16 @@ func runRoot(cmd *cobra.Command, args []string) {
 		}()
 	}
 
-	// uds health check listener
-	go server.HealthListener(site)
+	// uds health check listener, avoid typed nil interfaces
+	var health server.HealthChecker
+	switch {
+	case site != nil:
+		health = site
+	case observer != nil:
+		health = observer
+	}
+
+	go server.HealthListener(health)
 
 	log.FATAL.Println(wrapFatalError(httpd.ListenAndServe()))
 }
@@ -105,6 +105,12 @@ func loadConfigFile(conf *globalconfig.All, checkDB bool) error {
 		return fmt.Errorf("failed parsing config file: %w", err)
 	}
 
+	// keep file content for detecting changes on reload
+	var err error
+	if loaded, err = readFileConfig(); err != nil {
+		return err
+	}
+
 	// user did not specify a database path
 	if conf.Database.Dsn == "" && checkDB {
 		// check if service database exists
@@ -240,7 +246,7 @@ func configureMeters(static []config.Named, names ...string) error {
 		}
 
 		eg.Go(func() error {
-			ctx := util.WithLogger(context.TODO(), util.NewLogger(cc.Name))
+			ctx := deviceContext(templates.Meter, cc.Name, cc.Type, cc.Other)
 
 			instance, err := meter.NewFromConfig(ctx, cc.Type, cc.Other)
 			if err != nil {
@@ -269,8 +275,6 @@ func configureMeters(static []config.Named, names ...string) error {
 				return nil
 			}
 
-			ctx := util.WithLogger(context.TODO(), util.NewLogger(cc.Name))
-
 			props, err := customDevice(cc.Other)
 			if err != nil {
 				err = &DeviceError{cc.Name, fmt.Errorf("cannot decode custom meter '%s': %w", cc.Name, err)}
@@ -278,6 +282,7 @@ func configureMeters(static []config.Named, names ...string) error {
 
 			var instance api.Meter
 			if err == nil {
+				ctx := deviceContext(templates.Meter, cc.Name, cc.Type, props)
 				instance, err = meter.NewFromConfig(ctx, cc.Type, props)
 				if err != nil {
 					err = &DeviceError{cc.Name, fmt.Errorf("cannot create meter '%s': %w", cc.Name, err)}
@@ -312,7 +317,7 @@ func configureChargers(static []config.Named, names ...string) error {
 		}
 
 		eg.Go(func() error {
-			ctx := util.WithLogger(context.TODO(), util.NewLogger(cc.Name))
+			ctx := deviceContext(templates.Charger, cc.Name, cc.Type, cc.Other)
 
 			instance, err := charger.NewFromConfig(ctx, cc.Type, cc.Other)
 			if err != nil {
@@ -341,8 +346,6 @@ func configureChargers(static []config.Named, names ...string) error {
 				return nil
 			}
 
-			ctx := util.WithLogger(context.TODO(), util.NewLogger(cc.Name))
-
 			props, err := customDevice(cc.Other)
 			if err != nil {
 				err = &DeviceError{cc.Name, fmt.Errorf("cannot decode custom charger '%s': %w", cc.Name, err)}
@@ -350,6 +353,7 @@ func configureChargers(static []config.Named, names ...string) error {
 
 			var instance api.Charger
 			if err == nil {
+				ctx := deviceContext(templates.Charger, cc.Name, cc.Type, props)
 				instance, err = charger.NewFromConfig(ctx, cc.Type, props)
 				if err != nil {
 					err = &DeviceError{cc.Name, fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)}
@@ -368,12 +372,11 @@ func configureChargers(static []config.Named, names ...string) error {
 }
 
 func vehicleInstance(cc config.Named) (api.Vehicle, error) {
-	ctx := util.WithLogger(context.TODO(), util.NewLogger(cc.Name))
-
 	props, err := customDevice(cc.Other)
 
 	var instance api.Vehicle
 	if err == nil {
+		ctx := deviceContext(templates.Vehicle, cc.Name, cc.Type, props)
 		instance, err = vehicle.NewFromConfig(ctx, cc.Type, props)
 	}
 
@@ -633,11 +636,28 @@ func configureInflux(conf *globalconfig.Influx) (*server.Influx, error) {
 		conf.Password,
 		conf.Database,
 		conf.Insecure,
+		conf.BufferSize,
+		conf.BufferTime,
+		conf.Resolution,
 	)
 
 	return influx, nil
 }
 
+// configurePostgres configures postgres time-series database
+func configurePostgres(conf *globalconfig.Postgres) (*server.Postgres, error) {
+	if conf.URL == "" {
+		return nil, nil
+	}
+
+	table := conf.Table
+	if table == "" {
+		table = "evcc"
+	}
+
+	return server.NewPostgresClient(context.Background(), conf.URL, table)
+}
+
 // setup mqtt
 func configureMqtt(conf *globalconfig.Mqtt) error {
 	// migrate settings
@@ -799,11 +819,21 @@ func configureMessengers(conf *globalconfig.Messaging, vehicles push.Vehicles, v
 
 	messageChan := make(chan push.Event, 1)
 
-	messageHub, err := push.NewHub(conf.Events, vehicles, cache)
+	messageHub, err := push.NewHub(conf.Events, conf.Defaults, conf.Language, vehicles, cache)
 	if err != nil {
 		return messageChan, fmt.Errorf("failed configuring push services: %w", err)
 	}
 
+	// per-service event templates
+	var overrides globalconfig.MessagingEvents
+	if settings.Exists(keys.MessagingEvents) {
+		if err := settings.Json(keys.MessagingEvents, &overrides); err != nil {
+			return messageChan, fmt.Errorf("failed configuring push service events: %w", err)
+		}
+	}
+
+	services := make(globalconfig.MessagingEvents)
+
 	for _, conf := range conf.Services {
 		props, err := customDevice(conf.Other)
 		if err != nil {
@@ -814,9 +844,17 @@ func configureMessengers(conf *globalconfig.Messaging, vehicles push.Vehicles, v
 		if err != nil {
 			return messageChan, fmt.Errorf("failed configuring push service %s: %w", conf.Type, err)
 		}
-		messageHub.Add(impl)
+
+		id := push.ServiceID(conf.Type, props)
+		services[id] = overrides[id]
+
+		if err := messageHub.Add(id, impl, overrides[id]); err != nil {
+			return messageChan, fmt.Errorf("failed configuring push service %s: %w", conf.Type, err)
+		}
 	}
 
+	valueChan <- util.Param{Key: keys.MessagingEvents, Val: services}
+
 	go messageHub.Run(messageChan, valueChan)
 
 	return messageChan, nil
@@ -975,6 +1013,20 @@ func configureModbusProxy(conf *[]globalconfig.ModbusProxy) error {
 	return nil
 }
 
+// configureObserver mirrors the primary instance instead of running a site
+func configureObserver(conf globalconfig.Observer, valueChan chan<- util.Param) (*server.Observer, error) {
+	observer, err := server.NewObserver(conf.URI)
+	if err != nil {
+		return nil, fmt.Errorf("failed configuring observer: %w", err)
+	}
+
+	log.INFO.Printf("observer mode: mirroring %s", conf.URI)
+
+	go observer.Run(valueChan)
+
+	return observer, nil
+}
+
 func configureSiteAndLoadpoints(conf *globalconfig.All) (*core.Site, error) {
 	// migrate settings
 	if settings.Exists(keys.Interval) {
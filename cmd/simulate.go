@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/spf13/cobra"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate control loop without sending charger commands",
+	Long: `Simulate loads the full configuration, reads all devices and runs the control loop
+without sending commands to chargers and vehicles, changing battery modes or limiting
+pv production. The decisions that would have been made are printed for each iteration.
+Sessions are not recorded.
+Stop any running evcc instance before simulating, since devices and database are shared.`,
+	Run: runSimulate,
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().IntP("iterations", "n", 5, "Number of control loop iterations")
+	simulateCmd.Flags().Duration("interval", 0, "Interval between iterations (default config interval)")
+}
+
+func runSimulate(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf, !cmd.Flag(flagIgnoreDatabase).Changed); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, &conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	site, err := configureSiteAndLoadpoints(&conf)
+	if err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	iterations, _ := cmd.Flags().GetInt("iterations")
+
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval == 0 {
+		interval = conf.Interval
+	}
+
+	// discard ui and push messages
+	valueChan := make(chan util.Param)
+	pushChan := make(chan push.Event)
+
+	go func() {
+		for {
+			select {
+			case <-valueChan:
+			case <-pushChan:
+			}
+		}
+	}()
+
+	site.Prepare(valueChan, pushChan)
+
+	if err := site.Simulate(iterations, interval, printSimulationStep); err != nil {
+		log.FATAL.Fatal(err)
+	}
+}
+
+func printSimulationStep(step core.SimulationStep) {
+	lp := step.Loadpoint
+
+	fmt.Printf("iteration %d: grid %.0fW, pv %.0fW, battery %.0fW\n", step.Iteration, step.GridPower, step.PvPower, step.BatteryPower)
+	fmt.Printf("  loadpoint %s: mode %s, status %s, charge power %.0fW, phases %d\n", lp.GetTitle(), lp.GetMode(), lp.GetStatus(), lp.GetChargePower(), lp.GetPhases())
+
+	if len(step.Commands) == 0 {
+		fmt.Println("    no charger commands")
+	}
+
+	for _, e := range step.Commands {
+		fmt.Printf("    would send %s: %v\n", e.Command, e.Value)
+	}
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/meter"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/evcc-io/evcc/vehicle"
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v4"
+)
+
+// emulatorSerial is the logger serial of the SolarmanV5 emulator
+const emulatorSerial = 2700000000
+
+// templateTestCmd represents the template test command
+var templateTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Render template, create device and print all readable values",
+	Long: `Render the template given by --template, create the device and print all readable values.
+--template accepts a template name or a template file, use --template-type for non-meter templates.
+With --emulate, modbus templates connect to an in-process SolarmanV5 logger emulator
+returning the given register values and zero for all other registers.`,
+	Example: `  evcc template test --template deye-hybrid-3p --values usage=pv,modbus=tcpip,host=192.0.2.10,solarmanv5=true
+  evcc template test --template deye-hybrid-3p --values usage=grid --emulate --registers 625=1500`,
+	Args: cobra.NoArgs,
+	Run:  runTemplateTest,
+}
+
+func init() {
+	templateCmd.AddCommand(templateTestCmd)
+	templateTestCmd.Flags().StringToString("values", nil, "Template parameter values (key=value,...)")
+	templateTestCmd.Flags().Bool("emulate", false, "Connect to SolarmanV5 logger emulator instead of device")
+	templateTestCmd.Flags().StringToString("registers", nil, "Emulator register values (address=value,...)")
+	templateTestCmd.Flags().Bool(flagDiagnose, false, flagDiagnoseDescription)
+}
+
+// templateName registers the template file if name is a file and returns the template name
+func templateName(class templates.Class, name string) (string, error) {
+	b, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return name, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	var def struct {
+		Template string `yaml:"template"`
+	}
+
+	if err := yaml.Unmarshal(b, &def); err != nil {
+		return "", err
+	}
+
+	return def.Template, templates.Register(class, name)
+}
+
+// findTemplate returns the template by name or unique partial name
+func findTemplate(class templates.Class, name string) (templates.Template, error) {
+	if tmpl, err := templates.ByName(class, name); err == nil {
+		return tmpl, nil
+	}
+
+	var res []templates.Template
+	for _, tmpl := range templates.ByClass(class, templates.WithDeprecated()) {
+		if strings.Contains(tmpl.Template, name) {
+			res = append(res, tmpl)
+		}
+	}
+
+	switch len(res) {
+	case 0:
+		return templates.Template{}, fmt.Errorf("template not found: %s", name)
+	case 1:
+		return res[0], nil
+	default:
+		var names []string
+		for _, tmpl := range res {
+			names = append(names, tmpl.Template)
+		}
+		return templates.Template{}, fmt.Errorf("ambiguous template %s: %s", name, strings.Join(names, ", "))
+	}
+}
+
+// parseRegisterValue parses a signed or unsigned 16 bit register value
+func parseRegisterValue(s string) (uint16, error) {
+	if u, err := strconv.ParseUint(s, 0, 16); err == nil {
+		return uint16(u), nil
+	}
+
+	i, err := strconv.ParseInt(s, 0, 16)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint16(int16(i)), nil
+}
+
+// startEmulator starts the emulator and points the modbus values to it
+func startEmulator(tmpl templates.Template, values map[string]any, registers map[string]string) (*modbus.SolarmanV5Emulator, error) {
+	if len(tmpl.ModbusChoices()) == 0 {
+		return nil, fmt.Errorf("template %s does not support modbus", tmpl.Template)
+	}
+
+	id := 1
+	if _, p := tmpl.ParamByName(templates.ParamModbus); p.ID > 0 {
+		id = p.ID
+	}
+
+	if v, ok := values["id"]; ok {
+		var err error
+		if id, err = strconv.Atoi(fmt.Sprint(v)); err != nil {
+			return nil, fmt.Errorf("invalid id: %w", err)
+		}
+	}
+
+	e, err := modbus.NewSolarmanV5Emulator(emulatorSerial)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Zero = true
+
+	for k, v := range registers {
+		addr, err := strconv.ParseUint(k, 0, 16)
+		if err != nil {
+			e.Close()
+			return nil, fmt.Errorf("invalid register address: %w", err)
+		}
+
+		val, err := parseRegisterValue(v)
+		if err != nil {
+			e.Close()
+			return nil, fmt.Errorf("invalid register value: %w", err)
+		}
+
+		e.SetRegisters(byte(id), uint16(addr), val)
+	}
+
+	host, port, err := net.SplitHostPort(e.Addr())
+	if err != nil {
+		e.Close()
+		return nil, err
+	}
+
+	values[templates.ParamModbus] = "tcpip"
+	values["solarmanv5"] = true
+	values["loggerserial"] = emulatorSerial
+	values["host"] = host
+	values["port"] = port
+
+	return e, nil
+}
+
+func newFromTemplate(ctx context.Context, class templates.Class, values map[string]any) (any, error) {
+	switch class {
+	case templates.Meter:
+		return meter.NewFromConfig(ctx, "template", values)
+	case templates.Charger:
+		return charger.NewFromConfig(ctx, "template", values)
+	case templates.Vehicle:
+		return vehicle.NewFromConfig(ctx, "template", values)
+	default:
+		return nil, fmt.Errorf("unsupported template type: %s", class)
+	}
+}
+
+func runTemplateTest(cmd *cobra.Command, args []string) {
+	if err := templateTest(cmd); err != nil {
+		log.FATAL.Fatal(err)
+	}
+}
+
+// templateTest renders the template and prints the device values.
+// Errors are returned to make sure the emulator is closed before exiting.
+func templateTest(cmd *cobra.Command) error {
+	name := cmd.Flag(flagTemplate).Value.String()
+	if name == "" {
+		return errors.New("missing --template")
+	}
+
+	class := templates.Meter
+	if typ := cmd.Flag(flagTemplateType).Value.String(); typ != "" {
+		var err error
+		if class, err = templates.ClassString(typ); err != nil {
+			return err
+		}
+	}
+
+	name, err := templateName(class, name)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := findTemplate(class, name)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]any)
+	flagValues, _ := cmd.Flags().GetStringToString("values")
+	for k, v := range flagValues {
+		values[k] = v
+	}
+
+	if ok, _ := cmd.Flags().GetBool("emulate"); ok {
+		registers, _ := cmd.Flags().GetStringToString("registers")
+
+		e, err := startEmulator(tmpl, values, registers)
+		if err != nil {
+			return err
+		}
+		defer e.Close()
+	}
+
+	values["template"] = tmpl.Template
+
+	b, _, err := tmpl.RenderResult(templates.RenderModeInstance, values)
+	if err != nil {
+		return err
+	}
+
+	d := dumper{len: 2}
+
+	d.Header("config", "=")
+	fmt.Println(string(b))
+	fmt.Println()
+
+	instance, err := newFromTemplate(deviceContext(class, tmpl.Template, "template", values), class, values)
+	if err != nil {
+		return err
+	}
+
+	d.Header("values", "=")
+	d.Dump(tmpl.Template, instance)
+
+	if ok, _ := cmd.Flags().GetBool(flagDiagnose); ok {
+		d.DumpDiagnosis(instance)
+	}
+
+	return nil
+}
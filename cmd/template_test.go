@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindTemplate(t *testing.T) {
+	tmpl, err := findTemplate(templates.Meter, "deye-hybrid-3p")
+	require.NoError(t, err)
+	assert.Equal(t, "deye-hybrid-3p", tmpl.Template)
+
+	tmpl, err = findTemplate(templates.Meter, "hybrid-hp3")
+	require.NoError(t, err)
+	assert.Equal(t, "deye-hybrid-hp3", tmpl.Template)
+
+	_, err = findTemplate(templates.Meter, "deye-hybrid")
+	require.NoError(t, err, "covered name")
+
+	_, err = findTemplate(templates.Meter, "deye-hy")
+	require.ErrorContains(t, err, "ambiguous")
+}
+
+func TestStartEmulator(t *testing.T) {
+	tmpl, err := findTemplate(templates.Meter, "deye-hybrid-3p")
+	require.NoError(t, err)
+
+	values := map[string]any{"usage": "grid"}
+
+	e, err := startEmulator(tmpl, values, map[string]string{"625": "-1500"})
+	require.NoError(t, err)
+	defer e.Close()
+
+	assert.Equal(t, "tcpip", values[templates.ParamModbus])
+	assert.Equal(t, true, values["solarmanv5"])
+
+	v, ok := e.Register(1, 625)
+	require.True(t, ok)
+	assert.Equal(t, int16(-1500), int16(v))
+}
+
+func TestParseRegisterValue(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		res uint16
+		err bool
+	}{
+		{"1500", 1500, false},
+		{"-1500", 0xfa24, false},
+		{"0xffff", 0xffff, false},
+		{"65535", 65535, false},
+		{"-32768", 0x8000, false},
+		{"65536", 0, true},
+		{"-32769", 0, true},
+		{"foo", 0, true},
+	} {
+		res, err := parseRegisterValue(tc.in)
+		if tc.err {
+			assert.Error(t, err, tc.in)
+			continue
+		}
+
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.res, res, tc.in)
+	}
+}
@@ -0,0 +1,184 @@
+// Package chargerconfig keeps snapshots of charger device configurations.
+// Snapshots allow detecting and reverting configuration changes, e.g. after charger firmware resets.
+package chargerconfig
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+)
+
+const (
+	// Interval is the default snapshot interval
+	Interval = 24 * time.Hour
+
+	startupDelay = 5 * time.Minute // allow chargers to connect before first snapshot
+)
+
+// ErrNotSupported is returned for chargers not providing their configuration
+var ErrNotSupported = errors.New("charger configuration not supported")
+
+// Snapshot is a stored charger configuration
+type Snapshot struct {
+	Updated time.Time         `json:"updated"`
+	Config  map[string]string `json:"config"`
+}
+
+// Change is a configuration value differing from the snapshot
+type Change struct {
+	Key      string `json:"key"`
+	Snapshot string `json:"snapshot"`
+	Current  string `json:"current"`
+}
+
+func settingsKey(name string) string {
+	return keys.ChargerConfig + "." + name
+}
+
+// Load returns the stored snapshot of the named charger
+func Load(name string) (Snapshot, error) {
+	var res Snapshot
+	err := settings.Json(settingsKey(name), &res)
+	return res, err
+}
+
+// Diff returns the values of current differing from the snapshot, sorted by key.
+// Keys missing in either configuration are ignored as they cannot be restored.
+func Diff(snapshot, current map[string]string) []Change {
+	var res []Change
+
+	for k, v := range snapshot {
+		if cur, ok := current[k]; ok && cur != v {
+			res = append(res, Change{Key: k, Snapshot: v, Current: cur})
+		}
+	}
+
+	slices.SortFunc(res, func(a, b Change) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return res
+}
+
+// Current reads the charger's current configuration
+func Current(instance any) (map[string]string, error) {
+	cr, ok := instance.(api.ChargerConfigReader)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	return cr.ChargerConfig()
+}
+
+// Backup reads the charger configuration and stores it as new snapshot.
+// It returns the changes compared to the previous snapshot.
+func Backup(name string, instance any) ([]Change, error) {
+	current, err := Current(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	if prev, err := Load(name); err == nil {
+		changes = Diff(prev.Config, current)
+	}
+
+	err = settings.SetJson(settingsKey(name), Snapshot{
+		Updated: time.Now(),
+		Config:  current,
+	})
+
+	return changes, err
+}
+
+// Check compares the charger configuration to the stored snapshot. If no snapshot exists, the current
+// configuration is stored as initial snapshot. Existing snapshots are only replaced by Backup on user request,
+// so that the last known good configuration is not overwritten after firmware resets.
+func Check(name string, instance any) ([]Change, error) {
+	current, err := Current(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := Load(name)
+	switch {
+	case errors.Is(err, settings.ErrNotFound):
+		return nil, settings.SetJson(settingsKey(name), Snapshot{
+			Updated: time.Now(),
+			Config:  current,
+		})
+	case err != nil:
+		return nil, err
+	}
+
+	return Diff(prev.Config, current), nil
+}
+
+// Restore writes all snapshot values differing from the current charger configuration.
+// It returns the changes that were applied.
+func Restore(name string, instance any) ([]Change, error) {
+	cw, ok := instance.(api.ChargerConfigWriter)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	snapshot, err := Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := Current(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res  []Change
+		errs []error
+	)
+
+	// restore as many values as possible, some may be read-only
+	for _, c := range Diff(snapshot.Config, current) {
+		if err := cw.SetChargerConfig(c.Key, c.Snapshot); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Key, err))
+			continue
+		}
+		res = append(res, c)
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// Run periodically compares the configuration of all supported chargers to their snapshots and logs changes.
+// Chargers without snapshot get an initial snapshot.
+func Run(log *util.Logger, interval time.Duration) {
+	time.Sleep(startupDelay)
+
+	for tick := time.Tick(interval); ; <-tick {
+		for _, dev := range config.Chargers().Devices() {
+			name := dev.Config().Name
+
+			if _, ok := dev.Instance().(api.ChargerConfigReader); !ok {
+				continue
+			}
+
+			changes, err := Check(name, dev.Instance())
+			if err != nil {
+				log.ERROR.Printf("charger %s: configuration check: %v", name, err)
+				continue
+			}
+
+			for _, c := range changes {
+				log.WARN.Printf("charger %s: configuration %s differs from snapshot: %s (snapshot: %s)", name, c.Key, c.Current, c.Snapshot)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package chargerconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type configCharger struct {
+	config map[string]string
+}
+
+func (c *configCharger) ChargerConfig() (map[string]string, error) {
+	res := make(map[string]string, len(c.config))
+	for k, v := range c.config {
+		res[k] = v
+	}
+	return res, nil
+}
+
+func (c *configCharger) SetChargerConfig(key, value string) error {
+	c.config[key] = value
+	return nil
+}
+
+func TestDiff(t *testing.T) {
+	snapshot := map[string]string{"a": "1", "b": "2", "c": "3"}
+	current := map[string]string{"c": "4", "a": "0", "b": "2", "d": "5"}
+
+	assert.Equal(t, []Change{
+		{Key: "a", Snapshot: "1", Current: "0"},
+		{Key: "c", Snapshot: "3", Current: "4"},
+	}, Diff(snapshot, current))
+}
+
+func TestBackupRestore(t *testing.T) {
+	c := &configCharger{config: map[string]string{"limit": "32", "phases": "3"}}
+
+	changes, err := Backup("test", c)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// firmware reset
+	c.config["limit"] = "16"
+
+	changes, err = Restore("test", c)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Key: "limit", Snapshot: "32", Current: "16"}}, changes)
+	assert.Equal(t, "32", c.config["limit"])
+
+	c.config["phases"] = "1"
+
+	changes, err = Backup("test", c)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{{Key: "phases", Snapshot: "3", Current: "1"}}, changes)
+
+	snapshot, err := Load("test")
+	require.NoError(t, err)
+	assert.Equal(t, c.config, snapshot.Config)
+}
+
+func TestNotSupported(t *testing.T) {
+	_, err := Backup("test", struct{}{})
+	require.ErrorIs(t, err, ErrNotSupported)
+
+	_, err = Restore("test", struct{}{})
+	require.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestCheckKeepsSnapshot(t *testing.T) {
+	c := &configCharger{config: map[string]string{"limit": "32"}}
+
+	// initial snapshot
+	changes, err := Check("check", c)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// firmware reset is reported but does not replace the snapshot
+	c.config["limit"] = "16"
+
+	for range 2 {
+		changes, err = Check("check", c)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Key: "limit", Snapshot: "32", Current: "16"}}, changes)
+	}
+
+	snapshot, err := Load("check")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"limit": "32"}, snapshot.Config)
+}
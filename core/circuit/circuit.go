@@ -38,6 +38,10 @@ type Circuit struct {
 	power   float64
 	dimmed  bool
 
+	// increases granted to loadpoints since last update, not yet reflected by measurements
+	reservedCurrent float64
+	reservedPower   float64
+
 	currentUpdated time.Time
 	powerUpdated   time.Time
 }
@@ -286,6 +290,9 @@ func (c *Circuit) Update(loadpoints []api.CircuitLoad) (err error) {
 	maxPower := c.GetMaxPower()
 	maxCurrent := c.GetMaxCurrent()
 
+	c.reservedCurrent = 0
+	c.reservedPower = 0
+
 	defer func() {
 		if maxPower != 0 && c.power > maxPower {
 			c.log.WARN.Printf("over power detected: %.5gW > %.5gW", c.power, maxPower)
@@ -332,11 +339,12 @@ func (c *Circuit) GetMaxPhaseCurrent() float64 {
 	return c.current
 }
 
-// ValidatePower validates power request
+// ValidatePower validates power request against the circuit and all its ancestors.
+// Granted increases are reserved until the next update.
 func (c *Circuit) ValidatePower(old, new float64) float64 {
 	if maxPower := c.GetMaxPower(); maxPower != 0 {
 		delta := max(0, new-old)
-		potential := maxPower - c.power
+		potential := maxPower - c.power - c.reservedPower
 
 		if delta > potential {
 			capped := min(new, max(0, old+potential))
@@ -347,18 +355,21 @@ func (c *Circuit) ValidatePower(old, new float64) float64 {
 		}
 	}
 
-	if c.parent == nil {
-		return new
+	if c.parent != nil {
+		new = c.parent.ValidatePower(old, new)
 	}
 
-	return c.parent.ValidatePower(old, new)
+	c.reservedPower += max(0, new-old)
+
+	return new
 }
 
-// ValidateCurrent validates current request
+// ValidateCurrent validates current request against the circuit and all its ancestors.
+// Granted increases are reserved until the next update.
 func (c *Circuit) ValidateCurrent(old, new float64) float64 {
 	if maxCurrent := c.GetMaxCurrent(); maxCurrent != 0 {
 		delta := max(0, new-old)
-		potential := maxCurrent - c.current
+		potential := maxCurrent - c.current - c.reservedCurrent
 
 		if delta > potential {
 			capped := min(new, max(0, old+potential))
@@ -369,11 +380,13 @@ func (c *Circuit) ValidateCurrent(old, new float64) float64 {
 		}
 	}
 
-	if c.parent == nil {
-		return new
+	if c.parent != nil {
+		new = c.parent.ValidateCurrent(old, new)
 	}
 
-	return c.parent.ValidateCurrent(old, new)
+	c.reservedCurrent += max(0, new-old)
+
+	return new
 }
 
 func (c *Circuit) Dim(dim bool) {
@@ -139,3 +139,27 @@ func TestCircuitCurrents(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+func TestCircuitReservation(t *testing.T) {
+	log := util.NewLogger("foo")
+
+	// house -> garage -> two loadpoints
+	house, err := New(log, "house", 0, 10000, nil, 0)
+	require.NoError(t, err)
+	garage, err := New(log, "garage", 0, 8000, nil, 0)
+	require.NoError(t, err)
+	require.NoError(t, garage.setParent(house))
+
+	require.NoError(t, house.Update(nil))
+
+	// second loadpoint only gets the remainder of the garage circuit
+	assert.Equal(t, 6000.0, garage.ValidatePower(0, 6000))
+	assert.Equal(t, 2000.0, garage.ValidatePower(0, 6000))
+
+	// house circuit respects the garage reservations
+	assert.Equal(t, 2000.0, house.ValidatePower(0, 6000))
+
+	// reservations are released on update
+	require.NoError(t, house.Update(nil))
+	assert.Equal(t, 6000.0, garage.ValidatePower(0, 6000))
+}
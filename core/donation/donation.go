@@ -0,0 +1,149 @@
+package donation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/evcc-io/evcc/core/metrics"
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+// DefaultInterval is the default reporting period
+const DefaultInterval = 24 * time.Hour
+
+// Config is the data donation configuration
+type Config struct {
+	URI      string        `json:"uri"`                // research endpoint receiving the reports
+	Interval time.Duration `json:"interval,omitempty"` // reporting period
+}
+
+// Configured returns true if a donation endpoint is configured
+func (c Config) Configured() bool {
+	return c.URI != ""
+}
+
+// NewReport aggregates the sessions finished within the period and the household profile into an anonymized report
+func NewReport(from, to time.Time, sessions session.Sessions, household *[96]float64) Report {
+	res := Report{
+		Schema:    SchemaVersion,
+		From:      from.Truncate(time.Hour),
+		To:        to.Truncate(time.Hour),
+		Household: household,
+	}
+
+	for _, s := range sessions {
+		if s.Created.IsZero() || s.Finished.Before(from) || !s.Finished.Before(to) {
+			continue
+		}
+
+		hour := s.Created.Local().Hour()
+
+		var duration float64
+		if s.ChargeDuration != nil {
+			duration = s.ChargeDuration.Hours()
+		}
+
+		c := &res.Charging
+		c.Sessions++
+		c.Energy += s.ChargedEnergy
+		c.Duration += duration
+		c.StartsByHour[hour]++
+		c.EnergyByHour[hour] += s.ChargedEnergy
+		c.DurationsByHour[hour] += duration
+
+		if s.SolarPercentage != nil {
+			c.SolarEnergy += s.ChargedEnergy * *s.SolarPercentage / 100
+		}
+	}
+
+	return res
+}
+
+// Collect creates the report for the period from the local database
+func Collect(from, to time.Time) (Report, error) {
+	if db.Instance == nil {
+		return Report{}, errors.New("database offline")
+	}
+
+	var sessions session.Sessions
+	if err := db.Instance.Where("finished >= ? AND finished < ?", from, to).Find(&sessions).Error; err != nil {
+		return Report{}, err
+	}
+
+	household, err := metrics.Profile(from)
+	if err != nil && !errors.Is(err, metrics.ErrIncomplete) {
+		return Report{}, err
+	}
+
+	return NewReport(from, to, sessions, household), nil
+}
+
+var (
+	log      = util.NewLogger("donation")
+	interval = DefaultInterval
+)
+
+// Configure validates the configuration and starts the periodic upload if an endpoint is configured
+func Configure(cc Config) error {
+	if cc.Interval == 0 {
+		cc.Interval = DefaultInterval
+	}
+
+	if cc.Interval < time.Hour {
+		return fmt.Errorf("interval must be at least 1h: %v", cc.Interval)
+	}
+
+	interval = cc.Interval
+
+	if cc.Configured() {
+		go run(cc.URI, cc.Interval)
+	}
+
+	return nil
+}
+
+// Preview returns the report of the current period exactly as it would be uploaded
+func Preview() (Report, error) {
+	to := time.Now().Truncate(time.Hour)
+	return Collect(to.Add(-interval), to)
+}
+
+// run uploads the report at the end of each period
+func run(uri string, interval time.Duration) {
+	for range time.Tick(interval) {
+		to := time.Now().Truncate(time.Hour)
+
+		report, err := Collect(to.Add(-interval), to)
+		if err == nil {
+			err = upload(uri, report)
+		}
+
+		if err != nil {
+			log.ERROR.Printf("upload: %v", err)
+		}
+	}
+}
+
+// upload sends the report to the endpoint
+func upload(uri string, report Report) error {
+	ctx, cancel := context.WithTimeout(context.Background(), request.Timeout)
+	defer cancel()
+
+	req, err := request.New(http.MethodPost, uri, request.MarshalJSON(report), request.JSONEncoding)
+	if err != nil {
+		return err
+	}
+
+	_, err = request.NewHelper(log).DoBody(req.WithContext(ctx))
+	if err == nil {
+		log.DEBUG.Printf("uploaded report with %d sessions", report.Charging.Sessions)
+	}
+
+	return err
+}
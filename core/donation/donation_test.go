@@ -0,0 +1,66 @@
+package donation
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReport(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	to := from.Add(24 * time.Hour)
+
+	sessions := session.Sessions{
+		{
+			Loadpoint:       "Garage",
+			Vehicle:         "My car",
+			Identifier:      "rfid-1234",
+			Created:         from.Add(18*time.Hour + 5*time.Minute),
+			Finished:        from.Add(21 * time.Hour),
+			ChargedEnergy:   20,
+			ChargeDuration:  lo.ToPtr(2 * time.Hour),
+			SolarPercentage: lo.ToPtr(25.0),
+		},
+		{
+			Created:       from.Add(18*time.Hour + 30*time.Minute),
+			Finished:      from.Add(19 * time.Hour),
+			ChargedEnergy: 5,
+		},
+		// finished outside the period
+		{
+			Created:       from.Add(-2 * time.Hour),
+			Finished:      from.Add(-time.Hour),
+			ChargedEnergy: 10,
+		},
+	}
+
+	res := NewReport(from.Add(5*time.Minute), to, sessions, nil)
+
+	assert.Equal(t, SchemaVersion, res.Schema)
+	assert.Equal(t, from, res.From)
+	assert.Equal(t, 2, res.Charging.Sessions)
+	assert.Equal(t, 25.0, res.Charging.Energy)
+	assert.Equal(t, 5.0, res.Charging.SolarEnergy)
+	assert.Equal(t, 2.0, res.Charging.Duration)
+	assert.Equal(t, 2, res.Charging.StartsByHour[18])
+	assert.Equal(t, 25.0, res.Charging.EnergyByHour[18])
+
+	// no personal data is shared
+	b, err := json.Marshal(res)
+	require.NoError(t, err)
+	for _, s := range []string{"Garage", "My car", "rfid-1234"} {
+		assert.NotContains(t, string(b), s)
+	}
+	assert.NotContains(t, string(b), "household")
+}
+
+func TestConfigure(t *testing.T) {
+	require.Error(t, Configure(Config{Interval: time.Minute}))
+	require.NoError(t, Configure(Config{}))
+	assert.Equal(t, DefaultInterval, interval)
+}
@@ -0,0 +1,28 @@
+package donation
+
+import "time"
+
+// SchemaVersion is the version of the report schema. It is incremented on incompatible changes.
+const SchemaVersion = 1
+
+// Report is the anonymized data shared with the research endpoint.
+// It contains aggregates only: no identifiers, names, vehicle data, locations or individual sessions.
+// All times are reduced to the local hour of day.
+type Report struct {
+	Schema    int           `json:"schema"`              // schema version
+	From      time.Time     `json:"from"`                // start of the reporting period, truncated to the hour
+	To        time.Time     `json:"to"`                  // end of the reporting period, truncated to the hour
+	Charging  ChargingStats `json:"charging"`            // charging sessions finished within the period
+	Household *[96]float64  `json:"household,omitempty"` // average household consumption profile in Wh per 15min slot starting at 00:00 local time
+}
+
+// ChargingStats are aggregated charging sessions
+type ChargingStats struct {
+	Sessions        int         `json:"sessions"`        // number of sessions
+	Energy          float64     `json:"energy"`          // charged energy in kWh
+	SolarEnergy     float64     `json:"solarEnergy"`     // charged solar energy in kWh
+	Duration        float64     `json:"duration"`        // active charging duration in hours
+	StartsByHour    [24]int     `json:"startsByHour"`    // number of sessions by local hour of day the session started
+	EnergyByHour    [24]float64 `json:"energyByHour"`    // charged energy in kWh by local hour of day the session started
+	DurationsByHour [24]float64 `json:"durationsByHour"` // charging duration in hours by local hour of day the session started
+}
@@ -5,10 +5,12 @@ type EnergyMetrics struct {
 	totalKWh          float64  // Total amount of energy used (kWh)
 	solarKWh          float64  // Self-produced energy (kWh)
 	price             *float64 // Total cost (Currency)
+	solarPrice        *float64 // Share of total cost attributed to self-produced energy (Currency)
 	co2               *float64 // Amount of emitted CO2 (gCO2eq)
 	currentGreenShare float64  // Current share of solar energy of site (0-1)
 	currentPrice      *float64 // Current price per kWh
 	currentCo2        *float64 // Current co2 emissions
+	currentFeedIn     float64  // Current feed-in price per kWh
 }
 
 // SetEnvironment updates site information like solar share, price, co2 for use in later calculations
@@ -18,6 +20,11 @@ func (em *EnergyMetrics) SetEnvironment(greenShare float64, effPrice, effCo2 *fl
 	em.currentCo2 = effCo2
 }
 
+// SetFeedInPrice updates the feed-in price used for attributing the cost of self-produced energy
+func (em *EnergyMetrics) SetFeedInPrice(feedIn float64) {
+	em.currentFeedIn = feedIn
+}
+
 // Update sets the a new value for the total amount of charged energy and updated metrics based on environment values.
 // It returns the added total and green energy.
 func (em *EnergyMetrics) Update(chargedKWh float64) (float64, float64) {
@@ -37,6 +44,13 @@ func (em *EnergyMetrics) Update(chargedKWh float64) (float64, float64) {
 			newPrice = *em.price + newPrice
 		}
 		em.price = &newPrice
+
+		// self-produced energy is valued at the feed-in price
+		newSolarPrice := em.currentFeedIn * addedGreen
+		if em.solarPrice != nil {
+			newSolarPrice = *em.solarPrice + newSolarPrice
+		}
+		em.solarPrice = &newSolarPrice
 	}
 	if em.currentCo2 != nil {
 		addedCo2 := *em.currentCo2 * added
@@ -54,6 +68,7 @@ func (em *EnergyMetrics) Reset() {
 	em.totalKWh = 0
 	em.solarKWh = 0
 	em.price = nil
+	em.solarPrice = nil
 	em.co2 = nil
 }
 
@@ -78,6 +93,23 @@ func (em *EnergyMetrics) Price() *float64 {
 	return em.price
 }
 
+// SolarPrice returns the share of the total energy price attributed to self-produced energy in Currency
+func (em *EnergyMetrics) SolarPrice() *float64 {
+	if em.totalKWh == 0 || em.solarPrice == nil {
+		return nil
+	}
+	return em.solarPrice
+}
+
+// GridPrice returns the share of the total energy price attributed to grid energy in Currency
+func (em *EnergyMetrics) GridPrice() *float64 {
+	if em.totalKWh == 0 || em.price == nil || em.solarPrice == nil {
+		return nil
+	}
+	price := *em.price - *em.solarPrice
+	return &price
+}
+
 // PricePerKWh returns the average energy price in Currency
 func (em *EnergyMetrics) PricePerKWh() *float64 {
 	if em.totalKWh == 0 || em.price == nil {
@@ -102,5 +134,7 @@ func (em *EnergyMetrics) Publish(prefix string, p publisher) {
 	p.publish(prefix+"SolarPercentage", em.SolarPercentage())
 	p.publish(prefix+"PricePerKWh", em.PricePerKWh())
 	p.publish(prefix+"Price", em.Price())
+	p.publish(prefix+"GridPrice", em.GridPrice())
+	p.publish(prefix+"SolarPrice", em.SolarPrice())
 	p.publish(prefix+"Co2PerKWh", em.Co2PerKWh())
 }
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"math"
 	"testing"
 )
 
@@ -153,3 +154,34 @@ func TestEnergyMetrics(t *testing.T) {
 		t.Errorf("Metrics not properly reset %+v", s)
 	}
 }
+
+func TestEnergyMetricsPriceBreakdown(t *testing.T) {
+	f := func(f float64) *float64 { return &f }
+
+	var s EnergyMetrics
+
+	// half solar at 0.3 grid and 0.1 feed-in price
+	s.SetEnvironment(0.5, f(0.2), nil)
+	s.SetFeedInPrice(0.1)
+	s.Update(2)
+
+	// grid only
+	s.SetEnvironment(0, f(0.3), nil)
+	s.SetFeedInPrice(0.1)
+	s.Update(3)
+
+	if price := s.Price(); !isEqualFloat64(price, f(0.7)) {
+		t.Errorf("Price was incorrect, got: %v", *price)
+	}
+	if price := s.SolarPrice(); !isEqualFloat64(price, f(0.1)) {
+		t.Errorf("SolarPrice was incorrect, got: %v", *price)
+	}
+	if price := s.GridPrice(); price == nil || math.Abs(*price-0.6) > 1e-9 {
+		t.Errorf("GridPrice was incorrect, got: %v", price)
+	}
+
+	s.Reset()
+	if s.GridPrice() != nil || s.SolarPrice() != nil {
+		t.Errorf("Metrics not properly reset %+v", s)
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util/config"
@@ -82,3 +83,25 @@ func circuitDimmed(circuit api.Circuit) bool {
 
 	return circuit.Dimmed()
 }
+
+// override is a temporary value replacing a measured or controlled value
+type override struct {
+	value float64
+	until time.Time
+}
+
+// newOverride creates an override valid for the given duration or nil if value is nil
+func newOverride(value *float64, until time.Time) *override {
+	if value == nil {
+		return nil
+	}
+	return &override{value: *value, until: until}
+}
+
+// activeValue returns the override value unless expired
+func (o *override) activeValue(now time.Time) *float64 {
+	if o == nil || !now.Before(o.until) {
+		return nil
+	}
+	return &o.value
+}
@@ -0,0 +1,185 @@
+package journal
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+)
+
+const DefaultSize = 100
+
+// Command is the type of charger command
+type Command string
+
+const (
+	Enable   Command = "enable"
+	Current  Command = "current"
+	Phases   Command = "phases"
+	WakeUp   Command = "wakeup"
+	Dim      Command = "dim"
+	Indicate Command = "indicate"
+)
+
+// Status is the delivery status of a command
+type Status string
+
+const (
+	Pending    Status = "pending"    // command was not yet delivered and is retried on next execution
+	Delivered  Status = "delivered"  // command was accepted by the device
+	Superseded Status = "superseded" // command was replaced by a newer one before delivery
+	Simulated  Status = "simulated"  // command was not sent in dry-run mode
+)
+
+// Entry is a single journaled command
+type Entry struct {
+	ID       int64     `json:"id"`
+	Command  Command   `json:"command"`
+	Value    any       `json:"value"`
+	Status   Status    `json:"status"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error,omitempty"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+}
+
+// Journal records outgoing charger commands and tracks failed commands until they are retried
+type Journal struct {
+	mu      sync.Mutex
+	clock   clock.Clock
+	size    int
+	id      int64
+	entries []*Entry
+	pending map[Command]*Entry
+	dryRun  bool
+}
+
+// New creates a journal keeping the latest size entries
+func New(clock clock.Clock, size int) *Journal {
+	return &Journal{
+		clock:   clock,
+		size:    size,
+		pending: make(map[Command]*Entry),
+	}
+}
+
+// SetDryRun enables dry-run mode. Commands are journaled but not executed.
+func (j *Journal) SetDryRun(dryRun bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.dryRun = dryRun
+}
+
+// Execute journals the command and executes fn once. Failed attempts are not retried inline to
+// avoid blocking the control loop. A failed command remains pending and is retried on next
+// execution with the same value, i.e. on the next loadpoint cycle.
+func (j *Journal) Execute(cmd Command, value any, fn func() error) error {
+	j.mu.Lock()
+
+	now := j.clock.Now()
+
+	e, ok := j.pending[cmd]
+	switch {
+	case ok && e.Value == value:
+		// retry pending command
+
+	case ok:
+		e.Status = Superseded
+		e.Updated = now
+		fallthrough
+
+	default:
+		e = j.add(cmd, value, now)
+	}
+
+	if j.dryRun {
+		e.Status = Simulated
+		e.Updated = now
+		delete(j.pending, cmd)
+		j.mu.Unlock()
+		return nil
+	}
+
+	e.Status = Pending
+	j.pending[cmd] = e
+	j.mu.Unlock()
+
+	err := fn()
+	j.attempt(e, err)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// command may have been superseded meanwhile
+	if err == nil && j.pending[cmd] == e {
+		e.Status = Delivered
+		delete(j.pending, cmd)
+	}
+
+	return err
+}
+
+// attempt records the result of a single delivery attempt
+func (j *Journal) attempt(e *Entry, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e.Attempts++
+	e.Updated = j.clock.Now()
+	e.Error = ""
+
+	if err != nil {
+		e.Error = err.Error()
+	}
+}
+
+// Simulate journals the command as simulated if dry-run mode is enabled.
+// It is used for commands that are executed directly and not retried.
+func (j *Journal) Simulate(cmd Command, value any) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.dryRun {
+		return false
+	}
+
+	now := j.clock.Now()
+	e := j.add(cmd, value, now)
+	e.Status = Simulated
+	e.Updated = now
+
+	return true
+}
+
+// add appends a new entry, dropping the oldest entries exceeding the journal size
+func (j *Journal) add(cmd Command, value any, now time.Time) *Entry {
+	j.id++
+	e := &Entry{
+		ID:      j.id,
+		Command: cmd,
+		Value:   value,
+		Created: now,
+	}
+
+	j.entries = append(j.entries, e)
+	if len(j.entries) > j.size {
+		j.entries = slices.Delete(j.entries, 0, len(j.entries)-j.size)
+	}
+
+	return e
+}
+
+// Entries returns the journaled commands, oldest first
+func (j *Journal) Entries() []Entry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	res := make([]Entry, 0, len(j.entries))
+	for _, e := range j.entries {
+		res = append(res, *e)
+	}
+
+	return res
+}
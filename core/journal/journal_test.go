@@ -0,0 +1,114 @@
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalRetry(t *testing.T) {
+	j := New(clock.NewMock(), DefaultSize)
+
+	var calls int
+	fail := func() error {
+		calls++
+		return errors.New("offline")
+	}
+
+	// failed attempts are not retried inline
+	require.Error(t, j.Execute(Current, 16.0, fail))
+	require.Equal(t, 1, calls)
+
+	e := j.Entries()
+	require.Len(t, e, 1)
+	assert.Equal(t, Pending, e[0].Status)
+
+	// pending command is retried on next execution
+	require.Error(t, j.Execute(Current, 16.0, fail))
+	require.Equal(t, 2, calls)
+
+	require.NoError(t, j.Execute(Current, 16.0, func() error { return nil }))
+
+	e = j.Entries()
+	require.Len(t, e, 1)
+	assert.Equal(t, Delivered, e[0].Status)
+	assert.Equal(t, 3, e[0].Attempts)
+	assert.Empty(t, e[0].Error)
+}
+
+func TestJournalAsleep(t *testing.T) {
+	for _, tc := range []error{api.ErrAsleep, api.ErrMustRetry} {
+		j := New(clock.NewMock(), DefaultSize)
+
+		var calls int
+		err := j.Execute(Enable, true, func() error {
+			calls++
+			return fmt.Errorf("charger: %w", tc)
+		})
+
+		require.ErrorIs(t, err, tc)
+		require.Equal(t, 1, calls)
+
+		e := j.Entries()
+		require.Len(t, e, 1)
+		assert.Equal(t, Pending, e[0].Status)
+		assert.Equal(t, "charger: "+tc.Error(), e[0].Error)
+	}
+}
+
+func TestJournalSupersede(t *testing.T) {
+	j := New(clock.NewMock(), DefaultSize)
+
+	require.Error(t, j.Execute(Enable, true, func() error { return errors.New("offline") }))
+
+	// different value supersedes pending command
+	require.NoError(t, j.Execute(Enable, false, func() error { return nil }))
+
+	// other commands are independent
+	require.NoError(t, j.Execute(Phases, 1, func() error { return nil }))
+
+	e := j.Entries()
+	require.Len(t, e, 3)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{e[0].ID, e[1].ID, e[2].ID})
+	assert.Equal(t, Superseded, e[0].Status)
+	assert.Equal(t, "offline", e[0].Error)
+	assert.Equal(t, Delivered, e[1].Status)
+}
+
+func TestJournalSize(t *testing.T) {
+	j := New(clock.NewMock(), 2)
+
+	for i := range 3 {
+		require.NoError(t, j.Execute(Phases, i, func() error { return nil }))
+	}
+
+	e := j.Entries()
+	require.Len(t, e, 2)
+	assert.Equal(t, int64(2), e[0].ID)
+}
+
+func TestJournalDryRun(t *testing.T) {
+	j := New(clock.NewMock(), DefaultSize)
+	j.SetDryRun(true)
+
+	require.NoError(t, j.Execute(Current, 16.0, func() error {
+		t.Fatal("command executed in dry-run mode")
+		return nil
+	}))
+
+	require.True(t, j.Simulate(WakeUp, "vehicle"))
+
+	e := j.Entries()
+	require.Len(t, e, 2)
+	assert.Equal(t, Simulated, e[0].Status)
+	assert.Equal(t, 0, e[0].Attempts)
+	assert.Equal(t, Entry{ID: 2, Command: WakeUp, Value: "vehicle", Status: Simulated, Created: e[1].Created, Updated: e[1].Updated}, e[1])
+
+	j.SetDryRun(false)
+	assert.False(t, j.Simulate(WakeUp, "vehicle"))
+}
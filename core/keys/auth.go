@@ -3,4 +3,5 @@ package keys
 const (
 	AdminPassword = "adminPassword"
 	JwtSecret     = "jwtSecretKey"
+	Users         = "users"
 )
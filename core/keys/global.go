@@ -12,12 +12,16 @@ const (
 	Hems               = "hems"
 	Shm                = "shm"
 	Messaging          = "messaging"
+	MessagingEvents    = "messagingEvents"
+	PushQueue          = "pushQueue"
+	ChargerConfig      = "chargerConfig"
 	ModbusProxy        = "modbusproxy"
 	Tariffs            = "tariffs"
 	Version            = "version"
 	Config             = "config"
 	Database           = "database"
 	Fatal              = "fatal"
+	Features           = "features"
 	Startup            = "startup"
 	Plant              = "plant"
 	Telemetry          = "telemetry"
@@ -2,19 +2,20 @@ package keys
 
 const (
 	// loadpoint settings
-	Title            = "title"       // loadpoint title
-	Mode             = "mode"        // charge mode
-	DefaultMode      = "defaultMode" // default charge mode
-	Charger          = "charger"     // charger ref
-	Meter            = "meter"       // meter ref
-	Circuit          = "circuit"     // circuit ref
-	DefaultVehicle   = "vehicle"     // default vehicle ref
-	Priority         = "priority"    // priority
-	MinCurrent       = "minCurrent"  // min current
-	MaxCurrent       = "maxCurrent"  // max current
-	MinSoc           = "minSoc"      // min soc
-	LimitSoc         = "limitSoc"    // limit soc
-	LimitEnergy      = "limitEnergy" // limit energy
+	Title            = "title"        // loadpoint title
+	Mode             = "mode"         // charge mode
+	DefaultMode      = "defaultMode"  // default charge mode
+	Charger          = "charger"      // charger ref
+	Meter            = "meter"        // meter ref
+	BillingMeter     = "billingMeter" // billing meter ref
+	Circuit          = "circuit"      // circuit ref
+	DefaultVehicle   = "vehicle"      // default vehicle ref
+	Priority         = "priority"     // priority
+	MinCurrent       = "minCurrent"   // min current
+	MaxCurrent       = "maxCurrent"   // max current
+	MinSoc           = "minSoc"       // min soc
+	LimitSoc         = "limitSoc"     // limit soc
+	LimitEnergy      = "limitEnergy"  // limit energy
 	Soc              = "soc"
 	Thresholds       = "thresholds"
 	EnableThreshold  = "enableThreshold"
@@ -25,12 +26,14 @@ const (
 
 	PhasesConfigured = "phasesConfigured" // desired phase mode (0/1/3, 0 = automatic), user selection
 	PhasesActive     = "phasesActive"     // expectedly active phases, taking vehicle into account (1/2/3)
+	PhaseSwitches    = "phaseSwitches"    // phase switch statistics
 
 	ChargerIcon         = "chargerIcon"         // charger icon for ui
 	ChargerFeature      = "chargerFeature"      // charger feature
 	ChargerSinglePhase  = "chargerSinglePhase"  // api.PhaseDescriber: charger physical phases, sockets only
 	ChargerPhases1p3p   = "chargerPhases1p3p"   // api.PhaseSwitcher: 1p3p chargers
 	ChargerStatusReason = "chargerStatusReason" // either awaiting authorization or disconnect required
+	ChargerDiagnostics  = "chargerDiagnostics"  // optional charger status values like firmware version
 
 	// loadpoint status
 	Enabled   = "enabled"   // loadpoint enabled
@@ -60,17 +63,20 @@ const (
 	EffectiveLimitSoc   = "effectiveLimitSoc"   // effective limit soc
 
 	// measurements
-	ChargePower       = "chargePower"       // charge power
-	ChargeCurrents    = "chargeCurrents"    // charge currents
-	ChargeVoltages    = "chargeVoltages"    // charge voltages
-	ChargedEnergy     = "chargedEnergy"     // charged energy
-	ChargeDuration    = "chargeDuration"    // charge duration
-	ChargeTotalImport = "chargeTotalImport" // charge meter total import
+	ChargePower          = "chargePower"          // charge power
+	ChargeCurrents       = "chargeCurrents"       // charge currents
+	ChargeCurrentsSource = "chargeCurrentsSource" // charge currents source
+	ChargeVoltages       = "chargeVoltages"       // charge voltages
+	ChargedEnergy        = "chargedEnergy"        // charged energy
+	ChargeDuration       = "chargeDuration"       // charge duration
+	ChargeTotalImport    = "chargeTotalImport"    // charge meter total import
 
 	// session
 	ConnectedDuration       = "connectedDuration"       // connected duration
 	ChargeRemainingDuration = "chargeRemainingDuration" // charge remaining duration
 	ChargeRemainingEnergy   = "chargeRemainingEnergy"   // charge remaining energy
+	SessionState            = "sessionState"            // detailed charging state
+	SessionStateDuration    = "sessionStateDuration"    // duration of the current session state
 
 	// plan
 	PlanTime           = "planTime"           // charge plan finish time goal
@@ -81,18 +87,32 @@ const (
 	PlanProjectedStart = "planProjectedStart" // charge plan start time (earliest slot)
 	PlanProjectedEnd   = "planProjectedEnd"   // charge plan ends (end of last slot)
 	PlanOverrun        = "planOverrun"        // charge plan goal not reachable in time
+	PlanSlots          = "planSlots"          // charge plan slots committed to
 
 	// repeating plans
 	RepeatingPlans = "repeatingPlans" // key to access all repeating plans in db
 
+	// battery maintenance
+	Maintenance       = "maintenance"       // key to access the vehicle maintenance policy in db
+	MaintenanceActive = "maintenanceActive" // maintenance policy applies to the connected vehicle
+
+	// departure detection
+	Departure      = "departure"      // key to access the vehicle departure detection settings in db
+	DepartureTimes = "departureTimes" // key to access the detected vehicle departure times in db
+
+	// vehicle identification
+	Identifiers = "identifiers" // key to access the learned vehicle identifiers in db
+
 	// remote control
 	RemoteDisabled       = "remoteDisabled"       // remote disabled
 	RemoteDisabledSource = "remoteDisabledSource" // remote disabled source
+	CurrentOverride      = "currentOverride"      // externally forced charge current
 
 	// vehicle
 	VehicleName            = "vehicleName"            // vehicle name
 	VehicleTitle           = "vehicleTitle"           // vehicle title
 	VehicleIdentity        = "vehicleIdentity"        // vehicle identity
+	VehicleIdentityUnknown = "vehicleIdentityUnknown" // vehicle identity not assigned to any vehicle
 	VehicleDetectionActive = "vehicleDetectionActive" // vehicle detection active
 	VehicleOdometer        = "vehicleOdometer"        // vehicle odometer
 	VehicleRange           = "vehicleRange"           // vehicle range
@@ -1,6 +1,7 @@
 package keys
 
 const (
+	ActiveEndpoints       = "activeEndpoints"
 	Aux                   = "aux"
 	AuxPower              = "auxPower"
 	Circuits              = "circuits"
@@ -30,6 +31,7 @@ const (
 	TariffPriceHome       = "tariffPriceHome"
 	TariffPriceLoadpoints = "tariffPriceLoadpoints"
 	TariffSolar           = "tariffSolar"
+	UnhealthyConnections  = "unhealthyConnections"
 	Vehicles              = "vehicles"
 
 	// meters
@@ -40,12 +42,32 @@ const (
 	AuxMeters     = "auxMeters"
 
 	// battery settings
-	BatteryCapacity         = "batteryCapacity"
-	BatteryDischargeControl = "batteryDischargeControl"
-	BatteryGridChargeLimit  = "batteryGridChargeLimit"
-	BatteryGridChargeActive = "batteryGridChargeActive"
-	BufferSoc               = "bufferSoc"
-	BufferStartSoc          = "bufferStartSoc"
+	BatteryCapacity           = "batteryCapacity"
+	BatteryDischargeControl   = "batteryDischargeControl"
+	BatteryGridChargeLimit    = "batteryGridChargeLimit"
+	BatteryGridChargeActive   = "batteryGridChargeActive"
+	BatteryPreDischarge       = "batteryPreDischarge"
+	BatteryPreDischargeActive = "batteryPreDischargeActive"
+	BufferSoc                 = "bufferSoc"
+	BufferStartSoc            = "bufferStartSoc"
+
+	// pv settings
+	ExportLimit         = "exportLimit"
+	ExternalExportLimit = "externalExportLimit"
+
+	// grid settings
+	ImportLimit       = "importLimit"
+	GridPowerOverride = "gridPowerOverride"
+
+	// loadpoint settings
+	AllocationPolicy = "allocationPolicy"
+
+	// monthly budget
+	Budget       = "budget"
+	BudgetStatus = "budgetStatus"
+
+	// staged rollout
+	Rollout = "rollout"
 
 	// battery status
 	Battery       = "battery"
@@ -15,6 +15,7 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/coordinator"
+	"github.com/evcc-io/evcc/core/journal"
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/core/planner"
@@ -27,6 +28,7 @@ import (
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/diagnostics"
 	"github.com/evcc-io/evcc/util/modbus"
 	"github.com/evcc-io/evcc/util/telemetry"
 )
@@ -40,6 +42,7 @@ const (
 	evVehicleDisconnect   = "disconnect" // vehicle disconnected
 	evVehicleSoc          = "soc"        // vehicle soc progress
 	evVehicleUnidentified = "guest"      // vehicle unidentified
+	evVehicleIdentify     = "identify"   // vehicle identifier received, e.g. rfid tag
 	evVehicleAsleep       = "asleep"     // vehicle doesn't charge
 
 	pvTimer   = "pv"
@@ -94,8 +97,15 @@ type Loadpoint struct {
 	VehicleRef string `mapstructure:"vehicle"` // Vehicle reference
 	MeterRef   string `mapstructure:"meter"`   // Charge meter reference
 
+	BillingMeterRef string `mapstructure:"billingMeter"` // MID billing meter reference
+
 	Soc             loadpoint.SocConfig
 	Enable, Disable loadpoint.ThresholdConfig
+	Indicator       map[string]api.Indication   // Charger LED/display indication per state
+	Currents        []string                    // Charge current sources in order of preference
+	Coalesce        loadpoint.CoalesceConfig    // Charger current write coalescing
+	PhaseSwitch     loadpoint.PhaseSwitchConfig // Automatic 1p3p switching hysteresis
+	DC              bool                        // Charger is supplied from the hybrid inverter's DC bus
 
 	// from yaml
 	DefaultMode api.ChargeMode `mapstructure:"mode"`     // Default charge mode, used for disconnect
@@ -118,12 +128,14 @@ type Loadpoint struct {
 	smartCostLimit           *float64 // always charge if consumption cost is below this value
 	smartFeedInPriorityLimit *float64 // prevent charging if feed-in cost is above this value
 	batteryBoost             int      // battery boost state
+	importLimit              *float64 // charge power limit for keeping grid import within the site import limit
 
 	mode                api.ChargeMode
 	enabled             bool      // Charger enabled state
 	phases              int       // Charger enabled phases, guarded by mutex
 	measuredPhases      int       // Charger physically measured phases
 	offeredCurrent      float64   // Charger current limit
+	currentWritten      time.Time // Charger current limit write timestamp
 	socUpdated          time.Time // Soc updated timestamp (poll: connected)
 	vehicleDetect       time.Time // Vehicle connected timestamp
 	chargerSwitched     time.Time // Charger enabled/disabled timestamp
@@ -132,14 +144,16 @@ type Loadpoint struct {
 	vehicleIdentifier   string
 
 	charger          api.Charger
+	journal          *journal.Journal // Outgoing charger commands
 	chargeTimer      api.ChargeTimer
 	chargeRater      api.ChargeRater
 	chargedAtStartup float64 // session energy at startup
 
-	circuit        api.Circuit // Circuit
-	chargeMeter    api.Meter   // Charger usage meter
-	vehicle        api.Vehicle // Currently active vehicle
-	defaultVehicle api.Vehicle // Default vehicle (disables detection)
+	circuit        api.Circuit     // Circuit
+	chargeMeter    api.Meter       // Charger usage meter
+	billingMeter   api.MeterEnergy // MID billing meter, used for session accounting only
+	vehicle        api.Vehicle     // Currently active vehicle
+	defaultVehicle api.Vehicle     // Default vehicle (disables detection)
 	coordinator    coordinator.API
 	socEstimator   *soc.Estimator
 
@@ -149,16 +163,28 @@ type Loadpoint struct {
 	planPrecondition time.Duration // precondition duration
 	planEnergy       float64       // Plan charge energy in kWh (dumb vehicles)
 	planSlotEnd      time.Time     // current plan slot end time
+	planCommitted    api.Rates     // plan slots committed to
+	planCommitTime   time.Time     // time goal of the committed plan
 	planActive       bool          // charge plan exists and has a currently active slot
+	indicated        string        // last indicator state applied to the charger
 
 	// cached state
-	status         api.ChargeStatus // Charger status
-	chargePower    float64          // Charging power
-	chargeCurrents []float64        // Phase currents
-	connectedTime  time.Time        // Time when vehicle was connected
-	pvTimer        time.Time        // PV enabled/disable timer
-	phaseTimer     time.Time        // 1p3p switch timer
-	wakeUpTimer    *Timer           // Vehicle wake-up timeout
+	status               api.ChargeStatus           // Charger status
+	chargePower          float64                    // Charging power
+	chargeCurrents       []float64                  // Phase currents
+	chargeCurrentsSource string                     // Phase currents source
+	connectedTime        time.Time                  // Time when vehicle was connected
+	maintenanceTopUp     bool                       // Battery maintenance top-up in progress
+	sessionState         session.State              // Detailed charging state
+	sessionStateTime     time.Time                  // Time of last session state change
+	chargedSinceConnect  bool                       // Vehicle has been charging since connected
+	pvTimer              time.Time                  // PV enabled/disable timer
+	phaseTimer           time.Time                  // 1p3p switch timer
+	phaseSwitchStats     loadpoint.PhaseSwitchStats // 1p3p switch statistics
+	wakeUpTimer          *Timer                     // Vehicle wake-up timeout
+	remoteDemand         loadpoint.RemoteDemand     // External status demand
+	remoteDemandUpdated  time.Time                  // Last external status demand
+	currentOverride      *override                  // External charge current demand
 
 	// charge progress
 	vehicleSoc              float64       // Vehicle or charger soc
@@ -184,6 +210,10 @@ func NewLoadpointFromConfig(log *util.Logger, settings settings.Settings, other
 		return nil, err
 	}
 
+	if err := validateCurrentsSources(lp.Currents); err != nil {
+		return nil, err
+	}
+
 	// set vehicle polling mode
 	switch lp.Soc.Poll.Mode {
 	case loadpoint.PollCharging:
@@ -235,6 +265,18 @@ func NewLoadpointFromConfig(log *util.Logger, settings settings.Settings, other
 		}
 	}
 
+	if lp.BillingMeterRef != "" {
+		dev, err := config.Meters().ByName(lp.BillingMeterRef)
+		if err != nil {
+			return lp, fmt.Errorf("billing meter: %w", err)
+		}
+		m, ok := dev.Instance().(api.MeterEnergy)
+		if !ok {
+			return lp, errors.New("billing meter: missing energy total")
+		}
+		lp.billingMeter = m
+	}
+
 	// default vehicle
 	if lp.VehicleRef != "" {
 		dev, err := config.Vehicles().ByName(lp.VehicleRef)
@@ -286,6 +328,7 @@ func NewLoadpoint(log *util.Logger, settings settings.Settings) *Loadpoint {
 		settings:   settings, // settings
 		clock:      clock,    // mockable time
 		bus:        bus,      // event bus
+		journal:    journal.New(clock, journal.DefaultSize),
 		mode:       api.ModeOff,
 		status:     api.StatusNone,
 		minCurrent: 6,  // A
@@ -519,7 +562,11 @@ func (lp *Loadpoint) evVehicleConnectHandler() {
 func (lp *Loadpoint) evVehicleDisconnectHandler() {
 	lp.log.INFO.Println("car disconnected")
 
-	// session is persisted during evChargeStopHandler which runs before
+	// session is persisted during evChargeStopHandler which runs before,
+	// the plug-out is added for detecting the vehicle's typical departure times
+	lp.updateSession(func(s *session.Session) {
+		s.Disconnect(lp.clock.Now())
+	})
 	lp.clearSession()
 
 	// phases are unknown when vehicle disconnects
@@ -651,6 +698,7 @@ func (lp *Loadpoint) Prepare(site site.API, uiChan chan<- util.Param, pushChan c
 	lp.publish(keys.SmartCostLimit, lp.smartCostLimit)
 	lp.publish(keys.SmartFeedInPriorityLimit, lp.smartFeedInPriorityLimit)
 	lp.publishTimer(phaseTimer, 0, timerInactive)
+	lp.publish(keys.PhaseSwitches, lp.phaseSwitchStats)
 	lp.publishTimer(pvTimer, 0, timerInactive)
 
 	// charger features
@@ -742,7 +790,7 @@ func (lp *Loadpoint) syncCharger() error {
 		enabled = true
 
 		if shouldBeConsistent {
-			if err := lp.charger.Enable(true); err != nil { // also enable charger to correct internal state
+			if err := lp.chargerEnable(true); err != nil { // also enable charger to correct internal state
 				return fmt.Errorf("charger enable: %w", err)
 			}
 
@@ -828,7 +876,7 @@ func (lp *Loadpoint) syncCharger() error {
 	case !enabled && !lp.phaseSwitchCompleted():
 		// some chargers (i.E. Easee in some configurations) disable themselves to be able to switch phases
 		// -> enable charger
-		if err := lp.charger.Enable(true); err != nil {
+		if err := lp.chargerEnable(true); err != nil {
 			return fmt.Errorf("charger enable: %w", err)
 		}
 
@@ -877,6 +925,11 @@ func (lp *Loadpoint) setLimit(current float64) error {
 		current = lp.roundedCurrent(min(currentLimit, currentLimitViaPower))
 	}
 
+	// apply site import limit
+	if lp.importLimit != nil {
+		current = lp.roundedCurrent(min(current, powerToCurrent(*lp.importLimit, lp.ActivePhases())))
+	}
+
 	// https://github.com/evcc-io/evcc/issues/16309
 	effMinCurrent := lp.effectiveMinCurrent()
 	if effMaxCurrent := lp.effectiveMaxCurrent(); effMinCurrent > effMaxCurrent {
@@ -884,21 +937,14 @@ func (lp *Loadpoint) setLimit(current float64) error {
 	}
 
 	// set current
-	if current != lp.offeredCurrent && current >= effMinCurrent {
-		var err error
-		if charger, ok := lp.charger.(api.ChargerEx); ok {
-			err = charger.MaxCurrentMillis(current)
-		} else {
-			err = lp.charger.MaxCurrent(int64(current))
-		}
-
-		if err != nil {
+	if current != lp.offeredCurrent && current >= effMinCurrent && !lp.coalesceCurrent(current, effMinCurrent) {
+		if err := lp.chargerMaxCurrent(current); err != nil {
 			v := lp.GetVehicle()
 			if vv, ok := v.(api.Resurrector); ok && errors.Is(err, api.ErrAsleep) {
 				// https://github.com/evcc-io/evcc/issues/8254
 				// wakeup vehicle
 				lp.log.DEBUG.Printf("set charge current limit: waking up vehicle")
-				if err := vv.WakeUp(); err != nil {
+				if err := lp.directCommand(journal.WakeUp, "vehicle", vv.WakeUp); err != nil {
 					return fmt.Errorf("wake-up vehicle: %w", err)
 				}
 			}
@@ -908,18 +954,19 @@ func (lp *Loadpoint) setLimit(current float64) error {
 
 		lp.log.DEBUG.Printf("set charge current limit: %.3gA", current)
 		lp.offeredCurrent = current
+		lp.currentWritten = lp.clock.Now()
 		lp.bus.Publish(evChargeCurrent, current)
 	}
 
 	// set enabled/disabled
 	if enabled := current >= effMinCurrent; enabled != lp.enabled {
-		if err := lp.charger.Enable(enabled); err != nil {
+		if err := lp.chargerEnable(enabled); err != nil {
 			v := lp.GetVehicle()
 			if vv, ok := v.(api.Resurrector); enabled && ok && errors.Is(err, api.ErrAsleep) {
 				// https://github.com/evcc-io/evcc/issues/8254
 				// wakeup vehicle
 				lp.log.DEBUG.Printf("charger %s: waking up vehicle", status[enabled])
-				if err := vv.WakeUp(); err != nil {
+				if err := lp.directCommand(journal.WakeUp, "vehicle", vv.WakeUp); err != nil {
 					return fmt.Errorf("wake-up vehicle: %w", err)
 				}
 			}
@@ -1171,6 +1218,7 @@ func (lp *Loadpoint) resetPhaseTimer() {
 
 	lp.phaseTimer = time.Time{}
 	lp.publishTimer(phaseTimer, 0, timerInactive)
+	lp.publish(keys.PhaseSwitches, lp.phaseSwitchStats)
 }
 
 // scalePhasesRequired validates if fixed phase configuration matches enabled phases
@@ -1201,7 +1249,7 @@ func (lp *Loadpoint) scalePhases(phases int) error {
 
 	if lp.GetPhases() != phases {
 		// switch phases
-		if err := cp.Phases1p3p(phases); err != nil {
+		if err := lp.chargerPhases1p3p(cp, phases); err != nil {
 			return fmt.Errorf("switch phases: %w", err)
 		}
 
@@ -1209,6 +1257,7 @@ func (lp *Loadpoint) scalePhases(phases int) error {
 
 		// prevent premature measurement of active phases
 		lp.phasesSwitched = lp.clock.Now()
+		lp.countPhaseSwitch()
 
 		// update setting and reset timer
 		lp.SetPhases(phases)
@@ -1274,9 +1323,10 @@ func (lp *Loadpoint) pvScalePhases(sitePower, minCurrent, maxCurrent float64) in
 			lp.phaseTimer = lp.clock.Now()
 		}
 
-		lp.publishTimer(phaseTimer, lp.GetDisableDelay(), phaseScale1p)
+		delay := lp.phaseScaleDelay(1)
+		lp.publishTimer(phaseTimer, delay, phaseScale1p)
 
-		if elapsed := lp.clock.Since(lp.phaseTimer); elapsed >= lp.GetDisableDelay() {
+		if elapsed := lp.clock.Since(lp.phaseTimer); elapsed >= delay && lp.phaseSwitchAllowed() {
 			if err := lp.scalePhases(1); err != nil {
 				lp.log.ERROR.Println(err)
 			}
@@ -1303,9 +1353,10 @@ func (lp *Loadpoint) pvScalePhases(sitePower, minCurrent, maxCurrent float64) in
 			lp.phaseTimer = lp.clock.Now()
 		}
 
-		lp.publishTimer(phaseTimer, lp.GetEnableDelay(), phaseScale3p)
+		delay := lp.phaseScaleDelay(3)
+		lp.publishTimer(phaseTimer, delay, phaseScale3p)
 
-		if elapsed := lp.clock.Since(lp.phaseTimer); elapsed >= lp.GetEnableDelay() {
+		if elapsed := lp.clock.Since(lp.phaseTimer); elapsed >= delay && lp.phaseSwitchAllowed() {
 			if err := lp.scalePhases(3); err != nil {
 				lp.log.ERROR.Println(err)
 			}
@@ -1530,37 +1581,26 @@ func (lp *Loadpoint) UpdateChargePowerAndCurrents() float64 {
 	}
 
 	// update charge currents
-	lp.chargeCurrents = nil
-
-	if phaseMeter, ok := lp.chargeMeter.(api.PhaseCurrents); ok {
-		if err := backoff.Retry(func() error {
-			i1, i2, i3, err := phaseMeter.Currents()
-			if err != nil {
-				if errors.Is(err, api.ErrNotAvailable) {
-					err = backoff.Permanent(err)
-				}
-				return err
-			}
-
-			lp.Lock()
-			lp.chargeCurrents = []float64{i1, i2, i3}
-			lp.Unlock()
+	currents, source := lp.chargeCurrentsFromSources(power, err)
 
-			lp.log.DEBUG.Printf("charge currents: %.3gA", lp.chargeCurrents)
-			lp.publish(keys.ChargeCurrents, lp.chargeCurrents)
+	lp.Lock()
+	lp.chargeCurrents = currents
+	lp.chargeCurrentsSource = source
+	lp.Unlock()
 
-			return nil
-		}, modbus.Backoff()); err != nil && !errors.Is(err, api.ErrNotAvailable) {
-			lp.log.ERROR.Printf("charge currents: %v", err)
-		}
+	if currents != nil {
+		lp.log.DEBUG.Printf("charge currents: %.3gA (%s)", currents, source)
+		lp.publish(keys.ChargeCurrents, currents)
 	}
+	lp.publish(keys.ChargeCurrentsSource, source)
 
 	return power
 }
 
 // phasesFromChargeCurrents uses PhaseCurrents interface to count phases with current >=1A
 func (lp *Loadpoint) phasesFromChargeCurrents() {
-	if lp.chargeCurrents == nil {
+	// estimated currents are derived from active phases
+	if lp.chargeCurrents == nil || lp.chargeCurrentsSource == currentsEstimate {
 		return
 	}
 
@@ -1816,6 +1856,9 @@ func (lp *Loadpoint) phaseSwitchCompleted() bool {
 func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, feedin api.Rates, batteryBuffered, batteryStart bool, greenShare float64, effPrice, effCo2 *float64) {
 	// smart cost
 	smartCostActive, smartCostNextStart := lp.checkSmartLimit(lp.GetSmartCostLimit(), consumption, true)
+	if !smartCostActive && lp.smartCostExportActive(lp.GetSmartCostLimit(), consumption, feedin, sitePower) {
+		smartCostActive, smartCostNextStart = true, time.Time{}
+	}
 	lp.publish(keys.SmartCostActive, smartCostActive)
 	lp.publish(keys.SmartCostNextStart, smartCostNextStart)
 
@@ -1831,6 +1874,7 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 	lp.phasesFromChargeCurrents()
 
 	lp.energyMetrics.SetEnvironment(greenShare, effPrice, effCo2)
+	lp.energyMetrics.SetFeedInPrice(lp.feedInPrice())
 
 	// update ChargeRater here to make sure initial meter update is caught
 	lp.bus.Publish(evChargeCurrent, lp.offeredCurrent)
@@ -1851,7 +1895,9 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 		dim := lp.circuit != nil && lp.circuit.Dimmed()
 
 		if dim != dimmed {
-			if err := dimmer.Dim(dim); err != nil {
+			if err := lp.directCommand(journal.Dim, dim, func() error {
+				return dimmer.Dim(dim)
+			}); err != nil {
 				lp.log.ERROR.Printf("dim: %v", err)
 				return
 			}
@@ -1872,6 +1918,11 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 		return
 	}
 
+	// diagnostics are optional
+	if vals, err := diagnostics.Values(lp.charger); err == nil {
+		lp.publish(keys.ChargerDiagnostics, vals)
+	}
+
 	lp.publish(keys.VehicleWelcomeActive, welcomeCharge)
 	lp.publish(keys.Connected, lp.connected())
 	lp.publish(keys.Charging, lp.charging())
@@ -1913,6 +1964,16 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 	// update and publish plan without being short-circuited by modes etc.
 	plannerActive := lp.plannerActive()
 
+	_, maintenanceActive := lp.maintenancePolicy()
+	lp.publish(keys.MaintenanceActive, maintenanceActive)
+
+	// effective remote disable status
+	remoteDisabled := loadpoint.RemoteEnable
+	lp.expireRemoteDemand()
+
+	currentOverride := lp.activeCurrentOverride()
+	lp.publish(keys.CurrentOverride, currentOverride)
+
 	// execute loading strategy
 	switch {
 	case !lp.connected():
@@ -1930,6 +1991,10 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 		}
 		err = lp.setLimit(current)
 
+	// external override takes precedence over all charge modes except off
+	case currentOverride != nil:
+		err = lp.setLimit(min(*currentOverride, lp.effectiveMaxCurrent()))
+
 	// minimum or target charging
 	case lp.minSocNotReached() || plannerActive:
 		err = lp.fastCharging()
@@ -1948,6 +2013,10 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 	case mode == api.ModeNow:
 		err = lp.fastCharging()
 
+	// gentle top-up of idle vehicles
+	case lp.maintenanceTopUpRequired():
+		err = lp.setLimit(lp.effectiveMinCurrent())
+
 	case mode == api.ModeMinPV || mode == api.ModePV:
 		// cheap tariff
 		if smartCostActive {
@@ -1977,6 +2046,17 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 
 		targetCurrent := lp.pvMaxCurrent(mode, sitePower, batteryBoostPower, batteryBuffered, batteryStart)
 
+		// remote soft disable only affects surplus charging
+		if lp.remoteControlled(loadpoint.RemoteSoftDisable) {
+			remoteDisabled = loadpoint.RemoteSoftDisable
+
+			var minCurrent float64
+			if mode == api.ModeMinPV {
+				minCurrent = lp.effectiveMinCurrent()
+			}
+			targetCurrent = min(targetCurrent, minCurrent)
+		}
+
 		if targetCurrent == 0 && lp.vehicleClimateActive() {
 			targetCurrent = lp.effectiveMinCurrent()
 		}
@@ -2002,13 +2082,17 @@ func (lp *Loadpoint) Update(sitePower, batteryBoostPower float64, consumption, f
 	}
 
 	// effective disabled status
-	// TODO use for §14a
-	// if remoteDisabled != loadpoint.RemoteEnable {
-	// 	lp.publish(keys.RemoteDisabled, remoteDisabled)
-	// }
+	lp.publish(keys.RemoteDisabled, remoteDisabled)
+
+	lp.updateSessionState()
+
+	lp.updateIndicator(err)
 
 	// log any error
-	if err != nil {
+	switch {
+	case errors.Is(err, api.ErrMustRetry):
+		lp.log.DEBUG.Println(err)
+	case err != nil:
 		lp.log.ERROR.Println(err)
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/journal"
 )
 
 //go:generate go tool mockgen -package loadpoint -destination mock.go -mock_names API=MockAPI github.com/evcc-io/evcc/core/loadpoint API
@@ -34,6 +35,10 @@ type API interface {
 	GetMeterRef() string
 	// SetMeterRef sets the loadpoint meter
 	SetMeterRef(string)
+	// GetBillingMeterRef returns the loadpoint billing meter
+	GetBillingMeterRef() string
+	// SetBillingMeterRef sets the loadpoint billing meter
+	SetBillingMeterRef(string)
 	// GetCircuitRef returns the loadpoint circuit
 	GetCircuitRef() string
 	// SetCircuitRef sets the loadpoint circuit
@@ -183,6 +188,8 @@ type API interface {
 	HasChargeMeter() bool
 	// GetChargePower returns the current charging power
 	GetChargePower() float64
+	// DCCoupled returns true if the charger is supplied from the hybrid inverter's DC bus
+	DCCoupled() bool
 	// GetChargePowerFlexibility returns the flexible amount of current charging power
 	GetChargePowerFlexibility(rates api.Rates) float64
 	// GetMaxPhaseCurrent returns max phase current
@@ -203,6 +210,8 @@ type API interface {
 	// vehicles
 	//
 
+	// GetVehicleIdentifier returns the vehicle id as read from the charger
+	GetVehicleIdentifier() string
 	// GetVehicle gets the active vehicle
 	GetVehicle() api.Vehicle
 	// SetVehicle sets the active vehicle
@@ -211,4 +220,24 @@ type API interface {
 	StartVehicleDetection()
 	// GetSoc returns the last vehicle or charger soc in %
 	GetSoc() float64
+	// GetPhaseSwitchStats returns the phase switch statistics
+	GetPhaseSwitchStats() PhaseSwitchStats
+
+	//
+	// charger commands
+	//
+
+	// GetJournal returns the journal of charger commands
+	GetJournal() []journal.Entry
+	// InstallCertificate installs an ISO 15118 Plug & Charge certificate on the charger
+	InstallCertificate(typ, pem string) error
+
+	//
+	// remote control
+	//
+
+	// RemoteControl sets remote status demand
+	RemoteControl(string, RemoteDemand)
+	// SetCurrentOverride temporarily forces the charge current, nil removes the override
+	SetCurrentOverride(current *float64, timeout time.Duration)
 }
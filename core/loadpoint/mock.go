@@ -14,6 +14,7 @@ import (
 	time "time"
 
 	api "github.com/evcc-io/evcc/api"
+	journal "github.com/evcc-io/evcc/core/journal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +56,20 @@ func (mr *MockAPIMockRecorder) ActivePhases() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivePhases", reflect.TypeOf((*MockAPI)(nil).ActivePhases))
 }
 
+// DCCoupled mocks base method.
+func (m *MockAPI) DCCoupled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DCCoupled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// DCCoupled indicates an expected call of DCCoupled.
+func (mr *MockAPIMockRecorder) DCCoupled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DCCoupled", reflect.TypeOf((*MockAPI)(nil).DCCoupled))
+}
+
 // EffectiveLimitSoc mocks base method.
 func (m *MockAPI) EffectiveLimitSoc() int {
 	m.ctrl.T.Helper()
@@ -153,6 +168,20 @@ func (mr *MockAPIMockRecorder) GetBatteryBoost() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatteryBoost", reflect.TypeOf((*MockAPI)(nil).GetBatteryBoost))
 }
 
+// GetBillingMeterRef mocks base method.
+func (m *MockAPI) GetBillingMeterRef() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillingMeterRef")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetBillingMeterRef indicates an expected call of GetBillingMeterRef.
+func (mr *MockAPIMockRecorder) GetBillingMeterRef() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingMeterRef", reflect.TypeOf((*MockAPI)(nil).GetBillingMeterRef))
+}
+
 // GetChargePower mocks base method.
 func (m *MockAPI) GetChargePower() float64 {
 	m.ctrl.T.Helper()
@@ -307,6 +336,20 @@ func (mr *MockAPIMockRecorder) GetEnableThreshold() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnableThreshold", reflect.TypeOf((*MockAPI)(nil).GetEnableThreshold))
 }
 
+// GetJournal mocks base method.
+func (m *MockAPI) GetJournal() []journal.Entry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournal")
+	ret0, _ := ret[0].([]journal.Entry)
+	return ret0
+}
+
+// GetJournal indicates an expected call of GetJournal.
+func (mr *MockAPIMockRecorder) GetJournal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournal", reflect.TypeOf((*MockAPI)(nil).GetJournal))
+}
+
 // GetLimitEnergy mocks base method.
 func (m *MockAPI) GetLimitEnergy() float64 {
 	m.ctrl.T.Helper()
@@ -405,6 +448,20 @@ func (mr *MockAPIMockRecorder) GetMode() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMode", reflect.TypeOf((*MockAPI)(nil).GetMode))
 }
 
+// GetPhaseSwitchStats mocks base method.
+func (m *MockAPI) GetPhaseSwitchStats() PhaseSwitchStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhaseSwitchStats")
+	ret0, _ := ret[0].(PhaseSwitchStats)
+	return ret0
+}
+
+// GetPhaseSwitchStats indicates an expected call of GetPhaseSwitchStats.
+func (mr *MockAPIMockRecorder) GetPhaseSwitchStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhaseSwitchStats", reflect.TypeOf((*MockAPI)(nil).GetPhaseSwitchStats))
+}
+
 // GetPhases mocks base method.
 func (m *MockAPI) GetPhases() int {
 	m.ctrl.T.Helper()
@@ -660,6 +717,20 @@ func (mr *MockAPIMockRecorder) GetVehicle() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicle", reflect.TypeOf((*MockAPI)(nil).GetVehicle))
 }
 
+// GetVehicleIdentifier mocks base method.
+func (m *MockAPI) GetVehicleIdentifier() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVehicleIdentifier")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetVehicleIdentifier indicates an expected call of GetVehicleIdentifier.
+func (mr *MockAPIMockRecorder) GetVehicleIdentifier() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicleIdentifier", reflect.TypeOf((*MockAPI)(nil).GetVehicleIdentifier))
+}
+
 // HasChargeMeter mocks base method.
 func (m *MockAPI) HasChargeMeter() bool {
 	m.ctrl.T.Helper()
@@ -674,6 +745,20 @@ func (mr *MockAPIMockRecorder) HasChargeMeter() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasChargeMeter", reflect.TypeOf((*MockAPI)(nil).HasChargeMeter))
 }
 
+// InstallCertificate mocks base method.
+func (m *MockAPI) InstallCertificate(typ, pem string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallCertificate", typ, pem)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallCertificate indicates an expected call of InstallCertificate.
+func (mr *MockAPIMockRecorder) InstallCertificate(typ, pem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallCertificate", reflect.TypeOf((*MockAPI)(nil).InstallCertificate), typ, pem)
+}
+
 // IsFastChargingActive mocks base method.
 func (m *MockAPI) IsFastChargingActive() bool {
 	m.ctrl.T.Helper()
@@ -700,6 +785,18 @@ func (mr *MockAPIMockRecorder) PublishEffectiveValues() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEffectiveValues", reflect.TypeOf((*MockAPI)(nil).PublishEffectiveValues))
 }
 
+// RemoteControl mocks base method.
+func (m *MockAPI) RemoteControl(arg0 string, arg1 RemoteDemand) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoteControl", arg0, arg1)
+}
+
+// RemoteControl indicates an expected call of RemoteControl.
+func (mr *MockAPIMockRecorder) RemoteControl(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoteControl", reflect.TypeOf((*MockAPI)(nil).RemoteControl), arg0, arg1)
+}
+
 // SetBatteryBoost mocks base method.
 func (m *MockAPI) SetBatteryBoost(enable bool) error {
 	m.ctrl.T.Helper()
@@ -714,6 +811,18 @@ func (mr *MockAPIMockRecorder) SetBatteryBoost(enable any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBatteryBoost", reflect.TypeOf((*MockAPI)(nil).SetBatteryBoost), enable)
 }
 
+// SetBillingMeterRef mocks base method.
+func (m *MockAPI) SetBillingMeterRef(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBillingMeterRef", arg0)
+}
+
+// SetBillingMeterRef indicates an expected call of SetBillingMeterRef.
+func (mr *MockAPIMockRecorder) SetBillingMeterRef(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBillingMeterRef", reflect.TypeOf((*MockAPI)(nil).SetBillingMeterRef), arg0)
+}
+
 // SetChargerRef mocks base method.
 func (m *MockAPI) SetChargerRef(arg0 string) {
 	m.ctrl.T.Helper()
@@ -738,6 +847,18 @@ func (mr *MockAPIMockRecorder) SetCircuitRef(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCircuitRef", reflect.TypeOf((*MockAPI)(nil).SetCircuitRef), arg0)
 }
 
+// SetCurrentOverride mocks base method.
+func (m *MockAPI) SetCurrentOverride(current *float64, timeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCurrentOverride", current, timeout)
+}
+
+// SetCurrentOverride indicates an expected call of SetCurrentOverride.
+func (mr *MockAPIMockRecorder) SetCurrentOverride(current, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentOverride", reflect.TypeOf((*MockAPI)(nil).SetCurrentOverride), current, timeout)
+}
+
 // SetDefaultMode mocks base method.
 func (m *MockAPI) SetDefaultMode(arg0 api.ChargeMode) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,27 @@ type ThresholdConfig struct {
 	Threshold float64       `json:"threshold"`
 }
 
+// CoalesceConfig defines how current setpoint changes are combined into fewer charger writes
+type CoalesceConfig struct {
+	Threshold float64       `json:"threshold"` // minimum current change before writing (A)
+	Interval  time.Duration `json:"interval"`  // minimum time between current writes
+}
+
+// PhaseSwitchConfig defines automatic 1p3p switching hysteresis
+type PhaseSwitchConfig struct {
+	EnableDelay  time.Duration `json:"enableDelay"`  // delay before scaling up to 3p, defaults to enable delay
+	DisableDelay time.Duration `json:"disableDelay"` // delay before scaling down to 1p, defaults to disable delay
+	MinDwell     time.Duration `json:"minDwell"`     // minimum time between phase switches while charging
+	MaxSwitches  int           `json:"maxSwitches"`  // maximum phase switches per day while charging, 0 for unlimited
+}
+
+// PhaseSwitchStats defines phase switch statistics
+type PhaseSwitchStats struct {
+	Today int       `json:"today"` // phase switches today
+	Total int       `json:"total"` // phase switches since start
+	Last  time.Time `json:"last"`  // time of last phase switch
+}
+
 // SocConfig defines soc settings, estimation and update behavior
 type SocConfig struct {
 	Poll     PollConfig `json:"poll"`
@@ -37,3 +58,12 @@ const (
 	PollConnected
 	PollAlways
 )
+
+// RemoteDemand defines external status demand
+type RemoteDemand string
+
+// remote demand definitions
+const (
+	RemoteEnable      RemoteDemand = ""
+	RemoteSoftDisable RemoteDemand = "soft"
+)
@@ -59,6 +59,26 @@ func (lp *Loadpoint) SetMeterRef(ref string) {
 	lp.settings.SetString(keys.Meter, ref)
 }
 
+// GetBillingMeterRef returns the loadpoint billing meter
+func (lp *Loadpoint) GetBillingMeterRef() string {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.BillingMeterRef
+}
+
+// SetBillingMeterRef sets the loadpoint billing meter
+func (lp *Loadpoint) SetBillingMeterRef(ref string) {
+	if !lp.isConfigurable() {
+		lp.log.ERROR.Println("cannot set billing meter ref: not configurable")
+		return
+	}
+
+	lp.Lock()
+	defer lp.Unlock()
+	lp.BillingMeterRef = ref
+	lp.settings.SetString(keys.BillingMeter, ref)
+}
+
 // GetCircuitName returns the loadpoint circuit
 func (lp *Loadpoint) GetCircuitRef() string {
 	lp.RLock()
@@ -169,6 +189,11 @@ func (lp *Loadpoint) SetMode(mode api.ChargeMode) {
 		lp.batteryBoost = boostDisabled
 		lp.publish(keys.BatteryBoost, false)
 
+		// remote demand does not survive user interaction
+		if lp.remoteDemand != loadpoint.RemoteEnable {
+			lp.setRemoteDemand(loadpoint.RemoteEnable, "")
+		}
+
 		// reset timers
 		switch mode {
 		case api.ModeNow, api.ModeOff:
@@ -584,6 +609,11 @@ func (lp *Loadpoint) HasChargeMeter() bool {
 	return lp.chargeMeter != nil && !isWrapped
 }
 
+// DCCoupled returns true if the charger is supplied from the hybrid inverter's DC bus
+func (lp *Loadpoint) DCCoupled() bool {
+	return lp.DC
+}
+
 // GetChargePower returns the current charge power
 func (lp *Loadpoint) GetChargePower() float64 {
 	lp.RLock()
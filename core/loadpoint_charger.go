@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/evcc-io/evcc/api"
@@ -26,3 +27,21 @@ func (lp *Loadpoint) chargerSoc() (float64, error) {
 	}
 	return 0, api.ErrNotAvailable
 }
+
+// InstallCertificate installs an ISO 15118 Plug & Charge certificate on the charger
+func (lp *Loadpoint) InstallCertificate(typ, pem string) error {
+	c, ok := lp.charger.(api.CertificateInstaller)
+	if !ok {
+		return api.ErrNotAvailable
+	}
+
+	switch typ {
+	case api.CertificateV2GRoot, api.CertificateMORoot:
+	default:
+		return fmt.Errorf("invalid certificate type: %s", typ)
+	}
+
+	lp.log.DEBUG.Println("install certificate:", typ)
+
+	return c.InstallCertificate(typ, pem)
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"math"
+)
+
+// coalesceCurrent returns true if writing the current limit should be deferred.
+// Small changes and changes shortly after the previous write are combined into a later write
+// to reduce charger wear and bus traffic. Enabling, reaching the current bounds and decreases
+// required by circuits are never deferred.
+func (lp *Loadpoint) coalesceCurrent(current, effMinCurrent float64) bool {
+	cc := lp.Coalesce
+	if cc.Threshold <= 0 && cc.Interval <= 0 {
+		return false
+	}
+
+	// charger disabled or current unknown
+	if !lp.enabled || lp.offeredCurrent == 0 {
+		return false
+	}
+
+	if current == effMinCurrent || current == lp.effectiveMaxCurrent() {
+		return false
+	}
+
+	if lp.circuit != nil && current < lp.offeredCurrent {
+		return false
+	}
+
+	if math.Abs(current-lp.offeredCurrent) < cc.Threshold {
+		return true
+	}
+
+	return cc.Interval > 0 && lp.clock.Since(lp.currentWritten) < cc.Interval
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesceCurrent(t *testing.T) {
+	clock := clock.NewMock()
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.clock = clock
+	lp.enabled = true
+	lp.offeredCurrent = 10
+
+	// disabled by default
+	assert.False(t, lp.coalesceCurrent(10.2, 6))
+
+	lp.Coalesce = loadpoint.CoalesceConfig{Threshold: 0.5, Interval: time.Minute}
+	lp.currentWritten = clock.Now()
+	clock.Add(2 * time.Minute)
+
+	for _, tc := range []struct {
+		current  float64
+		coalesce bool
+	}{
+		{10.4, true},  // below threshold
+		{9.6, true},   // below threshold
+		{10.5, false}, // threshold reached
+		{6, false},    // min current
+		{16, false},   // max current
+	} {
+		assert.Equal(t, tc.coalesce, lp.coalesceCurrent(tc.current, 6), tc.current)
+	}
+
+	// within interval
+	lp.currentWritten = clock.Now()
+	assert.True(t, lp.coalesceCurrent(12, 6))
+	assert.False(t, lp.coalesceCurrent(16, 6))
+
+	// charger disabled
+	lp.enabled = false
+	assert.False(t, lp.coalesceCurrent(10.2, 6))
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/modbus"
+)
+
+// charge current sources
+const (
+	currentsCharger  = "charger"  // charger phase currents
+	currentsMeter    = "meter"    // charge meter phase currents
+	currentsEstimate = "estimate" // estimated from charge power and active phases
+)
+
+// defaultCurrentsSources prefers the charge meter, which is the charger unless a dedicated meter is configured
+var defaultCurrentsSources = []string{currentsMeter, currentsCharger}
+
+// validateCurrentsSources checks the configured charge current sources
+func validateCurrentsSources(sources []string) error {
+	for _, s := range sources {
+		if !slices.Contains([]string{currentsCharger, currentsMeter, currentsEstimate}, s) {
+			return fmt.Errorf("invalid currents source: %s", s)
+		}
+	}
+	return nil
+}
+
+// readChargeCurrents reads the phase currents with retry
+func readChargeCurrents(pc api.PhaseCurrents) ([]float64, error) {
+	return backoff.RetryWithData(func() ([]float64, error) {
+		i1, i2, i3, err := pc.Currents()
+		if err != nil {
+			if errors.Is(err, api.ErrNotAvailable) {
+				err = backoff.Permanent(err)
+			}
+			return nil, err
+		}
+		return []float64{i1, i2, i3}, nil
+	}, modbus.Backoff())
+}
+
+// estimateChargeCurrents distributes the charge power evenly across the active phases
+func (lp *Loadpoint) estimateChargeCurrents(power float64) []float64 {
+	phases := lp.ActivePhases()
+	current := max(0, powerToCurrent(power, phases))
+
+	res := make([]float64, 3)
+	for i := range phases {
+		res[i] = current
+	}
+
+	return res
+}
+
+// chargeCurrentsFromSources returns the charge currents from the first available source
+func (lp *Loadpoint) chargeCurrentsFromSources(power float64, powerErr error) ([]float64, string) {
+	sources := lp.Currents
+	if len(sources) == 0 {
+		sources = defaultCurrentsSources
+	}
+
+	for _, source := range sources {
+		var pc api.PhaseCurrents
+
+		switch source {
+		case currentsCharger:
+			pc, _ = lp.charger.(api.PhaseCurrents)
+		case currentsMeter:
+			pc, _ = lp.chargeMeter.(api.PhaseCurrents)
+		case currentsEstimate:
+			if powerErr == nil {
+				return lp.estimateChargeCurrents(power), source
+			}
+		}
+
+		if pc == nil {
+			continue
+		}
+
+		res, err := readChargeCurrents(pc)
+		if err == nil {
+			return res, source
+		}
+
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("charge currents (%s): %v", source, err)
+		}
+	}
+
+	return nil, ""
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChargeCurrentsFromSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	Voltage = 230 // V
+
+	charger := struct {
+		*api.MockCharger
+		*api.MockPhaseCurrents
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockPhaseCurrents(ctrl),
+	}
+
+	meter := struct {
+		*api.MockMeter
+		*api.MockPhaseCurrents
+	}{
+		api.NewMockMeter(ctrl),
+		api.NewMockPhaseCurrents(ctrl),
+	}
+
+	lp := &Loadpoint{
+		log:         util.NewLogger("foo"),
+		clock:       clock.NewMock(),
+		charger:     charger,
+		chargeMeter: meter,
+		phases:      1,
+	}
+
+	// default prefers meter
+	meter.MockPhaseCurrents.EXPECT().Currents().Return(6.0, 0.0, 0.0, nil)
+	res, source := lp.chargeCurrentsFromSources(1380, nil)
+	assert.Equal(t, []float64{6, 0, 0}, res)
+	assert.Equal(t, currentsMeter, source)
+
+	// charger first, falling back to meter
+	lp.Currents = []string{currentsCharger, currentsMeter, currentsEstimate}
+	charger.MockPhaseCurrents.EXPECT().Currents().Return(0.0, 0.0, 0.0, api.ErrNotAvailable)
+	meter.MockPhaseCurrents.EXPECT().Currents().Return(7.0, 0.0, 0.0, nil)
+	res, source = lp.chargeCurrentsFromSources(1380, nil)
+	assert.Equal(t, []float64{7, 0, 0}, res)
+	assert.Equal(t, currentsMeter, source)
+
+	// estimate from power and phases
+	charger.MockPhaseCurrents.EXPECT().Currents().Return(0.0, 0.0, 0.0, api.ErrNotAvailable)
+	meter.MockPhaseCurrents.EXPECT().Currents().Return(0.0, 0.0, 0.0, api.ErrNotAvailable)
+	res, source = lp.chargeCurrentsFromSources(2300, nil)
+	assert.Equal(t, []float64{10, 0, 0}, res)
+	assert.Equal(t, currentsEstimate, source)
+
+	// no estimate without power
+	charger.MockPhaseCurrents.EXPECT().Currents().Return(0.0, 0.0, 0.0, api.ErrNotAvailable)
+	meter.MockPhaseCurrents.EXPECT().Currents().Return(0.0, 0.0, 0.0, api.ErrNotAvailable)
+	res, source = lp.chargeCurrentsFromSources(0, errors.New("power"))
+	assert.Nil(t, res)
+	assert.Empty(t, source)
+}
+
+func TestValidateCurrentsSources(t *testing.T) {
+	require.NoError(t, validateCurrentsSources([]string{currentsCharger, currentsEstimate}))
+	require.Error(t, validateCurrentsSources([]string{"foo"}))
+}
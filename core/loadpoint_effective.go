@@ -77,7 +77,8 @@ func (lp *Loadpoint) nextVehiclePlan() (time.Time, time.Duration, int, int) {
 		}
 
 		// repeating plans
-		for index, rp := range vehicle.Settings(lp.log, v).GetRepeatingPlans() {
+		repeatingPlans := vehicle.Settings(lp.log, v).GetRepeatingPlans()
+		for index, rp := range repeatingPlans {
 			if !rp.Active || len(rp.Weekdays) == 0 {
 				continue
 			}
@@ -92,6 +93,13 @@ func (lp *Loadpoint) nextVehiclePlan() (time.Time, time.Duration, int, int) {
 			plans = append(plans, plan{Id: index + 2, Precondition: precondition, Soc: rp.Soc, End: planTime})
 		}
 
+		// detected departure
+		if departure := vehicle.Settings(lp.log, v).GetDeparture(); departure.Mode == api.DepartureModeAuto {
+			if planTime := nextDeparture(vehicle.Settings(lp.log, v).GetDepartureTimes()); !planTime.IsZero() {
+				plans = append(plans, plan{Id: len(repeatingPlans) + 2, Soc: departure.Soc, End: planTime})
+			}
+		}
+
 		// calculate earliest required plan start
 		if plan := lp.nextActivePlan(lp.effectiveMaxPower(), plans); plan != nil {
 			return plan.End, plan.Precondition, plan.Soc, plan.Id
@@ -100,6 +108,20 @@ func (lp *Loadpoint) nextVehiclePlan() (time.Time, time.Duration, int, int) {
 	return time.Time{}, 0, 0, 0
 }
 
+// nextDeparture returns the next occurrence of the detected departure times
+func nextDeparture(times []api.DepartureTimeStruct) time.Time {
+	var res time.Time
+
+	for _, dt := range times {
+		ts, err := util.GetNextOccurrence([]int{dt.Weekday}, dt.Time, dt.Tz)
+		if err == nil && (res.IsZero() || ts.Before(res)) {
+			res = ts
+		}
+	}
+
+	return res
+}
+
 // EffectivePlanSoc returns the soc target for the current plan
 func (lp *Loadpoint) EffectivePlanSoc() int {
 	_, _, soc, _ := lp.NextVehiclePlan()
@@ -188,9 +210,20 @@ func (lp *Loadpoint) EffectiveLimitSoc() int {
 	return lp.effectiveLimitSoc()
 }
 
-// effectiveLimitSoc returns the effective session limit soc
+// effectiveLimitSoc returns the effective session limit soc capped by an applicable maintenance policy
 // TODO take vehicle api limits into account
 func (lp *Loadpoint) effectiveLimitSoc() int {
+	res := lp.sessionLimitSoc()
+
+	if policy, ok := lp.maintenancePolicy(); ok {
+		res = min(res, policy.MaxSoc)
+	}
+
+	return res
+}
+
+// sessionLimitSoc returns the session or vehicle limit soc
+func (lp *Loadpoint) sessionLimitSoc() int {
 	if lp.limitSoc > 0 {
 		return lp.limitSoc
 	}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/journal"
+)
+
+// indicator states
+const (
+	indicatorIdle  = "idle"
+	indicatorPV    = "pv"
+	indicatorNow   = "now"
+	indicatorPlan  = "plan"
+	indicatorError = "error"
+)
+
+// indicatorState determines the indicator state from the loadpoint state
+func (lp *Loadpoint) indicatorState(err error) string {
+	switch {
+	case err != nil:
+		return indicatorError
+	case !lp.charging():
+		return indicatorIdle
+	case lp.planActive:
+		return indicatorPlan
+	case lp.GetMode() == api.ModeNow:
+		return indicatorNow
+	default:
+		return indicatorPV
+	}
+}
+
+// updateIndicator updates the charger's LED or display if the indicator state has changed.
+// Only states configured by the loadpoint's indicator configuration are indicated.
+func (lp *Loadpoint) updateIndicator(err error) {
+	c, ok := lp.charger.(api.Indicator)
+	if !ok || len(lp.Indicator) == 0 {
+		return
+	}
+
+	state := lp.indicatorState(err)
+	if state == lp.indicated {
+		return
+	}
+
+	ind, ok := lp.Indicator[state]
+	if !ok {
+		lp.indicated = state
+		return
+	}
+
+	lp.log.DEBUG.Printf("indicator: %s", state)
+
+	if err := lp.directCommand(journal.Indicate, state, func() error {
+		return c.Indicate(ind)
+	}); err != nil {
+		lp.log.ERROR.Printf("indicator: %v", err)
+		return
+	}
+
+	lp.indicated = state
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIndicator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	charger := struct {
+		*api.MockCharger
+		*api.MockIndicator
+	}{
+		api.NewMockCharger(ctrl),
+		api.NewMockIndicator(ctrl),
+	}
+
+	lp := &Loadpoint{
+		log:       util.NewLogger("foo"),
+		charger:   charger,
+		mode:      api.ModePV,
+		status:    api.StatusC,
+	}
+
+	// not configured
+	lp.updateIndicator(nil)
+	assert.Empty(t, lp.indicated)
+
+	lp.Indicator = map[string]api.Indication{
+		indicatorPV:    {Color: "#00ff00", Brightness: 50},
+		indicatorNow:   {Color: "#ffff00", Brightness: 80},
+		indicatorError: {Color: "#ff0000", Brightness: 100},
+	}
+
+	// configured pv indication
+	charger.MockIndicator.EXPECT().Indicate(api.Indication{Color: "#00ff00", Brightness: 50}).Return(nil)
+	lp.updateIndicator(nil)
+	assert.Equal(t, indicatorPV, lp.indicated)
+
+	// unchanged
+	lp.updateIndicator(nil)
+
+	// configured indication
+	lp.mode = api.ModeNow
+	charger.MockIndicator.EXPECT().Indicate(api.Indication{Color: "#ffff00", Brightness: 80}).Return(nil)
+	lp.updateIndicator(nil)
+
+	// failed indication is retried
+	charger.MockIndicator.EXPECT().Indicate(api.Indication{Color: "#ff0000", Brightness: 100}).Return(errors.New("failed"))
+	lp.updateIndicator(errors.New("error"))
+	assert.Equal(t, indicatorNow, lp.indicated)
+
+	// unconfigured state
+	lp.status = api.StatusB
+	lp.updateIndicator(nil)
+	assert.Equal(t, indicatorIdle, lp.indicated)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/journal"
+)
+
+// command executes a charger command through the journal
+func (lp *Loadpoint) command(cmd journal.Command, value any, fn func() error) error {
+	if lp.journal == nil {
+		return fn()
+	}
+	return lp.journal.Execute(cmd, value, fn)
+}
+
+// directCommand executes a command that is not retried, e.g. vehicle wake-up.
+// In dry-run mode the command is journaled but not executed.
+func (lp *Loadpoint) directCommand(cmd journal.Command, value any, fn func() error) error {
+	if lp.journal != nil && lp.journal.Simulate(cmd, value) {
+		return nil
+	}
+	return fn()
+}
+
+// chargerEnable enables or disables the charger
+func (lp *Loadpoint) chargerEnable(enable bool) error {
+	return lp.command(journal.Enable, enable, func() error {
+		return lp.charger.Enable(enable)
+	})
+}
+
+// chargerMaxCurrent sets the charger current limit
+func (lp *Loadpoint) chargerMaxCurrent(current float64) error {
+	return lp.command(journal.Current, current, func() error {
+		if charger, ok := lp.charger.(api.ChargerEx); ok {
+			return charger.MaxCurrentMillis(current)
+		}
+		return lp.charger.MaxCurrent(int64(current))
+	})
+}
+
+// chargerPhases1p3p switches the charger phases
+func (lp *Loadpoint) chargerPhases1p3p(cp api.PhaseSwitcher, phases int) error {
+	return lp.command(journal.Phases, phases, func() error {
+		return cp.Phases1p3p(phases)
+	})
+}
+
+// setDryRun journals charger commands without executing them and disables session persistence
+func (lp *Loadpoint) setDryRun() {
+	if lp.journal != nil {
+		lp.journal.SetDryRun(true)
+	}
+	lp.db = nil
+}
+
+// GetJournal returns the journal of charger commands
+func (lp *Loadpoint) GetJournal() []journal.Entry {
+	if lp.journal == nil {
+		return nil
+	}
+	return lp.journal.Entries()
+}
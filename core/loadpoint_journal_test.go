@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// charger must not receive any commands
+	lp := &Loadpoint{
+		charger: api.NewMockCharger(ctrl),
+		journal: journal.New(clock.NewMock(), journal.DefaultSize),
+	}
+
+	lp.setDryRun()
+
+	require.NoError(t, lp.chargerEnable(true))
+	require.NoError(t, lp.chargerMaxCurrent(16))
+
+	e := lp.GetJournal()
+	require.Len(t, e, 2)
+
+	for _, e := range e {
+		assert.Equal(t, journal.Simulated, e.Status)
+	}
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/vehicle"
+)
+
+// maintenanceDepartureWindow suspends battery maintenance if a charging plan is due within this window
+const maintenanceDepartureWindow = 24 * time.Hour
+
+// maintenancePolicy returns the battery maintenance policy of the connected vehicle and if it applies.
+// The policy applies once the vehicle has been connected for the configured idle duration and no charging plan is due.
+func (lp *Loadpoint) maintenancePolicy() (api.MaintenanceStruct, bool) {
+	v := lp.GetVehicle()
+	if v == nil || (lp.status != api.StatusB && lp.status != api.StatusC) {
+		return api.MaintenanceStruct{}, false
+	}
+
+	policy := vehicle.Settings(lp.log, v).GetMaintenance()
+	if !policy.Active || lp.clock.Since(lp.connectedTime) < time.Duration(policy.After)*time.Second {
+		return policy, false
+	}
+
+	// departure takes precedence
+	if ts, _, soc, _ := lp.nextVehiclePlan(); soc > 0 && ts.Sub(lp.clock.Now()) < maintenanceDepartureWindow {
+		return policy, false
+	}
+
+	return policy, true
+}
+
+// maintenanceTopUpRequired checks if the vehicle soc dropped below the maintenance minimum.
+// Once started, the top-up continues until the middle of the maintenance range is reached.
+func (lp *Loadpoint) maintenanceTopUpRequired() bool {
+	policy, ok := lp.maintenancePolicy()
+	if !ok || lp.vehicleSoc == 0 {
+		lp.maintenanceTopUp = false
+		return false
+	}
+
+	target := float64(policy.MinSoc+policy.MaxSoc) / 2
+
+	switch {
+	case lp.vehicleSoc < float64(policy.MinSoc):
+		lp.maintenanceTopUp = true
+	case lp.vehicleSoc >= target:
+		lp.maintenanceTopUp = false
+	}
+
+	if lp.maintenanceTopUp {
+		lp.log.DEBUG.Printf("maintenance top-up at vehicle soc %.0f%% (target %.0f%%)", lp.vehicleSoc, target)
+	}
+
+	return lp.maintenanceTopUp
+}
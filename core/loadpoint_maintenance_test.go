@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/vehicle"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMaintenance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clk := clock.NewMock()
+	clk.Set(time.Now())
+
+	v := api.NewMockVehicle(ctrl)
+	v.EXPECT().Capacity().Return(50.0).AnyTimes()
+	v.EXPECT().OnIdentified().Return(api.ActionConfig{}).AnyTimes()
+	v.EXPECT().Phases().Return(0).AnyTimes()
+	v.EXPECT().Features().Return(nil).AnyTimes()
+
+	require.NoError(t, config.Vehicles().Add(config.NewStaticDevice(config.Named{Name: "parked"}, api.Vehicle(v))))
+	defer func() { _ = config.Vehicles().Delete("parked") }()
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.clock = clk
+	lp.vehicle = v
+	lp.status = api.StatusB
+	lp.connectedTime = clk.Now()
+
+	settings := vehicle.Settings(lp.log, v)
+	require.Error(t, settings.SetMaintenance(api.MaintenanceStruct{MinSoc: 60, MaxSoc: 40}))
+	require.NoError(t, settings.SetMaintenance(api.MaintenanceStruct{
+		MinSoc: 40,
+		MaxSoc: 60,
+		After:  int64((7 * 24 * time.Hour).Seconds()),
+		Active: true,
+	}))
+
+	// not parked long enough
+	lp.vehicleSoc = 80
+	assert.Equal(t, 100, lp.effectiveLimitSoc())
+
+	clk.Add(7 * 24 * time.Hour)
+	assert.Equal(t, 60, lp.effectiveLimitSoc())
+	assert.False(t, lp.maintenanceTopUpRequired())
+
+	// top-up until middle of range
+	for _, tc := range []struct {
+		soc   float64
+		topUp bool
+	}{
+		{45, false},
+		{39, true},
+		{45, true},
+		{50, false},
+		{45, false},
+	} {
+		lp.vehicleSoc = tc.soc
+		assert.Equal(t, tc.topUp, lp.maintenanceTopUpRequired(), "soc %.0f", tc.soc)
+	}
+
+	// departure plan takes precedence
+	require.NoError(t, settings.SetPlanSoc(clk.Now().Add(12*time.Hour), 0, 80))
+	lp.vehicleSoc = 45
+	assert.Equal(t, 100, lp.effectiveLimitSoc())
+
+	lp.vehicleSoc = 30
+	assert.False(t, lp.maintenanceTopUpRequired())
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/loadpoint"
+)
+
+// phaseScaleDelay returns the delay before scaling to the given phases
+func (lp *Loadpoint) phaseScaleDelay(phases int) time.Duration {
+	if phases == 1 {
+		if d := lp.PhaseSwitch.DisableDelay; d > 0 {
+			return d
+		}
+		return lp.GetDisableDelay()
+	}
+
+	if d := lp.PhaseSwitch.EnableDelay; d > 0 {
+		return d
+	}
+	return lp.GetEnableDelay()
+}
+
+// phaseSwitchesToday returns the number of phase switches of the current day
+func (lp *Loadpoint) phaseSwitchesToday() int {
+	y1, m1, d1 := lp.phaseSwitchStats.Last.Local().Date()
+	y2, m2, d2 := lp.clock.Now().Local().Date()
+
+	if y1 != y2 || m1 != m2 || d1 != d2 {
+		return 0
+	}
+
+	return lp.phaseSwitchStats.Today
+}
+
+// phaseSwitchAllowed checks the minimum dwell time and daily switch budget.
+// Limits only apply while charging, otherwise phases are switched immediately.
+func (lp *Loadpoint) phaseSwitchAllowed() bool {
+	if !lp.charging() {
+		return true
+	}
+
+	cc := lp.PhaseSwitch
+
+	if cc.MinDwell > 0 && !lp.phaseSwitchStats.Last.IsZero() {
+		if remaining := cc.MinDwell - lp.clock.Since(lp.phaseSwitchStats.Last); remaining > 0 {
+			lp.log.DEBUG.Printf("phase switch: min dwell time remaining: %v", remaining.Round(time.Second))
+			return false
+		}
+	}
+
+	if cc.MaxSwitches > 0 && lp.phaseSwitchesToday() >= cc.MaxSwitches {
+		lp.log.DEBUG.Printf("phase switch: daily budget of %d switches exhausted", cc.MaxSwitches)
+		return false
+	}
+
+	return true
+}
+
+// countPhaseSwitch updates and publishes the phase switch statistics
+func (lp *Loadpoint) countPhaseSwitch() {
+	today := lp.phaseSwitchesToday()
+
+	lp.phaseSwitchStats = loadpoint.PhaseSwitchStats{
+		Today: today + 1,
+		Total: lp.phaseSwitchStats.Total + 1,
+		Last:  lp.clock.Now(),
+	}
+
+	lp.publish(keys.PhaseSwitches, lp.phaseSwitchStats)
+}
+
+// GetPhaseSwitchStats returns the phase switch statistics
+func (lp *Loadpoint) GetPhaseSwitchStats() loadpoint.PhaseSwitchStats {
+	lp.RLock()
+	defer lp.RUnlock()
+
+	res := lp.phaseSwitchStats
+	res.Today = lp.phaseSwitchesToday()
+
+	return res
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhaseSwitchAllowed(t *testing.T) {
+	clock := clock.NewMock()
+	clock.Set(time.Date(2025, 6, 1, 12, 0, 0, 0, time.Local))
+
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.clock = clock
+	lp.status = api.StatusC
+	lp.PhaseSwitch = loadpoint.PhaseSwitchConfig{MinDwell: 15 * time.Minute, MaxSwitches: 2}
+
+	// no switches yet
+	assert.True(t, lp.phaseSwitchAllowed())
+
+	lp.countPhaseSwitch()
+	assert.False(t, lp.phaseSwitchAllowed(), "min dwell")
+
+	clock.Add(15 * time.Minute)
+	assert.True(t, lp.phaseSwitchAllowed())
+
+	lp.countPhaseSwitch()
+	clock.Add(15 * time.Minute)
+	assert.False(t, lp.phaseSwitchAllowed(), "daily budget")
+
+	// not charging
+	lp.status = api.StatusB
+	assert.True(t, lp.phaseSwitchAllowed())
+	lp.status = api.StatusC
+
+	// next day
+	clock.Add(12 * time.Hour)
+	assert.True(t, lp.phaseSwitchAllowed())
+	assert.Equal(t, loadpoint.PhaseSwitchStats{Today: 0, Total: 2, Last: clock.Now().Add(-12*time.Hour - 15*time.Minute)}, lp.GetPhaseSwitchStats())
+}
+
+func TestPhaseScaleDelay(t *testing.T) {
+	lp := NewLoadpoint(util.NewLogger("foo"), nil)
+	lp.Enable.Delay = time.Minute
+	lp.Disable.Delay = 3 * time.Minute
+
+	assert.Equal(t, time.Minute, lp.phaseScaleDelay(3))
+	assert.Equal(t, 3*time.Minute, lp.phaseScaleDelay(1))
+
+	lp.PhaseSwitch = loadpoint.PhaseSwitchConfig{EnableDelay: 5 * time.Minute, DisableDelay: 10 * time.Minute}
+
+	assert.Equal(t, 5*time.Minute, lp.phaseScaleDelay(3))
+	assert.Equal(t, 10*time.Minute, lp.phaseScaleDelay(1))
+}
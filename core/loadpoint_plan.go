@@ -8,13 +8,28 @@ import (
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/core/planner"
 	"github.com/evcc-io/evcc/core/vehicle"
+	"github.com/evcc-io/evcc/tariff"
 )
 
 const (
 	smallSlotDuration = 15 * time.Minute // small planner slot duration we might ignore
 	smallGapDuration  = 30 * time.Minute // small gap duration between planner slots we might ignore
+	commitmentWindow  = time.Hour        // planner slots starting within this window are not re-planned
 )
 
+// solarPlanMargin is the forecasted pv energy required relative to the plan's missing energy for deferring grid charging.
+// It accounts for household consumption and forecast errors.
+const solarPlanMargin = 2
+
+// solarPlanSufficient checks if the forecasted pv energy until plan time covers the energy missing to reach the plan goal
+func solarPlanSufficient(solar api.Rates, ts, planTime time.Time, energy float64) bool {
+	if len(solar) == 0 || energy <= 0 || !ts.Before(planTime) {
+		return false
+	}
+
+	return solarEnergy(solar, ts, planTime) >= solarPlanMargin*energy
+}
+
 // TODO planActive is not guarded by mutex
 
 // setPlanActive updates plan active flag
@@ -110,15 +125,18 @@ func (lp *Loadpoint) plannerActive() (active bool) {
 
 	var planStart, planEnd time.Time
 	var planOverrun time.Duration
+	var planSlots api.Rates
 
 	defer func() {
 		lp.publish(keys.PlanProjectedStart, planStart)
 		lp.publish(keys.PlanProjectedEnd, planEnd)
 		lp.publish(keys.PlanOverrun, planOverrun)
+		lp.publish(keys.PlanSlots, planSlots)
 	}()
 
 	// re-check since plannerActive() is called before connected() check in Update()
 	if !lp.connected() {
+		lp.planCommitted = nil
 		return false
 	}
 
@@ -147,11 +165,29 @@ func (lp *Loadpoint) plannerActive() (active bool) {
 		return false
 	}
 
+	// defer grid charging while pv surplus is expected to reach the goal until plan time
+	if !lp.planActive && lp.site != nil {
+		solar := tariff.Rates(lp.site.GetTariff(api.TariffUsageSolar))
+		if energy := requiredDuration.Hours() * maxPower; solarPlanSufficient(solar, lp.clock.Now(), planTime, energy) {
+			lp.log.DEBUG.Printf("plan: deferred, solar forecast covers %.1fkWh until %v", energy/1e3, planTime.Round(time.Second).Local())
+			return false
+		}
+	}
+
 	plan := lp.GetPlan(planTime, requiredDuration, lp.GetPlanPreCondDuration())
 	if plan == nil {
 		return false
 	}
 
+	// commit to upcoming slots instead of re-planning them on every price or soc update
+	if !planTime.Equal(lp.planCommitTime) {
+		lp.planCommitted = nil
+	}
+
+	plan = planner.Commit(lp.planCommitted, plan, lp.clock.Now(), commitmentWindow, requiredDuration)
+	lp.planCommitted, lp.planCommitTime = plan, planTime
+	planSlots = plan
+
 	var overrun string
 	if excessDuration := requiredDuration - lp.clock.Until(planTime); excessDuration > 0 {
 		overrun = fmt.Sprintf("overruns by %v, ", excessDuration.Round(time.Second))
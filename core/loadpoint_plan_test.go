@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/jinzhu/now"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolarPlanSufficient(t *testing.T) {
+	bod := now.BeginningOfDay()
+
+	var solar api.Rates
+	for h := 6; h < 14; h++ {
+		solar = append(solar, api.Rate{
+			Start: bod.Add(time.Duration(h) * time.Hour),
+			End:   bod.Add(time.Duration(h+1) * time.Hour),
+			Value: 5e3,
+		})
+	}
+
+	for _, tc := range []struct {
+		name           string
+		solar          api.Rates
+		hour, planHour int
+		energy         float64
+		expected       bool
+	}{
+		{"forecast covers energy", solar, 7, 16, 10e3, true},
+		{"forecast below energy", solar, 7, 16, 20e3, false},
+		{"plan before pv production", solar, 4, 6, 1e3, false},
+		{"plan time passed", solar, 17, 16, 1e3, false},
+		{"no forecast", nil, 7, 16, 1e3, false},
+		{"no energy required", solar, 7, 16, 0, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := bod.Add(time.Duration(tc.hour) * time.Hour)
+			planTime := bod.Add(time.Duration(tc.planHour) * time.Hour)
+			assert.Equal(t, tc.expected, solarPlanSufficient(tc.solar, ts, planTime, tc.energy))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/loadpoint"
+)
+
+// remoteDemandTimeout is the time after which a remote demand expires without being repeated
+const remoteDemandTimeout = 15 * time.Minute
+
+// RemoteControl sets remote status demand
+func (lp *Loadpoint) RemoteControl(source string, demand loadpoint.RemoteDemand) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	lp.remoteDemandUpdated = lp.clock.Now()
+
+	if lp.remoteDemand == demand {
+		return
+	}
+
+	lp.log.DEBUG.Printf("remote demand: %q (%s)", demand, source)
+
+	// apply immediately
+	lp.setRemoteDemand(demand, source)
+	lp.requestUpdate()
+}
+
+// setRemoteDemand sets the remote demand and publishes its source
+func (lp *Loadpoint) setRemoteDemand(demand loadpoint.RemoteDemand, source string) {
+	lp.remoteDemand = demand
+	lp.publish(keys.RemoteDisabledSource, source)
+}
+
+// expireRemoteDemand resets the remote demand if it has not been repeated in time,
+// e.g. if the remote controller has gone away
+func (lp *Loadpoint) expireRemoteDemand() {
+	lp.Lock()
+	defer lp.Unlock()
+
+	if lp.remoteDemand != loadpoint.RemoteEnable && lp.clock.Since(lp.remoteDemandUpdated) >= remoteDemandTimeout {
+		lp.log.DEBUG.Printf("remote demand: %q expired", lp.remoteDemand)
+		lp.setRemoteDemand(loadpoint.RemoteEnable, "")
+	}
+}
+
+// remoteControlled returns true if remote control status is active
+func (lp *Loadpoint) remoteControlled(demand loadpoint.RemoteDemand) bool {
+	lp.RLock()
+	defer lp.RUnlock()
+
+	return lp.remoteDemand == demand
+}
+
+// SetCurrentOverride forces the charge current until the timeout expires, bypassing the charge mode
+// unless the loadpoint is off. A nil current removes the override.
+func (lp *Loadpoint) SetCurrentOverride(current *float64, timeout time.Duration) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	lp.log.DEBUG.Printf("set current override: %s (%v)", printPtr("%.3gA", current), timeout)
+
+	// apply immediately
+	lp.currentOverride = newOverride(current, lp.clock.Now().Add(timeout))
+	lp.publish(keys.CurrentOverride, lp.currentOverride.activeValue(lp.clock.Now()))
+	lp.requestUpdate()
+}
+
+// activeCurrentOverride returns the current override unless expired
+func (lp *Loadpoint) activeCurrentOverride() *float64 {
+	lp.RLock()
+	defer lp.RUnlock()
+
+	return lp.currentOverride.activeValue(lp.clock.Now())
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemoteSoftDisable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+
+	lp := &Loadpoint{
+		log:         util.NewLogger("foo"),
+		bus:         evbus.New(),
+		clock:       clock.NewMock(),
+		charger:     charger,
+		chargeMeter: &Null{},            // silence nil panics
+		chargeRater: &Null{},            // silence nil panics
+		chargeTimer: &Null{},            // silence nil panics
+		progress:    NewProgress(0, 10), // silence nil panics
+		wakeUpTimer: NewTimer(),         // silence nil panics
+		minCurrent:  minA,
+		maxCurrent:  maxA,
+		mode:        api.ModePV,
+		phases:      1,
+	}
+
+	attachListeners(t, lp)
+
+	lp.enabled = true
+	lp.offeredCurrent = maxA
+	lp.status = api.StatusC
+
+	lp.RemoteControl("test", loadpoint.RemoteSoftDisable)
+
+	// surplus available, but remote demand disables charging
+	charger.EXPECT().Status().Return(api.StatusC, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().Enable(false).Return(nil)
+	lp.Update(-10000, 0, nil, nil, false, false, 0, nil, nil)
+	ctrl.Finish()
+
+	lp.RemoteControl("test", loadpoint.RemoteEnable)
+
+	// surplus charging resumes
+	charger.EXPECT().Status().Return(api.StatusB, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().MaxCurrent(gomock.Any()).Return(nil).AnyTimes()
+	charger.EXPECT().Enable(true).Return(nil)
+	lp.Update(-10000, 0, nil, nil, false, false, 0, nil, nil)
+	ctrl.Finish()
+}
+
+func TestRemoteDemandExpiry(t *testing.T) {
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:      util.NewLogger("foo"),
+		clock:    clck,
+		settings: settings.NewDatabaseSettingsAdapter("foo"),
+		mode:     api.ModePV,
+	}
+
+	lp.RemoteControl("test", loadpoint.RemoteSoftDisable)
+
+	// repeated demand extends expiry
+	clck.Add(remoteDemandTimeout - time.Minute)
+	lp.RemoteControl("test", loadpoint.RemoteSoftDisable)
+
+	clck.Add(2 * time.Minute)
+	lp.expireRemoteDemand()
+	assert.True(t, lp.remoteControlled(loadpoint.RemoteSoftDisable))
+
+	// expired without control messages
+	clck.Add(remoteDemandTimeout)
+	lp.expireRemoteDemand()
+	assert.False(t, lp.remoteControlled(loadpoint.RemoteSoftDisable))
+
+	// reset by mode change
+	lp.RemoteControl("test", loadpoint.RemoteSoftDisable)
+	lp.SetMode(api.ModeMinPV)
+	assert.False(t, lp.remoteControlled(loadpoint.RemoteSoftDisable))
+}
+
+func TestCurrentOverride(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := api.NewMockCharger(ctrl)
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:         util.NewLogger("foo"),
+		bus:         evbus.New(),
+		clock:       clck,
+		charger:     charger,
+		chargeMeter: &Null{},            // silence nil panics
+		chargeRater: &Null{},            // silence nil panics
+		chargeTimer: &Null{},            // silence nil panics
+		progress:    NewProgress(0, 10), // silence nil panics
+		wakeUpTimer: NewTimer(),         // silence nil panics
+		minCurrent:  minA,
+		maxCurrent:  maxA,
+		mode:        api.ModePV,
+		phases:      1,
+	}
+
+	attachListeners(t, lp)
+
+	lp.enabled = true
+	lp.offeredCurrent = minA
+	lp.status = api.StatusC
+
+	lp.SetCurrentOverride(lo.ToPtr(10.0), time.Minute)
+
+	// override bypasses charge mode
+	charger.EXPECT().Status().Return(api.StatusC, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().MaxCurrent(int64(10)).Return(nil)
+	lp.Update(0, 0, nil, nil, false, false, 0, nil, nil)
+	ctrl.Finish()
+
+	assert.Equal(t, 10.0, lp.offeredCurrent)
+
+	// off takes precedence over override
+	lp.mode = api.ModeOff
+
+	charger.EXPECT().Status().Return(api.StatusC, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().Enable(false).Return(nil)
+	lp.Update(0, 0, nil, nil, false, false, 0, nil, nil)
+	ctrl.Finish()
+
+	assert.NotNil(t, lp.activeCurrentOverride())
+
+	// override expired
+	clck.Add(2 * time.Minute)
+	assert.Nil(t, lp.activeCurrentOverride())
+}
@@ -1,14 +1,27 @@
 package core
 
 import (
+	"time"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/core/session"
 	"github.com/evcc-io/evcc/core/wrapper"
+	"github.com/evcc-io/evcc/tariff"
 	"github.com/jinzhu/now"
 	"github.com/samber/lo"
 )
 
+// feedInPrice returns the current feed-in price, zero if not available
+func (lp *Loadpoint) feedInPrice() float64 {
+	if lp.site == nil {
+		return 0
+	}
+
+	f, _ := tariff.Now(lp.site.GetTariff(api.TariffUsageFeedIn))
+	return f
+}
+
 func (lp *Loadpoint) chargeMeterTotal() float64 {
 	m, ok := lp.chargeMeter.(api.MeterEnergy)
 	if !ok {
@@ -26,6 +39,22 @@ func (lp *Loadpoint) chargeMeterTotal() float64 {
 	return f
 }
 
+func (lp *Loadpoint) billingMeterTotal() float64 {
+	if lp.billingMeter == nil {
+		return 0
+	}
+
+	f, err := lp.billingMeter.TotalEnergy()
+	if err != nil {
+		lp.log.ERROR.Printf("billing total import: %v", err)
+		return 0
+	}
+
+	lp.log.DEBUG.Printf("billing total import: %.3fkWh", f)
+
+	return f
+}
+
 // createSession creates a charging session. The created timestamp is empty until set by evChargeStartHandler.
 // The session is not persisted yet. That will only happen when stopSession is called.
 func (lp *Loadpoint) createSession() {
@@ -36,6 +65,14 @@ func (lp *Loadpoint) createSession() {
 
 	lp.session = lp.db.New(lp.chargeMeterTotal())
 
+	if billingStart := lp.billingMeterTotal(); billingStart > 0 {
+		lp.session.BillingMeterStart = &billingStart
+	}
+
+	if lp.sessionState != "" && lp.sessionState != session.StateDisconnected {
+		lp.session.SetState(lp.sessionState, lp.clock.Now())
+	}
+
 	if v := lp.GetVehicle(); v != nil {
 		lp.session.Vehicle = v.GetTitle()
 	} else if lp.chargerHasFeature(api.IntegratedDevice) {
@@ -69,16 +106,29 @@ func (lp *Loadpoint) stopSession() {
 	}
 
 	s.Finished = lp.clock.Now()
+	s.CloseTimeline(s.Finished)
 	if meterStop := lp.chargeMeterTotal(); meterStop > 0 {
 		s.MeterStop = &meterStop
 	}
+	if billingStop := lp.billingMeterTotal(); billingStop > 0 {
+		s.BillingMeterStop = &billingStop
+	}
 
 	s.SolarPercentage = lo.ToPtr(lp.energyMetrics.SolarPercentage())
 	s.Price = lp.energyMetrics.Price()
+	s.GridPrice = lp.energyMetrics.GridPrice()
+	s.SolarPrice = lp.energyMetrics.SolarPrice()
 	s.PricePerKWh = lp.energyMetrics.PricePerKWh()
 	s.Co2PerKWh = lp.energyMetrics.Co2PerKWh()
 	s.ChargedEnergy = lp.energyMetrics.TotalWh() / 1e3
 	s.ChargeDuration = lo.ToPtr(lp.chargeDuration.Abs())
+	s.SetBilling()
+
+	if s.Vehicle != "" && s.OdometerStart != nil {
+		if odo, ok := lp.db.LastOdometer(s); ok {
+			s.SetTrip(odo)
+		}
+	}
 
 	lp.db.Persist(s)
 }
@@ -132,3 +182,48 @@ func (lp *Loadpoint) resetHeatingSession() {
 
 	lp.createSession()
 }
+
+// currentSessionState derives the detailed charging state from charger status and evcc's charger control
+func (lp *Loadpoint) currentSessionState() session.State {
+	switch lp.status {
+	case api.StatusC:
+		return session.StateCharging
+	case api.StatusB:
+		switch {
+		case !lp.enabled:
+			return session.StateSuspendedEvcc
+		case lp.chargedSinceConnect:
+			return session.StateSuspendedVehicle
+		default:
+			return session.StatePreparing
+		}
+	default:
+		return session.StateDisconnected
+	}
+}
+
+// updateSessionState publishes the detailed charging state and records changes in the session timeline
+func (lp *Loadpoint) updateSessionState() {
+	switch lp.status {
+	case api.StatusC:
+		lp.chargedSinceConnect = true
+	case api.StatusA:
+		lp.chargedSinceConnect = false
+	}
+
+	now := lp.clock.Now()
+
+	if state := lp.currentSessionState(); state != lp.sessionState {
+		lp.log.DEBUG.Printf("session state: %s", state)
+
+		lp.sessionState = state
+		lp.sessionStateTime = now
+
+		if lp.session != nil {
+			lp.session.SetState(state, now)
+		}
+	}
+
+	lp.publish(keys.SessionState, lp.sessionState)
+	lp.publish(keys.SessionStateDuration, now.Sub(lp.sessionStateTime).Round(time.Second))
+}
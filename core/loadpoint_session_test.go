@@ -258,3 +258,85 @@ func TestResetHeatingSession(t *testing.T) {
 	assert.Equal(t, 1.0, *lp.session.MeterStart)
 	assert.Equal(t, 3.0, *lp.session.MeterStop)
 }
+
+func TestSessionState(t *testing.T) {
+	clock := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		clock:   clock,
+		session: new(session.Session),
+	}
+
+	for _, tc := range []struct {
+		status  api.ChargeStatus
+		enabled bool
+		state   session.State
+	}{
+		{api.StatusB, false, session.StateSuspendedEvcc},
+		{api.StatusB, true, session.StatePreparing},
+		{api.StatusC, true, session.StateCharging},
+		{api.StatusB, true, session.StateSuspendedVehicle},
+		{api.StatusB, false, session.StateSuspendedEvcc},
+		{api.StatusA, false, session.StateDisconnected},
+		{api.StatusB, true, session.StatePreparing},
+	} {
+		clock.Add(time.Minute)
+
+		lp.status, lp.enabled = tc.status, tc.enabled
+		lp.updateSessionState()
+
+		assert.Equal(t, tc.state, lp.sessionState, "%s enabled: %t", tc.status, tc.enabled)
+	}
+
+	assert.Len(t, lp.session.Timeline, 7)
+	assert.Equal(t, 2*time.Minute, *lp.session.SuspendedEvcc)
+	assert.Equal(t, time.Minute, *lp.session.SuspendedVehicle)
+}
+
+func TestSessionBillingMeter(t *testing.T) {
+	var err error
+	serverdb.Instance, err = serverdb.New("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	db, err := session.NewStore("foo", serverdb.Instance)
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+
+	me := api.NewMockMeterEnergy(ctrl)
+	bm := api.NewMockMeterEnergy(ctrl)
+
+	type EnergyDecorator struct {
+		api.Meter
+		api.MeterEnergy
+	}
+
+	lp := &Loadpoint{
+		log:          util.NewLogger("foo"),
+		clock:        clock,
+		db:           db,
+		chargeMeter:  &EnergyDecorator{Meter: api.NewMockMeter(ctrl), MeterEnergy: me},
+		billingMeter: bm,
+	}
+
+	me.EXPECT().TotalEnergy().Return(1.0, nil)
+	bm.EXPECT().TotalEnergy().Return(1000.0, nil)
+	lp.createSession()
+	lp.updateSession(sessionStart(lp))
+
+	clock.Add(time.Hour)
+	lp.energyMetrics.Update(2)
+
+	// internal meter drives control, billing meter is authoritative for the session
+	me.EXPECT().TotalEnergy().Return(3.0, nil)
+	bm.EXPECT().TotalEnergy().Return(1002.1, nil)
+	lp.stopSession()
+
+	s := lp.session
+	assert.Equal(t, 2.0, s.ChargedEnergy)
+	assert.Equal(t, 1000.0, *s.BillingMeterStart)
+	assert.Equal(t, 1002.1, *s.BillingMeterStop)
+	assert.InDelta(t, 2.1, *s.BilledEnergy, 1e-9)
+}
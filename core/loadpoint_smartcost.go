@@ -46,3 +46,37 @@ func (lp *Loadpoint) smartLimitNextStart(limit *float64, rates api.Rates, checkB
 
 	return time.Time{}
 }
+
+// smartCostExportActive checks if charging at max power is below the smart cost limit when
+// taking lost export revenue into account. Surplus power would otherwise be exported at the
+// feed-in price, only the remainder is imported at the consumption price.
+func (lp *Loadpoint) smartCostExportActive(limit *float64, consumption, feedin api.Rates, sitePower float64) bool {
+	if limit == nil {
+		return false
+	}
+
+	grid, err := consumption.At(time.Now())
+	if err != nil {
+		return false
+	}
+
+	export, err := feedin.At(time.Now())
+	if err != nil {
+		return false
+	}
+
+	maxPower := lp.EffectiveMaxPower()
+	if maxPower <= 0 {
+		return false
+	}
+
+	// surplus available to the loadpoint
+	surplus := min(max(lp.GetChargePower()-sitePower, 0), maxPower)
+
+	return exportAwareCost(grid.Value, export.Value, surplus/maxPower) <= *limit
+}
+
+// exportAwareCost returns the cost of charging with the given share of surplus power
+func exportAwareCost(grid, feedin, surplusShare float64) float64 {
+	return grid*(1-surplusShare) + feedin*surplusShare
+}
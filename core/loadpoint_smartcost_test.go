@@ -0,0 +1,20 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportAwareCost(t *testing.T) {
+	for _, tc := range []struct {
+		grid, feedin, share, cost float64
+	}{
+		{0.30, 0.08, 0, 0.30},
+		{0.30, 0.08, 1, 0.08},
+		{0.30, 0.08, 0.5, 0.19},
+		{0.30, -0.05, 1, -0.05},
+	} {
+		assert.InDelta(t, tc.cost, exportAwareCost(tc.grid, tc.feedin, tc.share), 1e-9, tc)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/journal"
 	"github.com/evcc-io/evcc/core/keys"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/core/session"
@@ -42,9 +43,17 @@ func (lp *Loadpoint) setVehicleIdentifier(id string) {
 	if lp.vehicleIdentifier != id {
 		lp.vehicleIdentifier = id
 		lp.publish(keys.VehicleIdentity, id)
+		lp.publish(keys.VehicleIdentityUnknown, false)
 	}
 }
 
+// GetVehicleIdentifier returns the vehicle id as read from the charger
+func (lp *Loadpoint) GetVehicleIdentifier() string {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.vehicleIdentifier
+}
+
 // identifyVehicle reads vehicle identification from charger
 func (lp *Loadpoint) identifyVehicle() {
 	identifier, ok := lp.charger.(api.Identifier)
@@ -67,10 +76,14 @@ func (lp *Loadpoint) identifyVehicle() {
 
 	if id != "" {
 		lp.log.DEBUG.Println("charger vehicle id:", id)
+		lp.pushEvent(evVehicleIdentify)
 
 		if vehicle := lp.selectVehicleByID(id); vehicle != nil {
 			lp.stopVehicleDetection()
 			lp.setActiveVehicle(vehicle)
+		} else {
+			lp.log.INFO.Println("unknown vehicle id:", id)
+			lp.publish(keys.VehicleIdentityUnknown, true)
 		}
 	}
 }
@@ -79,9 +92,14 @@ func (lp *Loadpoint) identifyVehicle() {
 func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 	vehicles := lp.coordinatedVehicles()
 
+	// configured and learned identifiers
+	identifiers := func(v api.Vehicle) []string {
+		return append(slices.Clone(v.Identifiers()), vehicle.Settings(lp.log, v).GetIdentifiers()...)
+	}
+
 	// find exact match
 	for _, vehicle := range vehicles {
-		for _, vid := range vehicle.Identifiers() {
+		for _, vid := range identifiers(vehicle) {
 			if strings.EqualFold(id, vid) {
 				return vehicle
 			}
@@ -90,7 +108,7 @@ func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 
 	// find placeholder match
 	for _, vehicle := range vehicles {
-		for _, vid := range vehicle.Identifiers() {
+		for _, vid := range identifiers(vehicle) {
 			// case insensitive match
 			re, err := regexp.Compile("(?i)" + strings.ReplaceAll(vid, "*", ".*?"))
 			if err != nil {
@@ -192,7 +210,7 @@ func (lp *Loadpoint) wakeUpVehicle() {
 
 func (lp *Loadpoint) wakeUpResurrector(resurrector api.Resurrector, name string) {
 	lp.log.DEBUG.Printf("wake-up %s, attempts left: %d", name, lp.wakeUpTimer.wakeupAttemptsLeft)
-	if err := resurrector.WakeUp(); err != nil {
+	if err := lp.directCommand(journal.WakeUp, name, resurrector.WakeUp); err != nil {
 		lp.log.ERROR.Printf("wake-up %s: %v", name, err)
 	}
 }
@@ -311,6 +329,9 @@ func (lp *Loadpoint) vehicleOdometer() {
 
 			// update session once odometer is read
 			lp.updateSession(func(session *session.Session) {
+				if session.OdometerStart == nil {
+					session.OdometerStart = &odo
+				}
 				session.Odometer = &odo
 			})
 		} else if !loadpoint.AcceptableError(err) {
@@ -11,8 +11,11 @@ import (
 	"github.com/evcc-io/evcc/core/coordinator"
 	"github.com/evcc-io/evcc/core/settings"
 	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/core/vehicle"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -149,6 +152,29 @@ func TestVehicleDetectByID(t *testing.T) {
 	}
 }
 
+func TestVehicleDetectByLearnedID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := api.NewMockVehicle(ctrl)
+	v.EXPECT().Identifiers().Return(nil).AnyTimes()
+
+	require.NoError(t, config.Vehicles().Add(config.NewStaticDevice(config.Named{Name: "learned"}, api.Vehicle(v))))
+	defer func() { _ = config.Vehicles().Delete("learned") }()
+
+	lp := &Loadpoint{
+		log: util.NewLogger("foo"),
+	}
+
+	lp.coordinator = coordinator.NewAdapter(lp, coordinator.New(util.NewLogger("foo"), []api.Vehicle{v}))
+
+	assert.Nil(t, lp.selectVehicleByID("04A2B3"))
+
+	require.NoError(t, vehicle.Settings(lp.log, v).SetIdentifiers([]string{"04a2b3"}))
+	defer func() { _ = vehicle.Settings(lp.log, v).SetIdentifiers(nil) }()
+
+	assert.Equal(t, api.Vehicle(v), lp.selectVehicleByID("04A2B3"))
+}
+
 func TestDefaultVehicle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
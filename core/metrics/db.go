@@ -15,8 +15,16 @@ type meter struct {
 
 var ErrIncomplete = errors.New("meter profile incomplete")
 
+// migrations of meter profiles, append only
+var migrations = []db.Migration{
+	{Description: "initial schema"},
+}
+
 func Init() error {
-	return db.Instance.AutoMigrate(new(meter))
+	if err := db.Instance.AutoMigrate(new(meter)); err != nil {
+		return err
+	}
+	return db.Migrate("metrics", migrations...)
 }
 
 // Persist stores 15min consumption in Wh
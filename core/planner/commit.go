@@ -0,0 +1,66 @@
+package planner
+
+import (
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// Commit keeps the slots of the committed plan that are active or start within the commitment window.
+// The remaining required duration is filled with the cheapest non-overlapping slots of the new plan.
+// The result is sorted by time.
+func Commit(committed, plan api.Rates, now time.Time, window, requiredDuration time.Duration) api.Rates {
+	var res api.Rates
+
+	for _, slot := range committed {
+		if !slot.End.After(now) || !slot.Start.Before(now.Add(window)) {
+			continue
+		}
+
+		if slot.Start.Before(now) {
+			slot.Start = now
+		}
+
+		res = append(res, slot)
+	}
+
+	if len(res) == 0 {
+		return plan
+	}
+
+	overlaps := func(r api.Rate) bool {
+		return slices.ContainsFunc(res, func(slot api.Rate) bool {
+			return r.Start.Before(slot.End) && slot.Start.Before(r.End)
+		})
+	}
+
+	candidates := slices.Clone(plan)
+	slices.SortStableFunc(candidates, sortByCost)
+
+	for _, slot := range candidates {
+		remaining := requiredDuration - Duration(res)
+		if remaining <= 0 {
+			break
+		}
+
+		if !slot.End.After(now) || overlaps(slot) {
+			continue
+		}
+
+		if slot.Start.Before(now) {
+			slot.Start = now
+		}
+
+		// late start for partial slots
+		if slot.End.Sub(slot.Start) > remaining {
+			slot.Start = slot.End.Add(-remaining)
+		}
+
+		res = append(res, slot)
+	}
+
+	res.Sort()
+
+	return res
+}
@@ -0,0 +1,36 @@
+package planner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommit(t *testing.T) {
+	clock := clock.NewMock()
+	now := clock.Now()
+	rr := rates([]float64{20, 5, 8, 10}, now, time.Hour)
+
+	committed := api.Rates{rr[0], rr[3]}
+	plan := api.Rates{rr[1], rr[2]}
+
+	// no committed plan
+	assert.Equal(t, plan, Commit(nil, plan, now, time.Hour, 2*time.Hour))
+
+	// committed plan expired
+	assert.Equal(t, plan, Commit(committed, plan, now.Add(5*time.Hour), time.Hour, 2*time.Hour))
+
+	// active slot is kept, slot beyond window is re-planned
+	assert.Equal(t, api.Rates{rr[0], rr[1]}, Commit(committed, plan, now, time.Hour, 2*time.Hour))
+
+	// partial slot starts late
+	assert.Equal(t, api.Rates{rr[0], {Start: now.Add(90 * time.Minute), End: rr[1].End, Value: rr[1].Value}},
+		Commit(committed, plan, now, time.Hour, 90*time.Minute))
+
+	// active slot is trimmed to now
+	res := Commit(committed, plan, now.Add(30*time.Minute), time.Hour, 90*time.Minute)
+	assert.Equal(t, api.Rates{{Start: now.Add(30 * time.Minute), End: rr[0].End, Value: rr[0].Value}, rr[1]}, res)
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/evcc-io/evcc/util"
 )
 
+// needThreshold is the minimum difference of remaining energy in kWh for soc based allocation
+const needThreshold = 1.0
+
 type Prioritizer struct {
 	mu     sync.Mutex
 	log    *util.Logger
+	policy api.AllocationPolicy
 	demand map[loadpoint.API]float64
 }
 
@@ -22,6 +26,13 @@ func New(log *util.Logger) *Prioritizer {
 	}
 }
 
+// SetPolicy sets the allocation policy
+func (p *Prioritizer) SetPolicy(policy api.AllocationPolicy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.policy = policy
+}
+
 func (p *Prioritizer) UpdateChargePowerFlexibility(lp loadpoint.API, rates api.Rates) {
 	if power := lp.GetChargePowerFlexibility(rates); power >= 0 {
 		p.mu.Lock()
@@ -30,23 +41,53 @@ func (p *Prioritizer) UpdateChargePowerFlexibility(lp loadpoint.API, rates api.R
 	}
 }
 
-func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
+// share returns the flexible power of loadpoint other that can be reallocated to loadpoint lp.
+// Lower priority loadpoints always yield, same priority loadpoints depending on the policy.
+func (p *Prioritizer) share(lp, other loadpoint.API, power float64) float64 {
 	prio := lp.EffectivePriority()
 
+	switch otherPrio := other.EffectivePriority(); {
+	case otherPrio < prio:
+		return power
+	case otherPrio > prio || other == lp:
+		return 0
+	}
+
+	switch p.policy {
+	case api.AllocationFair:
+		// equalize flexible power among loadpoints of same priority
+		return max(power-p.demand[lp], 0) / 2
+	case api.AllocationSoc:
+		if other.GetRemainingEnergy()+needThreshold < lp.GetRemainingEnergy() {
+			return power
+		}
+	}
+
+	return 0
+}
+
+func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	var (
 		reduceBy float64
 		msg      string
 	)
 
-	for lp, power := range p.demand {
-		if lp.EffectivePriority() < prio && power > 0 {
-			reduceBy += power
-			msg += fmt.Sprintf("%.0fW from %s at prio %d, ", power, lp.GetTitle(), lp.EffectivePriority())
+	for other, power := range p.demand {
+		if power <= 0 {
+			continue
+		}
+
+		if share := p.share(lp, other, power); share > 0 {
+			reduceBy += share
+			msg += fmt.Sprintf("%.0fW from %s at prio %d, ", share, other.GetTitle(), other.EffectivePriority())
 		}
 	}
 
 	if p.log != nil && reduceBy > 0 {
-		p.log.DEBUG.Printf("lp %s at prio %d gets additional %stotal %.0fW\n", lp.GetTitle(), lp.EffectivePriority(), msg, reduceBy)
+		p.log.DEBUG.Printf("lp %s at prio %d gets additional %stotal %.0fW (%s)\n", lp.GetTitle(), lp.EffectivePriority(), msg, reduceBy, p.policy)
 	}
 
 	return reduceBy
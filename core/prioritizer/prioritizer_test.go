@@ -3,6 +3,7 @@ package prioritizer
 import (
 	"testing"
 
+	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -38,3 +39,38 @@ func TestPrioritzer(t *testing.T) {
 	p.UpdateChargePowerFlexibility(lo, nil)
 	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(hi))
 }
+
+func TestPrioritzerPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	a := loadpoint.NewMockAPI(ctrl)
+	a.EXPECT().GetTitle().AnyTimes()
+	a.EXPECT().EffectivePriority().Return(0).AnyTimes()
+	a.EXPECT().GetRemainingEnergy().Return(30.0).AnyTimes()
+	a.EXPECT().GetChargePowerFlexibility(nil).Return(1e3).AnyTimes()
+
+	b := loadpoint.NewMockAPI(ctrl)
+	b.EXPECT().GetTitle().AnyTimes()
+	b.EXPECT().EffectivePriority().Return(0).AnyTimes()
+	b.EXPECT().GetRemainingEnergy().Return(10.0).AnyTimes()
+	b.EXPECT().GetChargePowerFlexibility(nil).Return(5e3).AnyTimes()
+
+	for _, tc := range []struct {
+		policy api.AllocationPolicy
+		a, b   float64
+	}{
+		{api.AllocationPriority, 0, 0},
+		{api.AllocationFair, 2e3, 0},
+		{api.AllocationSoc, 5e3, 0},
+	} {
+		t.Log(tc.policy)
+
+		p := New(nil)
+		p.SetPolicy(tc.policy)
+		p.UpdateChargePowerFlexibility(a, nil)
+		p.UpdateChargePowerFlexibility(b, nil)
+
+		assert.Equal(t, tc.a, p.GetChargePowerFlexibility(a))
+		assert.Equal(t, tc.b, p.GetChargePowerFlexibility(b))
+	}
+}
@@ -0,0 +1,39 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBilling(t *testing.T) {
+	s := Session{
+		ChargedEnergy:     10,
+		Price:             lo.ToPtr(3.0),
+		GridPrice:         lo.ToPtr(2.0),
+		SolarPrice:        lo.ToPtr(1.0),
+		PricePerKWh:       lo.ToPtr(0.3),
+		BillingMeterStart: lo.ToPtr(100.0),
+		BillingMeterStop:  lo.ToPtr(110.5),
+	}
+
+	s.SetBilling()
+	require.NotNil(t, s.BilledEnergy)
+	assert.Equal(t, 10.5, *s.BilledEnergy)
+	assert.InDelta(t, 3.15, *s.Price, 1e-9)
+	assert.InDelta(t, 2.1, *s.GridPrice, 1e-9)
+	assert.InDelta(t, 1.05, *s.SolarPrice, 1e-9)
+
+	// missing stop reading
+	s = Session{ChargedEnergy: 10, Price: lo.ToPtr(3.0), BillingMeterStart: lo.ToPtr(100.0)}
+	s.SetBilling()
+	assert.Nil(t, s.BilledEnergy)
+	assert.Equal(t, 3.0, *s.Price)
+
+	// meter reset
+	s = Session{BillingMeterStart: lo.ToPtr(100.0), BillingMeterStop: lo.ToPtr(1.0)}
+	s.SetBilling()
+	assert.Nil(t, s.BilledEnergy)
+}
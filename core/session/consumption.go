@@ -0,0 +1,27 @@
+package session
+
+// VehicleConsumption is the accumulated driving consumption of a vehicle
+type VehicleConsumption struct {
+	Distance    float64 `json:"distance"`    // km
+	Energy      float64 `json:"energy"`      // kWh
+	Consumption float64 `json:"consumption"` // kWh/100km
+}
+
+// Consumption returns the consumption per vehicle for sessions with known trip distance
+func (t Sessions) Consumption() map[string]VehicleConsumption {
+	res := make(map[string]VehicleConsumption)
+
+	for _, s := range t {
+		if s.Vehicle == "" || s.Distance == nil || *s.Distance <= 0 {
+			continue
+		}
+
+		vc := res[s.Vehicle]
+		vc.Distance += *s.Distance
+		vc.Energy += s.ChargedEnergy
+		vc.Consumption = vc.Energy / vc.Distance * 100
+		res[s.Vehicle] = vc
+	}
+
+	return res
+}
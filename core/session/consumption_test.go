@@ -0,0 +1,39 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTrip(t *testing.T) {
+	s := Session{ChargedEnergy: 15, OdometerStart: lo.ToPtr(1100.0)}
+
+	s.SetTrip(1000)
+	require.NotNil(t, s.Distance)
+	assert.Equal(t, 100.0, *s.Distance)
+	assert.Equal(t, 15.0, *s.Consumption)
+
+	// odometer not advanced
+	s = Session{ChargedEnergy: 15, OdometerStart: lo.ToPtr(1000.0)}
+	s.SetTrip(1000)
+	assert.Nil(t, s.Distance)
+	assert.Nil(t, s.Consumption)
+}
+
+func TestConsumption(t *testing.T) {
+	sessions := Sessions{
+		{Vehicle: "a", ChargedEnergy: 10, Distance: lo.ToPtr(50.0)},
+		{Vehicle: "a", ChargedEnergy: 20, Distance: lo.ToPtr(150.0)},
+		{Vehicle: "a", ChargedEnergy: 30},
+		{Vehicle: "b", ChargedEnergy: 18, Distance: lo.ToPtr(100.0)},
+		{ChargedEnergy: 18, Distance: lo.ToPtr(100.0)},
+	}
+
+	assert.Equal(t, map[string]VehicleConsumption{
+		"a": {Distance: 200, Energy: 30, Consumption: 15},
+		"b": {Distance: 100, Energy: 18, Consumption: 18},
+	}, sessions.Consumption())
+}
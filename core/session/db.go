@@ -17,8 +17,16 @@ var (
 	sessions Sessions
 )
 
+// migrations of sessions, append only
+var migrations = []db.Migration{
+	{Description: "initial schema"},
+}
+
 func Init() error {
 	err := db.Instance.AutoMigrate(new(Session))
+	if err == nil {
+		err = db.Migrate("sessions", migrations...)
+	}
 	if err == nil {
 		err = db.Instance.Find(&sessions).Error
 	}
@@ -95,3 +103,13 @@ func (s *DB) ClosePendingSessionsInHistory(chargeMeterTotal float64) error {
 
 	return nil
 }
+
+// LastOdometer returns the most recent odometer reading of the vehicle before the given session
+func (s *DB) LastOdometer(session *Session) (float64, bool) {
+	var res Session
+	if tx := s.db.Limit(1).Order("created DESC").Find(&res, "vehicle = ? AND odometer IS NOT NULL AND created < ?", session.Vehicle, session.Created); tx.Error != nil || tx.RowsAffected == 0 {
+		return 0, false
+	}
+
+	return *res.Odometer, true
+}
@@ -0,0 +1,102 @@
+package session
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/samber/lo"
+)
+
+const (
+	departureMinParking   = 2 * time.Hour // ignore short stops
+	departureMinSamples   = 3             // minimum number of departures per weekday
+	departureMaxDeviation = 60            // maximum deviation from the median departure (minutes)
+	departureRounding     = 5             // departure times are rounded down to this many minutes
+)
+
+// Disconnect records the vehicle being unplugged in the session timeline.
+// Suspension totals are not accounted since charging has already finished.
+func (s *Session) Disconnect(ts time.Time) {
+	if n := len(s.Timeline); n > 0 && s.Timeline[n-1].State == StateDisconnected {
+		return
+	}
+	s.Timeline = append(s.Timeline, Transition{State: StateDisconnected, Start: ts})
+}
+
+// Departure returns the time the vehicle was unplugged if recorded
+func (s *Session) Departure() (time.Time, bool) {
+	if n := len(s.Timeline); n > 0 && s.Timeline[n-1].State == StateDisconnected {
+		return s.Timeline[n-1].Start, true
+	}
+	return time.Time{}, false
+}
+
+// DepartureTimes detects the typical departure time per weekday from the sessions' plug-out times.
+// Only the first departure of each day after parking for a longer period is considered. Weekdays
+// without regular departures are omitted. The lower quartile is used for not missing early departures.
+func (ss Sessions) DepartureTimes(loc *time.Location) []api.DepartureTimeStruct {
+	// first departure per day
+	days := make(map[time.Time]time.Time)
+
+	for _, s := range ss {
+		dep, ok := s.Departure()
+		if !ok {
+			continue
+		}
+
+		arrival := s.Created
+		if len(s.Timeline) > 0 {
+			arrival = s.Timeline[0].Start
+		}
+
+		if dep.Sub(arrival) < departureMinParking {
+			continue
+		}
+
+		dep = dep.In(loc)
+		day := time.Date(dep.Year(), dep.Month(), dep.Day(), 0, 0, 0, 0, loc)
+
+		if prev, ok := days[day]; !ok || dep.Before(prev) {
+			days[day] = dep
+		}
+	}
+
+	var minutes [7][]int
+	for day, dep := range days {
+		minutes[day.Weekday()] = append(minutes[day.Weekday()], dep.Hour()*60+dep.Minute())
+	}
+
+	var res []api.DepartureTimeStruct
+
+	for weekday, mm := range minutes {
+		if len(mm) < departureMinSamples {
+			continue
+		}
+
+		slices.Sort(mm)
+		median := mm[len(mm)/2]
+
+		typical := lo.Filter(mm, func(m, _ int) bool {
+			return max(m-median, median-m) <= departureMaxDeviation
+		})
+
+		// irregular departures
+		if len(typical) < departureMinSamples || 2*len(typical) <= len(mm) {
+			continue
+		}
+
+		m := typical[(len(typical)-1)/4]
+		m -= m % departureRounding
+
+		res = append(res, api.DepartureTimeStruct{
+			Weekday: weekday,
+			Time:    fmt.Sprintf("%02d:%02d", m/60, m%60),
+			Tz:      loc.String(),
+			Samples: len(typical),
+		})
+	}
+
+	return res
+}
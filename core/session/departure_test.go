@@ -0,0 +1,73 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func departureSession(arrival, departure time.Time) Session {
+	s := Session{Created: arrival}
+	s.SetState(StatePreparing, arrival)
+	s.Disconnect(departure)
+	return s
+}
+
+func TestDepartureTimes(t *testing.T) {
+	loc := time.UTC
+	monday := time.Date(2025, 6, 2, 0, 0, 0, 0, loc)
+
+	var ss Sessions
+
+	for week, dep := range []string{"07:32", "07:41", "07:28", "07:35", "11:50"} {
+		day := monday.AddDate(0, 0, 7*week)
+		ts, err := time.ParseInLocation("15:04", dep, loc)
+		require.NoError(t, err)
+		departure := day.Add(time.Duration(ts.Hour())*time.Hour + time.Duration(ts.Minute())*time.Minute)
+
+		// overnight parking
+		ss = append(ss, departureSession(day.Add(-6*time.Hour), departure))
+
+		// short stop later that day
+		ss = append(ss, departureSession(departure.Add(2*time.Hour), departure.Add(3*time.Hour)))
+	}
+
+	// irregular tuesday
+	tuesday := monday.AddDate(0, 0, 1)
+	for week, h := range []int{6, 9, 13, 18} {
+		day := tuesday.AddDate(0, 0, 7*week)
+		ss = append(ss, departureSession(day.Add(-6*time.Hour), day.Add(time.Duration(h)*time.Hour)))
+	}
+
+	// wednesday without recorded departure
+	wednesday := monday.AddDate(0, 0, 2)
+	for week := range 4 {
+		ss = append(ss, Session{Created: wednesday.AddDate(0, 0, 7*week)})
+	}
+
+	assert.Equal(t, []api.DepartureTimeStruct{
+		{Weekday: 1, Time: "07:25", Tz: "UTC", Samples: 4},
+	}, ss.DepartureTimes(loc))
+}
+
+func TestSessionDisconnect(t *testing.T) {
+	var s Session
+	ts := time.Now()
+
+	_, ok := s.Departure()
+	assert.False(t, ok)
+
+	s.SetState(StateSuspendedVehicle, ts)
+	s.Disconnect(ts.Add(time.Hour))
+	s.Disconnect(ts.Add(2 * time.Hour))
+
+	dep, ok := s.Departure()
+	assert.True(t, ok)
+	assert.Equal(t, ts.Add(time.Hour), dep)
+
+	// suspension is not accounted
+	assert.Nil(t, s.SuspendedVehicle)
+}
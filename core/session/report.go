@@ -0,0 +1,207 @@
+package session
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// ReportEntry is a single charging session of a reimbursement report
+type ReportEntry struct {
+	Created         time.Time `json:"created"`
+	Finished        time.Time `json:"finished"`
+	Loadpoint       string    `json:"loadpoint"`
+	Energy          float64   `json:"energy"` // kWh, billed energy if available
+	SolarPercentage *float64  `json:"solarPercentage"`
+	PricePerKWh     *float64  `json:"pricePerKWh"`
+	Price           *float64  `json:"price"`
+}
+
+// Report is the reimbursement report of a single vehicle's charging sessions
+type Report struct {
+	Vehicle         string        `json:"vehicle"`
+	Sessions        []ReportEntry `json:"sessions"`
+	Energy          float64       `json:"energy"`          // kWh
+	SolarPercentage float64       `json:"solarPercentage"` // energy weighted
+	Price           *float64      `json:"price"`
+}
+
+// Reports is a list of vehicle reports
+type Reports []Report
+
+var _ api.CsvWriter = (*Reports)(nil)
+
+// Report creates reimbursement reports per vehicle. If pricePerKWh is given,
+// it replaces the session prices, e.g. for a flat rate agreed with the employer.
+func (t Sessions) Report(pricePerKWh *float64) Reports {
+	byVehicle := make(map[string]*Report)
+
+	for _, s := range t {
+		r, ok := byVehicle[s.Vehicle]
+		if !ok {
+			r = &Report{Vehicle: s.Vehicle}
+			byVehicle[s.Vehicle] = r
+		}
+
+		e := ReportEntry{
+			Created:         s.Created,
+			Finished:        s.Finished,
+			Loadpoint:       s.Loadpoint,
+			Energy:          s.ChargedEnergy,
+			SolarPercentage: s.SolarPercentage,
+			PricePerKWh:     s.PricePerKWh,
+			Price:           s.Price,
+		}
+
+		if s.BilledEnergy != nil {
+			e.Energy = *s.BilledEnergy
+		}
+
+		if pricePerKWh != nil {
+			price := e.Energy * *pricePerKWh
+			e.PricePerKWh = pricePerKWh
+			e.Price = &price
+		}
+
+		if e.Price != nil {
+			price := *e.Price
+			if r.Price != nil {
+				price += *r.Price
+			}
+			r.Price = &price
+		}
+
+		if e.SolarPercentage != nil && r.Energy+e.Energy > 0 {
+			r.SolarPercentage = (r.SolarPercentage*r.Energy + *e.SolarPercentage*e.Energy) / (r.Energy + e.Energy)
+		}
+
+		r.Energy += e.Energy
+		r.Sessions = append(r.Sessions, e)
+	}
+
+	res := make(Reports, 0, len(byVehicle))
+	for _, r := range byVehicle {
+		slices.SortFunc(r.Sessions, func(a, b ReportEntry) int {
+			return a.Created.Compare(b.Created)
+		})
+
+		res = append(res, *r)
+	}
+
+	slices.SortFunc(res, func(a, b Report) int {
+		return cmp.Compare(a.Vehicle, b.Vehicle)
+	})
+
+	return res
+}
+
+// reportColumns are the report columns, captions reuse the session export translations
+var reportColumns = []struct{ id, caption string }{
+	{"vehicle", "Vehicle"},
+	{"created", "Created"},
+	{"finished", "Finished"},
+	{"loadpoint", "Charging point"},
+	{"chargedenergy", "Energy (kWh)"},
+	{"solarpercentage", "Solar (%)"},
+	{"priceperkwh", "Price/kWh"},
+	{"price", "Price"},
+}
+
+// reportCaptions returns the localized column captions
+func reportCaptions(lang string) []string {
+	localizer := i18n.NewLocalizer(locale.Bundle, lang, locale.Language)
+
+	res := make([]string, 0, len(reportColumns))
+	for _, c := range reportColumns {
+		caption, err := localizer.Localize(&locale.Config{
+			MessageID: "sessions.csv." + c.id,
+		})
+		if err != nil {
+			caption = c.caption
+		}
+
+		res = append(res, caption)
+	}
+
+	return res
+}
+
+// rows returns the formatted report rows including a trailing total row
+func (r Report) rows(mp *message.Printer) [][]string {
+	res := make([][]string, 0, len(r.Sessions)+1)
+
+	for _, e := range r.Sessions {
+		res = append(res, []string{
+			r.Vehicle,
+			formatValue(mp, e.Created, 0),
+			formatValue(mp, e.Finished, 0),
+			e.Loadpoint,
+			formatValue(mp, e.Energy, 3),
+			formatValue(mp, e.SolarPercentage, 1),
+			formatValue(mp, e.PricePerKWh, 3),
+			formatValue(mp, e.Price, 2),
+		})
+	}
+
+	return append(res, []string{
+		r.Vehicle, "", "", "",
+		formatValue(mp, r.Energy, 3),
+		formatValue(mp, r.SolarPercentage, 1),
+		"",
+		formatValue(mp, r.Price, 2),
+	})
+}
+
+// reportLanguage returns the context language
+func reportLanguage(ctx context.Context) (string, language.Tag, error) {
+	lang := locale.Language
+	if l, ok := ctx.Value(locale.Locale).(string); ok && l != "" {
+		lang = l
+	}
+
+	tag, err := language.Parse(lang)
+	return lang, tag, err
+}
+
+// WriteCsv implements the api.CsvWriter interface
+func (t *Reports) WriteCsv(ctx context.Context, w io.Writer) error {
+	if _, err := w.Write([]byte{0xEF, 0xBB, 0xBF}); err != nil {
+		return err
+	}
+
+	lang, tag, err := reportLanguage(ctx)
+	if err != nil {
+		return err
+	}
+
+	ww := csv.NewWriter(w)
+
+	// set separator according to locale
+	if b, _ := tag.Base(); b.String() == language.German.String() {
+		ww.Comma = ';'
+	}
+
+	if err := ww.Write(reportCaptions(lang)); err != nil {
+		return err
+	}
+
+	mp := message.NewPrinter(tag)
+	for _, r := range *t {
+		if err := ww.WriteAll(r.rows(mp)); err != nil {
+			return err
+		}
+	}
+
+	ww.Flush()
+
+	return ww.Error()
+}
@@ -0,0 +1,74 @@
+package session
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/text/message"
+)
+
+// reportWidths are the pdf column widths in mm
+var reportWidths = []float64{0, 34, 34, 54, 30, 25, 30, 30}
+
+// WritePdf writes the reports as pdf with one page per vehicle
+func (t *Reports) WritePdf(ctx context.Context, w io.Writer, title string) error {
+	lang, tag, err := reportLanguage(ctx)
+	if err != nil {
+		return err
+	}
+
+	captions := reportCaptions(lang)
+	mp := message.NewPrinter(tag)
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for _, r := range *t {
+		pdf.AddPage()
+
+		heading := title
+		if r.Vehicle != "" {
+			heading += " - " + r.Vehicle
+		}
+
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(0, 10, tr(heading), "", 1, "L", false, 0, "")
+		pdf.Ln(2)
+
+		// vehicle column is omitted since it is part of the heading
+		pdf.SetFont("Helvetica", "B", 9)
+		for i, c := range captions {
+			if reportWidths[i] > 0 {
+				pdf.CellFormat(reportWidths[i], 7, tr(c), "B", 0, "L", false, 0, "")
+			}
+		}
+		pdf.Ln(-1)
+
+		rows := r.rows(mp)
+		for j, row := range rows {
+			pdf.SetFont("Helvetica", "", 9)
+			border := ""
+			if j == len(rows)-1 {
+				pdf.SetFont("Helvetica", "B", 9)
+				border = "T"
+			}
+
+			for i, val := range row {
+				if reportWidths[i] > 0 {
+					pdf.CellFormat(reportWidths[i], 6, tr(val), border, 0, "L", false, 0, "")
+				}
+			}
+			pdf.Ln(-1)
+		}
+	}
+
+	// empty document
+	if pdf.PageCount() == 0 {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(0, 10, tr(title), "", 1, "L", false, 0, "")
+	}
+
+	return pdf.Output(w)
+}
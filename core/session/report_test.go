@@ -0,0 +1,65 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/server/assets"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	now := time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC)
+
+	sessions := Sessions{
+		{Vehicle: "car", Loadpoint: "garage", Created: now.Add(time.Hour), ChargedEnergy: 10, SolarPercentage: lo.ToPtr(100.0), Price: lo.ToPtr(1.0)},
+		{Vehicle: "car", Loadpoint: "office", Created: now, ChargedEnergy: 30, BilledEnergy: lo.ToPtr(30.0), SolarPercentage: lo.ToPtr(0.0), Price: lo.ToPtr(9.0)},
+		{Vehicle: "bike", Created: now, ChargedEnergy: 1},
+	}
+
+	res := sessions.Report(nil)
+	require.Len(t, res, 2)
+
+	assert.Equal(t, "bike", res[0].Vehicle)
+	assert.Nil(t, res[0].Price)
+
+	car := res[1]
+	require.Len(t, car.Sessions, 2)
+	assert.Equal(t, "office", car.Sessions[0].Loadpoint)
+	assert.Equal(t, 40.0, car.Energy)
+	assert.Equal(t, 25.0, car.SolarPercentage)
+	assert.Equal(t, 10.0, *car.Price)
+
+	// price override
+	res = sessions.Report(lo.ToPtr(0.5))
+	assert.Equal(t, 0.5, *res[0].Price)
+	assert.Equal(t, 20.0, *res[1].Price)
+}
+
+func TestReportExport(t *testing.T) {
+	assets.I18n = os.DirFS("../../i18n")
+	require.NoError(t, locale.Init())
+
+	res := Sessions{{Vehicle: "car", Loadpoint: "garage", ChargedEnergy: 10, Price: lo.ToPtr(3.0)}}.Report(nil)
+	ctx := context.WithValue(context.Background(), locale.Locale, "en")
+
+	var csv bytes.Buffer
+	require.NoError(t, res.WriteCsv(ctx, &csv))
+
+	lines := strings.Split(strings.TrimSpace(csv.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "Charging point")
+	assert.Equal(t, "car,,,garage,10,,,3", lines[1])
+	assert.Equal(t, "car,,,,10,0,,3", lines[2])
+
+	var pdf bytes.Buffer
+	require.NoError(t, res.WritePdf(ctx, &pdf, "report"))
+	assert.True(t, bytes.HasPrefix(pdf.Bytes(), []byte("%PDF")))
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/evcc-io/evcc/util/locale"
 	"github.com/fatih/structs"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/samber/lo"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
 	"golang.org/x/text/number"
@@ -20,21 +21,77 @@ import (
 
 // Session is a single charging session
 type Session struct {
-	ID              uint           `json:"id" csv:"-" gorm:"primarykey"`
-	Created         time.Time      `json:"created"`
-	Finished        time.Time      `json:"finished"`
-	Loadpoint       string         `json:"loadpoint"`
-	Identifier      string         `json:"identifier"`
-	Vehicle         string         `json:"vehicle"`
-	Odometer        *float64       `json:"odometer" format:"int"`
-	MeterStart      *float64       `json:"meterStart" csv:"Meter Start (kWh)" gorm:"column:meter_start_kwh"`
-	MeterStop       *float64       `json:"meterStop" csv:"Meter Stop (kWh)" gorm:"column:meter_end_kwh"`
-	ChargedEnergy   float64        `json:"chargedEnergy" csv:"Charged Energy (kWh)" gorm:"column:charged_kwh"`
-	ChargeDuration  *time.Duration `json:"chargeDuration" csv:"Charge Duration" gorm:"column:charge_duration"`
-	SolarPercentage *float64       `json:"solarPercentage" csv:"Solar (%)" gorm:"column:solar_percentage"`
-	Price           *float64       `json:"price" csv:"Price" gorm:"column:price"`
-	PricePerKWh     *float64       `json:"pricePerKWh" csv:"Price/kWh" gorm:"column:price_per_kwh"`
-	Co2PerKWh       *float64       `json:"co2PerKWh" csv:"CO2/kWh (gCO2eq)" gorm:"column:co2_per_kwh"`
+	ID                uint           `json:"id" csv:"-" gorm:"primarykey"`
+	Created           time.Time      `json:"created"`
+	Finished          time.Time      `json:"finished"`
+	Loadpoint         string         `json:"loadpoint"`
+	Identifier        string         `json:"identifier"`
+	Vehicle           string         `json:"vehicle"`
+	OdometerStart     *float64       `json:"odometerStart" csv:"Odometer Start (km)" format:"int" gorm:"column:odometer_start"`
+	Odometer          *float64       `json:"odometer" format:"int"`
+	Distance          *float64       `json:"distance" csv:"Distance (km)" format:"int" gorm:"column:distance"`
+	MeterStart        *float64       `json:"meterStart" csv:"Meter Start (kWh)" gorm:"column:meter_start_kwh"`
+	MeterStop         *float64       `json:"meterStop" csv:"Meter Stop (kWh)" gorm:"column:meter_end_kwh"`
+	BillingMeterStart *float64       `json:"billingMeterStart" csv:"Billing Meter Start (kWh)" gorm:"column:billing_meter_start_kwh"`
+	BillingMeterStop  *float64       `json:"billingMeterStop" csv:"Billing Meter Stop (kWh)" gorm:"column:billing_meter_end_kwh"`
+	ChargedEnergy     float64        `json:"chargedEnergy" csv:"Charged Energy (kWh)" gorm:"column:charged_kwh"`
+	BilledEnergy      *float64       `json:"billedEnergy" csv:"Billed Energy (kWh)" gorm:"column:billed_kwh"`
+	ChargeDuration    *time.Duration `json:"chargeDuration" csv:"Charge Duration" gorm:"column:charge_duration"`
+	SuspendedVehicle  *time.Duration `json:"suspendedVehicle" csv:"Suspended by Vehicle" gorm:"column:suspended_vehicle"`
+	SuspendedEvcc     *time.Duration `json:"suspendedEvcc" csv:"Suspended by evcc" gorm:"column:suspended_evcc"`
+	SolarPercentage   *float64       `json:"solarPercentage" csv:"Solar (%)" gorm:"column:solar_percentage"`
+	Price             *float64       `json:"price" csv:"Price" gorm:"column:price"`
+	GridPrice         *float64       `json:"gridPrice" csv:"Grid Price" gorm:"column:grid_price"`
+	SolarPrice        *float64       `json:"solarPrice" csv:"Solar Price" gorm:"column:solar_price"`
+	PricePerKWh       *float64       `json:"pricePerKWh" csv:"Price/kWh" gorm:"column:price_per_kwh"`
+	Co2PerKWh         *float64       `json:"co2PerKWh" csv:"CO2/kWh (gCO2eq)" gorm:"column:co2_per_kwh"`
+	Consumption       *float64       `json:"consumption" csv:"Consumption (kWh/100km)" gorm:"column:consumption"`
+	Timeline          []Transition   `json:"timeline,omitempty" csv:"-" gorm:"column:timeline;serializer:json"`
+
+	accounted time.Time // timeline accounted until
+}
+
+// SetTrip sets distance driven since the previous session's odometer reading and
+// the resulting consumption, assuming the charged energy replenishes the energy used
+func (s *Session) SetTrip(prevOdometer float64) {
+	if s.OdometerStart == nil || *s.OdometerStart <= prevOdometer {
+		return
+	}
+
+	distance := *s.OdometerStart - prevOdometer
+	s.Distance = &distance
+
+	consumption := s.ChargedEnergy / distance * 100
+	s.Consumption = &consumption
+}
+
+// SetBilling sets the billed energy from the billing meter readings.
+// The billing meter is authoritative for invoicing, the price is based on the billed instead of the measured energy.
+func (s *Session) SetBilling() {
+	if s.BillingMeterStart == nil || s.BillingMeterStop == nil || *s.BillingMeterStop < *s.BillingMeterStart {
+		return
+	}
+
+	energy := *s.BillingMeterStop - *s.BillingMeterStart
+	s.BilledEnergy = &energy
+
+	if s.PricePerKWh != nil {
+		price := energy * *s.PricePerKWh
+		s.Price = &price
+
+		// scale price breakdown to billed energy
+		if s.ChargedEnergy > 0 {
+			scale := func(p *float64) *float64 {
+				if p == nil {
+					return nil
+				}
+				return lo.ToPtr(*p * energy / s.ChargedEnergy)
+			}
+
+			s.GridPrice = scale(s.GridPrice)
+			s.SolarPrice = scale(s.SolarPrice)
+		}
+	}
 }
 
 // Sessions is a list of sessions
@@ -82,6 +139,8 @@ func formatValue(mp *message.Printer, value any, digits int) string {
 		return mp.Sprint(number.Decimal(v, number.NoSeparator(), number.MaxFractionDigits(digits)))
 	case *float64:
 		return mp.Sprint(number.Decimal(*v, number.NoSeparator(), number.MaxFractionDigits(digits)))
+	case *time.Duration:
+		return v.Round(time.Second).String()
 	case time.Time:
 		if v.IsZero() {
 			return ""
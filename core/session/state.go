@@ -0,0 +1,71 @@
+package session
+
+import "time"
+
+// State is the detailed charging state of a session
+type State string
+
+// Session states following IEC 61851 with charging suspensions attributed to vehicle or evcc
+const (
+	StateDisconnected     State = "disconnected"     // A: no vehicle
+	StatePreparing        State = "preparing"        // B: connected, charging has not started yet
+	StateCharging         State = "charging"         // C: charging
+	StateSuspendedVehicle State = "suspendedVehicle" // B: charging enabled but paused by the vehicle
+	StateSuspendedEvcc    State = "suspendedEvcc"    // B: charging disabled by evcc
+)
+
+// Transition is a state change of the session timeline
+type Transition struct {
+	State State     `json:"state"`
+	Start time.Time `json:"start"`
+}
+
+// SetState appends the state to the session timeline and accounts the duration of the previous state
+func (s *Session) SetState(state State, ts time.Time) {
+	if n := len(s.Timeline); n > 0 && s.Timeline[n-1].State == state {
+		return
+	}
+
+	s.account(ts)
+	s.Timeline = append(s.Timeline, Transition{State: state, Start: ts})
+}
+
+// CloseTimeline accounts the duration of the current state when the session ends
+func (s *Session) CloseTimeline(ts time.Time) {
+	s.account(ts)
+}
+
+// account adds the duration of the current state until ts to the suspension totals
+func (s *Session) account(ts time.Time) {
+	n := len(s.Timeline)
+	if n == 0 {
+		return
+	}
+
+	prev := s.Timeline[n-1]
+
+	// avoid accounting twice
+	start := prev.Start
+	if s.accounted.After(start) {
+		start = s.accounted
+	}
+	s.accounted = ts
+
+	d := ts.Sub(start)
+
+	switch prev.State {
+	case StateSuspendedVehicle:
+		s.SuspendedVehicle = addDuration(s.SuspendedVehicle, d)
+	case StateSuspendedEvcc:
+		s.SuspendedEvcc = addDuration(s.SuspendedEvcc, d)
+	}
+}
+
+func addDuration(d *time.Duration, add time.Duration) *time.Duration {
+	var res time.Duration
+	if d != nil {
+		res = *d
+	}
+	res += add
+	return &res
+}
@@ -0,0 +1,42 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTimeline(t *testing.T) {
+	var s Session
+	ts := time.Now()
+
+	for _, tc := range []struct {
+		state State
+		after time.Duration
+	}{
+		{StatePreparing, 0},
+		{StateCharging, time.Minute},
+		{StateSuspendedEvcc, time.Hour},
+		{StateSuspendedEvcc, 10 * time.Minute}, // no change
+		{StateCharging, 20 * time.Minute},
+		{StateSuspendedVehicle, time.Hour},
+	} {
+		ts = ts.Add(tc.after)
+		s.SetState(tc.state, ts)
+	}
+
+	require.Len(t, s.Timeline, 5)
+	assert.Equal(t, StateSuspendedVehicle, s.Timeline[4].State)
+
+	require.NotNil(t, s.SuspendedEvcc)
+	assert.Equal(t, 30*time.Minute, *s.SuspendedEvcc)
+	assert.Nil(t, s.SuspendedVehicle)
+
+	s.CloseTimeline(ts.Add(15 * time.Minute))
+	s.CloseTimeline(ts.Add(15 * time.Minute))
+
+	require.NotNil(t, s.SuspendedVehicle)
+	assert.Equal(t, 15*time.Minute, *s.SuspendedVehicle)
+}
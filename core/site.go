@@ -32,9 +32,12 @@ import (
 	"github.com/evcc-io/evcc/tariff"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/diagnostics"
+	"github.com/evcc-io/evcc/util/feature"
 	"github.com/evcc-io/evcc/util/modbus"
 	"github.com/evcc-io/evcc/util/sponsor"
 	"github.com/evcc-io/evcc/util/telemetry"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
 	"github.com/smallnest/chanx"
 	"golang.org/x/sync/errgroup"
@@ -60,6 +63,8 @@ type measurement struct {
 	Capacity      *float64  `json:"capacity,omitempty"`
 	Soc           *float64  `json:"soc,omitempty"`
 	Controllable  *bool     `json:"controllable,omitempty"`
+
+	Diagnostics map[string]any `json:"diagnostics,omitempty"`
 }
 
 var _ site.API = (*Site)(nil)
@@ -67,6 +72,7 @@ var _ site.API = (*Site)(nil)
 // Site is the main configuration container. A site can host multiple loadpoints.
 type Site struct {
 	uiChan       chan<- util.Param // client push messages
+	pushChan     chan<- push.Event // notifications
 	lpUpdateChan chan *Loadpoint
 
 	*Health
@@ -94,6 +100,36 @@ type Site struct {
 	bufferStartSoc          float64  // start charging on battery above this Soc
 	batteryDischargeControl bool     // prevent battery discharge for fast and planned charging
 	batteryGridChargeLimit  *float64 // grid charging limit
+	batteryPreDischarge     bool     // discharge battery before pv peak if forecast predicts it to be full
+
+	// pv settings
+	exportLimit         *float64           // grid export limit
+	externalExportLimit *float64           // grid export limit imposed by grid operator, not persisted
+	productionLimits    map[string]float64 // production limits per pv meter
+
+	// grid settings
+	importLimit       *float64  // grid import limit
+	gridPowerOverride *override // externally provided grid power, not persisted
+
+	allocationPolicy api.AllocationPolicy // surplus allocation among loadpoints
+
+	// staged rollout
+	rolloutMu        sync.Mutex         // serializes rollout operations calling into loadpoints
+	rollout          *site.Rollout      // active rollout
+	rolloutStatus    []api.ChargeStatus // loadpoint status at last rollout update
+	rolloutUpdated   time.Time          // last rollout update
+	rolloutPersisted time.Time          // last time the rollout was persisted
+
+	// monthly budget
+	budget        site.Budget        // charging budget
+	budgetStatus  *site.BudgetStatus // current month progress
+	budgetUpdated time.Time          // last budget update
+
+	departuresUpdated time.Time // last departure detection
+
+	unhealthy []string // unresponsive device connections
+
+	dryRun bool // simulation mode, battery mode is not applied
 
 	loadpoints  []*Loadpoint             // Loadpoints
 	tariffs     *tariff.Tariffs          // Tariffs
@@ -107,16 +143,18 @@ type Site struct {
 	householdSlotStart time.Time
 
 	// cached state
-	gridPower                float64         // Grid power
-	pvPower                  float64         // PV power
-	excessDCPower            float64         // PV excess DC charge power (hybrid only)
-	auxPower                 float64         // Aux power
-	batteryPower             float64         // Battery power (charge negative, discharge positive)
-	batterySoc               float64         // Battery soc
-	batteryCapacity          float64         // Battery capacity
-	batteryMode              api.BatteryMode // Battery mode (runtime only, not persisted)
-	batteryModeExternal      api.BatteryMode // Battery mode (external, runtime only, not persisted)
-	batteryModeExternalTimer time.Time       // Battery mode timer for external control
+	gridPower                 float64         // Grid power
+	pvPower                   float64         // PV power
+	pvPowers                  []float64       // PV power per meter
+	excessDCPower             float64         // PV excess DC charge power (hybrid only)
+	auxPower                  float64         // Aux power
+	batteryPower              float64         // Battery power (charge negative, discharge positive)
+	batterySoc                float64         // Battery soc
+	batteryCapacity           float64         // Battery capacity
+	batteryMode               api.BatteryMode // Battery mode (runtime only, not persisted)
+	batteryModeExternal       api.BatteryMode // Battery mode (external, runtime only, not persisted)
+	batteryModeExternalTimer  time.Time       // Battery mode timer for external control
+	batteryPreDischargeActive bool            // Battery pre-discharge active, only written by the update loop
 }
 
 // MetersConfig contains the site's meter configuration
@@ -257,11 +295,12 @@ func (site *Site) Boot(log *util.Logger, loadpoints []*Loadpoint, tariffs *tarif
 // NewSite creates a Site with sane defaults
 func NewSite() *Site {
 	site := &Site{
-		log:             util.NewLogger("site"),
-		Voltage:         230, // V
-		pvEnergy:        make(map[string]*meterEnergy),
-		fcstEnergy:      &meterEnergy{clock: clock.New()},
-		householdEnergy: &meterEnergy{clock: clock.New()},
+		log:              util.NewLogger("site"),
+		Voltage:          230, // V
+		pvEnergy:         make(map[string]*meterEnergy),
+		productionLimits: make(map[string]float64),
+		fcstEnergy:       &meterEnergy{clock: clock.New()},
+		householdEnergy:  &meterEnergy{clock: clock.New()},
 	}
 
 	return site
@@ -318,6 +357,11 @@ func (site *Site) restoreSettings() error {
 			return err
 		}
 	}
+	if v, err := settings.Bool(keys.BatteryPreDischarge); err == nil {
+		if err := site.SetBatteryPreDischarge(v); err != nil {
+			return err
+		}
+	}
 	if v, err := settings.Float(keys.ResidualPower); err == nil {
 		if err := site.SetResidualPower(v); err != nil {
 			return err
@@ -326,6 +370,23 @@ func (site *Site) restoreSettings() error {
 	if v, err := settings.Float(keys.BatteryGridChargeLimit); err == nil {
 		site.SetBatteryGridChargeLimit(&v)
 	}
+	if v, err := settings.Float(keys.ExportLimit); err == nil {
+		site.SetExportLimit(&v)
+	}
+	if v, err := settings.Float(keys.ImportLimit); err == nil {
+		site.SetImportLimit(&v)
+	}
+	if v, err := settings.String(keys.AllocationPolicy); err == nil {
+		if p, err := api.AllocationPolicyString(v); err == nil {
+			site.SetAllocationPolicy(p)
+		}
+	}
+	if r, err := restoreRollout(); err == nil {
+		site.rollout = r
+	}
+	if b, status, err := restoreBudget(); err == nil {
+		site.budget, site.budgetStatus = b, status
+	}
 
 	// restore accumulated energy
 	pvEnergy := make(map[string]meterEnergy)
@@ -518,12 +579,16 @@ func (site *Site) collectMeters(key string, meters []config.Device[api.Meter]) [
 			}
 		}
 
+		// diagnostics are optional
+		diag, _ := diagnostics.Values(meter)
+
 		props := deviceProperties(dev)
 		mm[i] = measurement{
-			Title:  props.Title,
-			Icon:   props.Icon,
-			Power:  power,
-			Energy: energy,
+			Title:       props.Title,
+			Icon:        props.Icon,
+			Power:       power,
+			Energy:      energy,
+			Diagnostics: diag,
 		}
 	}
 
@@ -563,9 +628,10 @@ func (site *Site) updatePvMeters() {
 		}
 	}
 
-	site.pvPower = lo.SumBy(mm, func(m measurement) float64 {
+	site.pvPowers = lo.Map(mm, func(m measurement, _ int) float64 {
 		return max(0, m.Power)
 	})
+	site.pvPower = lo.Sum(site.pvPowers)
 	site.excessDCPower = lo.SumBy(mm, func(m measurement) float64 {
 		return math.Abs(m.ExcessDCPower)
 	})
@@ -717,6 +783,16 @@ func (site *Site) updateExtMeters() {
 
 // updateGridMeter updates grid meter
 func (site *Site) updateGridMeter() error {
+	power := site.activeGridPowerOverride()
+	site.publish(keys.GridPowerOverride, power)
+
+	if power != nil {
+		site.gridPower = *power
+		site.log.DEBUG.Printf("grid power: %.0fW (override)", site.gridPower)
+		site.publish(keys.Grid, measurement{Power: site.gridPower})
+		return nil
+	}
+
 	if site.gridMeter == nil {
 		return nil
 	}
@@ -783,7 +859,7 @@ func (site *Site) updateMeters() error {
 		return err
 	}
 
-	if sponsor.IsAuthorized() {
+	if sponsor.IsAuthorized() && feature.Enabled(feature.Optimizer) {
 		go site.optimizerUpdateAsync(battery)
 	}
 
@@ -827,7 +903,7 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 	}
 
 	// allow using PV as estimate for grid power
-	if site.gridMeter == nil {
+	if site.gridMeter == nil && site.activeGridPowerOverride() == nil {
 		site.gridPower = totalChargePower - site.pvPower
 		site.publish(keys.Grid, measurement{Power: site.gridPower})
 	}
@@ -868,6 +944,11 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 			// if battery is above bufferSoc allow using it for charging
 			batteryBuffered = site.bufferSoc > 0 && site.batterySoc > site.bufferSoc
 			batteryStart = site.bufferStartSoc > 0 && site.batterySoc > site.bufferStartSoc
+
+			// battery will be full before pv peak anyway, use it for charging
+			if site.batteryPreDischargeActive {
+				batteryBuffered, batteryStart = true, true
+			}
 		}
 	}
 
@@ -884,12 +965,12 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 	return sitePower, batteryBuffered, batteryStart, nil
 }
 
-// updateLoadpoints updates all loadpoints' charge power
-func (site *Site) updateLoadpoints(rates api.Rates) float64 {
+// updateLoadpoints updates all loadpoints' charge power and returns the total and DC coupled charge power
+func (site *Site) updateLoadpoints(rates api.Rates) (float64, float64) {
 	var (
-		wg  sync.WaitGroup
-		mu  sync.Mutex
-		sum float64
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sum, dc float64
 	)
 
 	for _, lp := range site.loadpoints {
@@ -899,17 +980,23 @@ func (site *Site) updateLoadpoints(rates api.Rates) float64 {
 
 			mu.Lock()
 			sum += power
+			if lp.DCCoupled() {
+				dc += power
+			}
 			mu.Unlock()
 		})
 	}
 	wg.Wait()
 
-	return sum
+	return sum, dc
 }
 
 func (site *Site) update(lp updater) {
 	site.log.DEBUG.Println("----")
 
+	timer := prometheus.NewTimer(updateDurationMetric)
+	defer timer.ObserveDuration()
+
 	// smart cost and battery mode handling
 	consumption, err := site.tariffRates(api.TariffUsagePlanner)
 	if err != nil {
@@ -921,8 +1008,10 @@ func (site *Site) update(lp updater) {
 		site.log.WARN.Println("feed-in:", err)
 	}
 
+	site.updateConnectionHealth()
+
 	// update loadpoints
-	totalChargePower := site.updateLoadpoints(consumption)
+	totalChargePower, dcChargePower := site.updateLoadpoints(consumption)
 
 	// update all circuits' power and currents
 	if site.circuit != nil {
@@ -966,18 +1055,31 @@ func (site *Site) update(lp updater) {
 			site.updateHomeConsumption(homePower)
 		}
 
+		site.updateBatteryPreDischarge(homePower)
+
 		// add battery charging power to homePower to ignore all consumption which does not occur on loadpoints
 		// fix for: https://github.com/evcc-io/evcc/issues/11032
 		nonChargePower := homePower + max(0, -site.batteryPower)
-		greenShareHome := site.greenShare(0, homePower)
-		greenShareLoadpoints := site.greenShare(nonChargePower, nonChargePower+totalChargePower)
+		greenShareHome, greenShareLoadpoints, greenShareAC, greenShareDC := site.greenShares(homePower, nonChargePower, totalChargePower, dcChargePower)
+
+		greenShare := greenShareAC
+		if lp.DCCoupled() {
+			greenShare = greenShareDC
+		}
+
+		site.updateImportLimit(totalChargePower)
 
 		// TODO
 		lp.Update(
 			sitePower, max(0, site.batteryPower), consumption, feedin, batteryBuffered, batteryStart,
-			greenShareLoadpoints, site.effectivePrice(greenShareLoadpoints), site.effectiveCo2(greenShareLoadpoints),
+			greenShare, site.effectivePrice(greenShare), site.effectiveCo2(greenShare),
 		)
 
+		site.updateExportLimit()
+		site.updateRollout()
+		site.updateBudget()
+		site.updateDepartures()
+
 		site.Health.Update()
 
 		site.publishTariffs(greenShareHome, greenShareLoadpoints)
@@ -1011,7 +1113,14 @@ func (site *Site) prepare() {
 	site.publish(keys.BufferStartSoc, site.bufferStartSoc)
 	site.publish(keys.BatteryMode, site.batteryMode)
 	site.publish(keys.BatteryDischargeControl, site.batteryDischargeControl)
+	site.publish(keys.BatteryPreDischarge, site.batteryPreDischarge)
 	site.publish(keys.ResidualPower, site.GetResidualPower())
+	site.publish(keys.ExportLimit, site.GetExportLimit())
+	site.publish(keys.ExternalExportLimit, site.externalExportLimit)
+	site.publish(keys.ImportLimit, site.GetImportLimit())
+	site.publish(keys.AllocationPolicy, site.GetAllocationPolicy())
+	site.publish(keys.Rollout, site.GetRollout())
+	site.publish(keys.Budget, site.GetBudget())
 	site.publish(keys.SmartCostAvailable, site.isDynamicTariff(api.TariffUsagePlanner))
 	site.publish(keys.SmartFeedInPriorityAvailable, site.isDynamicTariff(api.TariffUsageFeedIn))
 
@@ -1037,6 +1146,7 @@ func (site *Site) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Event)
 
 	// use ch.In for writing
 	site.uiChan = ch.In
+	site.pushChan = pushChan
 
 	// use ch.Out for reading
 	go func() {
@@ -1,6 +1,8 @@
 package site
 
 import (
+	"time"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
 )
@@ -54,6 +56,47 @@ type API interface {
 	GetResidualPower() float64
 	SetResidualPower(float64) error
 
+	// GetExportLimit returns the grid export limit
+	GetExportLimit() *float64
+	// SetExportLimit sets the grid export limit distributed across controllable pv inverters
+	SetExportLimit(limit *float64)
+	// SetExternalExportLimit sets a transient grid export limit imposed by the grid operator, e.g. via HEMS
+	SetExternalExportLimit(limit *float64)
+
+	// GetImportLimit returns the grid import limit
+	GetImportLimit() *float64
+	// SetImportLimit sets the grid import limit enforced by reducing the loadpoints' charge power
+	SetImportLimit(limit *float64)
+	// SetGridPowerOverride temporarily replaces the measured grid power, nil removes the override
+	SetGridPowerOverride(power *float64, timeout time.Duration)
+
+	// GetAllocationPolicy returns the surplus allocation policy
+	GetAllocationPolicy() api.AllocationPolicy
+	// SetAllocationPolicy sets the policy for allocating surplus among loadpoints of same priority
+	SetAllocationPolicy(policy api.AllocationPolicy)
+
+	//
+	// staged rollout
+	//
+
+	// GetRollout returns the active rollout
+	GetRollout() *Rollout
+	// StartRollout applies loadpoint settings to a canary loadpoint before site-wide rollout
+	StartRollout(loadpoint int, settings RolloutSettings, duration time.Duration) error
+	// CancelRollout reverts the canary loadpoint
+	CancelRollout()
+
+	//
+	// monthly budget
+	//
+
+	// GetBudget returns the monthly charging budget
+	GetBudget() Budget
+	// SetBudget sets the monthly charging budget
+	SetBudget(Budget) error
+	// GetBudgetStatus returns the charging progress of the current month
+	GetBudgetStatus() *BudgetStatus
+
 	//
 	// tariffs and costs
 	//
@@ -67,6 +110,8 @@ type API interface {
 
 	GetBatteryDischargeControl() bool
 	SetBatteryDischargeControl(bool) error
+	GetBatteryPreDischarge() bool
+	SetBatteryPreDischarge(bool) error
 
 	//
 	// battery control external
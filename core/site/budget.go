@@ -0,0 +1,42 @@
+package site
+
+import (
+	"time"
+)
+
+// Budget is a monthly charging budget. Zero limits are disabled.
+type Budget struct {
+	Energy  float64 `json:"energy,omitempty"`  // grid energy limit (kWh)
+	Cost    float64 `json:"cost,omitempty"`    // charging cost limit
+	Enforce bool    `json:"enforce,omitempty"` // switch to pv mode once the budget is exhausted
+}
+
+// Configured returns true if any limit is set
+func (b Budget) Configured() bool {
+	return b.Energy > 0 || b.Cost > 0
+}
+
+// Progress returns the used share of the most exhausted limit
+func (b Budget) Progress(gridEnergy, cost float64) float64 {
+	var res float64
+	if b.Energy > 0 {
+		res = max(res, gridEnergy/b.Energy)
+	}
+	if b.Cost > 0 {
+		res = max(res, cost/b.Cost)
+	}
+	return res
+}
+
+// BudgetStatus is the charging progress of the current month
+type BudgetStatus struct {
+	Month               time.Time `json:"month"`               // beginning of the month
+	Energy              float64   `json:"energy"`              // charged energy (kWh)
+	GridEnergy          float64   `json:"gridEnergy"`          // charged grid energy (kWh)
+	Cost                float64   `json:"cost"`                // charging cost
+	ProjectedGridEnergy float64   `json:"projectedGridEnergy"` // grid energy projected for the end of the month (kWh)
+	ProjectedCost       float64   `json:"projectedCost"`       // cost projected for the end of the month
+	Progress            float64   `json:"progress"`            // used share of the budget
+	ProjectedProgress   float64   `json:"projectedProgress"`   // share of the budget projected for the end of the month
+	Exhausted           bool      `json:"exhausted"`
+}
@@ -0,0 +1,47 @@
+package site
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/core/loadpoint"
+)
+
+// Rollout is a staged settings change applied to a canary loadpoint first.
+// After the observation period the canary is compared against the remaining loadpoints
+// and the change is either applied site-wide or reverted.
+type Rollout struct {
+	Loadpoint int              `json:"loadpoint"` // canary loadpoint id (1-based)
+	Settings  RolloutSettings  `json:"settings"`
+	Previous  RolloutSettings  `json:"previous"` // canary settings before the rollout
+	Started   time.Time        `json:"started"`
+	Duration  time.Duration    `json:"duration"`
+	Metrics   []RolloutMetrics `json:"metrics"` // per loadpoint
+}
+
+// RolloutSettings are the loadpoint settings changed by a rollout. Omitted settings remain unchanged.
+type RolloutSettings struct {
+	Thresholds *loadpoint.ThresholdsConfig `json:"thresholds,omitempty"`
+	MinCurrent *float64                    `json:"minCurrent,omitempty"`
+	MaxCurrent *float64                    `json:"maxCurrent,omitempty"`
+	Phases     *int                        `json:"phases,omitempty"`
+	Priority   *int                        `json:"priority,omitempty"`
+}
+
+// Empty returns true if no setting is changed
+func (s RolloutSettings) Empty() bool {
+	return s == RolloutSettings{}
+}
+
+// RolloutMetrics are the charging metrics collected per loadpoint during a rollout
+type RolloutMetrics struct {
+	Charging      time.Duration `json:"charging"`      // accumulated charging time
+	Interruptions int           `json:"interruptions"` // charging stopped while vehicle remained connected
+}
+
+// Rate returns the interruptions per charging hour
+func (m RolloutMetrics) Rate() float64 {
+	if m.Charging <= 0 {
+		return 0
+	}
+	return float64(m.Interruptions) / m.Charging.Hours()
+}
@@ -308,6 +308,29 @@ func (site *Site) SetBatteryDischargeControl(val bool) error {
 	return nil
 }
 
+// GetBatteryPreDischarge returns the battery pre-discharge mode
+func (site *Site) GetBatteryPreDischarge() bool {
+	site.RLock()
+	defer site.RUnlock()
+	return site.batteryPreDischarge
+}
+
+// SetBatteryPreDischarge sets the battery pre-discharge mode (discharge before pv peak if forecast predicts the battery to be full)
+func (site *Site) SetBatteryPreDischarge(val bool) error {
+	site.log.DEBUG.Println("set battery pre-discharge:", val)
+
+	site.Lock()
+	defer site.Unlock()
+
+	if site.batteryPreDischarge != val {
+		site.batteryPreDischarge = val
+		settings.SetBool(keys.BatteryPreDischarge, val)
+		site.publish(keys.BatteryPreDischarge, val)
+	}
+
+	return nil
+}
+
 func (site *Site) GetBatteryGridChargeLimit() *float64 {
 	site.RLock()
 	defer site.RUnlock()
@@ -333,6 +356,131 @@ func (site *Site) SetBatteryGridChargeLimit(val *float64) {
 	}
 }
 
+// GetExportLimit returns the grid export limit
+func (site *Site) GetExportLimit() *float64 {
+	site.RLock()
+	defer site.RUnlock()
+	return site.exportLimit
+}
+
+// SetExportLimit sets the grid export limit
+func (site *Site) SetExportLimit(val *float64) {
+	site.log.DEBUG.Println("set export limit:", printPtr("%.0f", val))
+
+	site.Lock()
+	defer site.Unlock()
+
+	if !ptrValueEqual(site.exportLimit, val) {
+		site.exportLimit = val
+
+		if val == nil {
+			settings.SetString(keys.ExportLimit, "")
+			site.publish(keys.ExportLimit, nil)
+		} else {
+			settings.SetFloat(keys.ExportLimit, *val)
+			site.publish(keys.ExportLimit, *val)
+		}
+	}
+}
+
+// SetExternalExportLimit sets the grid export limit imposed by the grid operator.
+// The limit is not persisted and applies in addition to the configured export limit.
+func (site *Site) SetExternalExportLimit(val *float64) {
+	site.log.DEBUG.Println("set external export limit:", printPtr("%.0f", val))
+
+	site.Lock()
+	defer site.Unlock()
+
+	if !ptrValueEqual(site.externalExportLimit, val) {
+		site.externalExportLimit = val
+		site.publish(keys.ExternalExportLimit, val)
+	}
+}
+
+// SetGridPowerOverride replaces the measured grid power with an externally provided value
+// until the timeout expires. A nil value removes the override.
+func (site *Site) SetGridPowerOverride(power *float64, timeout time.Duration) {
+	site.log.DEBUG.Printf("set grid power override: %s (%v)", printPtr("%.0f", power), timeout)
+
+	site.Lock()
+	defer site.Unlock()
+
+	site.gridPowerOverride = newOverride(power, time.Now().Add(timeout))
+	site.publish(keys.GridPowerOverride, site.gridPowerOverride.activeValue(time.Now()))
+}
+
+// activeGridPowerOverride returns the grid power override unless expired
+func (site *Site) activeGridPowerOverride() *float64 {
+	site.RLock()
+	defer site.RUnlock()
+	return site.gridPowerOverride.activeValue(time.Now())
+}
+
+// effectiveExportLimit returns the stricter of configured and external export limit
+func (site *Site) effectiveExportLimit() *float64 {
+	site.RLock()
+	defer site.RUnlock()
+
+	switch {
+	case site.exportLimit == nil:
+		return site.externalExportLimit
+	case site.externalExportLimit == nil:
+		return site.exportLimit
+	default:
+		return lo.ToPtr(min(*site.exportLimit, *site.externalExportLimit))
+	}
+}
+
+// GetImportLimit returns the grid import limit
+func (site *Site) GetImportLimit() *float64 {
+	site.RLock()
+	defer site.RUnlock()
+	return site.importLimit
+}
+
+// SetImportLimit sets the grid import limit
+func (site *Site) SetImportLimit(val *float64) {
+	site.log.DEBUG.Println("set import limit:", printPtr("%.0f", val))
+
+	site.Lock()
+	defer site.Unlock()
+
+	if !ptrValueEqual(site.importLimit, val) {
+		site.importLimit = val
+
+		if val == nil {
+			settings.SetString(keys.ImportLimit, "")
+			site.publish(keys.ImportLimit, nil)
+		} else {
+			settings.SetFloat(keys.ImportLimit, *val)
+			site.publish(keys.ImportLimit, *val)
+		}
+	}
+}
+
+// GetAllocationPolicy returns the surplus allocation policy
+func (site *Site) GetAllocationPolicy() api.AllocationPolicy {
+	site.RLock()
+	defer site.RUnlock()
+	return site.allocationPolicy
+}
+
+// SetAllocationPolicy sets the surplus allocation policy
+func (site *Site) SetAllocationPolicy(policy api.AllocationPolicy) {
+	site.log.DEBUG.Println("set allocation policy:", policy)
+
+	site.Lock()
+	defer site.Unlock()
+
+	if site.allocationPolicy != policy {
+		site.allocationPolicy = policy
+		site.prioritizer.SetPolicy(policy)
+
+		settings.SetString(keys.AllocationPolicy, policy.String())
+		site.publish(keys.AllocationPolicy, policy)
+	}
+}
+
 // GetBatteryMode returns the battery mode
 func (site *Site) GetBatteryMode() api.BatteryMode {
 	site.RLock()
@@ -51,6 +51,13 @@ func (site *Site) updateBatteryMode(batteryGridChargeActive bool, rate api.Rate)
 	}
 
 	// NOTE: applyBatteryMode is always called when charge mode is active to validate max soc
+	if site.dryRun {
+		if batteryMode != api.BatteryUnknown {
+			site.log.INFO.Println("battery mode (dry-run):", batteryMode)
+		}
+		return
+	}
+
 	if modeChanged := batteryMode != api.BatteryUnknown; modeChanged || site.batteryMode == api.BatteryCharge {
 		if err := site.applyBatteryMode(batteryMode); err == nil {
 			if modeChanged {
@@ -92,6 +99,9 @@ func (site *Site) requiredBatteryMode(batteryGridChargeActive bool, rate api.Rat
 		}
 	case batteryGridChargeActive:
 		res = keepUnlessModified(api.BatteryCharge)
+	case site.batteryPreDischargeActive:
+		// battery will be full before the pv peak anyway
+		res = keepUnlessModified(api.BatteryDischarge)
 	case site.dischargeControlActive(rate):
 		res = keepUnlessModified(api.BatteryHold)
 	case batteryModeModified(batMode):
@@ -160,7 +170,15 @@ func (site *Site) applyBatteryMode(mode api.BatteryMode) error {
 		}
 
 		if mode != api.BatteryUnknown {
-			if err := batCtrl.SetBatteryMode(mode); err != nil && !errors.Is(err, api.ErrNotAvailable) {
+			err := batCtrl.SetBatteryMode(mode)
+
+			// release batteries without forced discharge to normal operation
+			if err != nil && mode == api.BatteryDischarge {
+				site.log.DEBUG.Printf("battery %s: discharge not supported: %v", deviceTitleOrName(dev), err)
+				err = batCtrl.SetBatteryMode(api.BatteryNormal)
+			}
+
+			if err != nil && !errors.Is(err, api.ErrNotAvailable) {
 				return err
 			}
 		}
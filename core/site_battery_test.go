@@ -222,3 +222,52 @@ func TestForcedBatteryChargeLimits(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+func TestPreDischargeBatteryMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var bat api.Meter
+	batCon := api.NewMockBatteryController(ctrl)
+
+	bat = &struct {
+		api.Meter
+		api.BatteryController
+	}{
+		BatteryController: batCon,
+	}
+
+	site := &Site{
+		log:                       util.NewLogger("foo"),
+		batteryMeters:             []config.Device[api.Meter]{config.NewStaticDevice(config.Named{}, bat)},
+		batteryMode:               api.BatteryHold,
+		batteryPreDischargeActive: true,
+	}
+
+	// discharge not supported, battery released to normal operation
+	gomock.InOrder(
+		batCon.EXPECT().SetBatteryMode(api.BatteryDischarge).Return(api.ErrNotAvailable),
+		batCon.EXPECT().SetBatteryMode(api.BatteryNormal),
+	)
+	site.updateBatteryMode(false, api.Rate{})
+	assert.Equal(t, api.BatteryDischarge, site.batteryMode)
+
+	// discharge applied only once
+	site.updateBatteryMode(false, api.Rate{})
+
+	// grid charging takes precedence
+	batCon.EXPECT().SetBatteryMode(api.BatteryCharge)
+	site.updateBatteryMode(true, api.Rate{})
+	assert.Equal(t, api.BatteryCharge, site.batteryMode)
+
+	// back to normal after pre-discharge
+	site.batteryPreDischargeActive = false
+	batCon.EXPECT().SetBatteryMode(api.BatteryNormal)
+	site.updateBatteryMode(false, api.Rate{})
+	assert.Equal(t, api.BatteryNormal, site.batteryMode)
+
+	// dry-run
+	site.batteryPreDischargeActive = true
+	site.dryRun = true
+	site.updateBatteryMode(false, api.Rate{})
+	assert.Equal(t, api.BatteryNormal, site.batteryMode)
+}
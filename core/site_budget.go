@@ -0,0 +1,236 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/jinzhu/now"
+)
+
+const (
+	budgetInterval = 5 * time.Minute // budget status update interval
+
+	evBudgetWarning   = "budgetwarning"   // projected to exceed the monthly budget
+	evBudgetExhausted = "budgetexhausted" // monthly budget exhausted
+)
+
+// budgetUsage returns the charged total and grid energy and cost of the sessions started since the given time
+var budgetUsage = func(from time.Time) (energy, gridEnergy, cost float64, err error) {
+	if db.Instance == nil {
+		return 0, 0, 0, errors.New("database not available")
+	}
+
+	var res struct {
+		Energy, GridEnergy, Cost float64
+	}
+
+	err = db.Instance.Raw(`
+		SELECT
+			COALESCE(SUM(charged_kwh), 0) AS energy,
+			COALESCE(SUM(charged_kwh * (100 - COALESCE(solar_percentage, 0)) / 100), 0) AS grid_energy,
+			COALESCE(SUM(price), 0) AS cost
+		FROM sessions
+		WHERE created >= ?
+		AND charged_kwh > 0`, from).Scan(&res).Error
+
+	return res.Energy, res.GridEnergy, res.Cost, err
+}
+
+// budgetStatus calculates the budget progress and linearly projects the usage to the end of the month
+func budgetStatus(b site.Budget, ts time.Time, energy, gridEnergy, cost float64) site.BudgetStatus {
+	month := now.With(ts).BeginningOfMonth()
+	total := month.AddDate(0, 1, 0).Sub(month)
+
+	project := func(val float64) float64 {
+		elapsed := ts.Sub(month)
+		if elapsed < time.Hour {
+			return val
+		}
+		return val * float64(total) / float64(elapsed)
+	}
+
+	res := site.BudgetStatus{
+		Month:               month,
+		Energy:              energy,
+		GridEnergy:          gridEnergy,
+		Cost:                cost,
+		ProjectedGridEnergy: project(gridEnergy),
+		ProjectedCost:       project(cost),
+	}
+
+	res.Progress = b.Progress(res.GridEnergy, res.Cost)
+	res.ProjectedProgress = b.Progress(res.ProjectedGridEnergy, res.ProjectedCost)
+	res.Exhausted = b.Configured() && res.Progress >= 1
+
+	return res
+}
+
+// restoreBudget restores the persisted budget and its last status
+func restoreBudget() (site.Budget, *site.BudgetStatus, error) {
+	var res site.Budget
+	if err := settings.Json(keys.Budget, &res); err != nil {
+		return res, nil, err
+	}
+
+	var status site.BudgetStatus
+	if err := settings.Json(keys.BudgetStatus, &status); err != nil {
+		return res, nil, nil
+	}
+
+	return res, &status, nil
+}
+
+// GetBudget returns the monthly charging budget
+func (site *Site) GetBudget() site.Budget {
+	site.RLock()
+	defer site.RUnlock()
+	return site.budget
+}
+
+// SetBudget sets the monthly charging budget
+func (site *Site) SetBudget(b site.Budget) error {
+	if b.Energy < 0 || b.Cost < 0 {
+		return errors.New("invalid budget")
+	}
+
+	site.log.DEBUG.Printf("set budget: %.1fkWh, cost %.2f, enforce %v", b.Energy, b.Cost, b.Enforce)
+
+	site.Lock()
+	defer site.Unlock()
+
+	site.budget = b
+
+	if !b.Configured() {
+		site.budgetStatus = nil
+		settings.SetString(keys.Budget, "")
+		settings.SetString(keys.BudgetStatus, "")
+		site.publish(keys.BudgetStatus, nil)
+	} else if err := settings.SetJson(keys.Budget, b); err != nil {
+		return err
+	}
+
+	site.publish(keys.Budget, b)
+
+	// recalculate on next update
+	site.budgetUpdated = time.Time{}
+
+	return nil
+}
+
+// GetBudgetStatus returns the charging progress of the current month
+func (site *Site) GetBudgetStatus() *site.BudgetStatus {
+	site.RLock()
+	defer site.RUnlock()
+
+	if site.budgetStatus == nil {
+		return nil
+	}
+
+	res := *site.budgetStatus
+	return &res
+}
+
+// updateBudget tracks the monthly charging progress, notifies when the budget is
+// projected to be exceeded or exhausted and optionally switches loadpoints to pv mode.
+// The site lock is only held for reading and updating the budget status.
+func (site *Site) updateBudget() {
+	budget, ok := site.budgetDue()
+	if !ok {
+		return
+	}
+
+	ts := time.Now()
+
+	energy, gridEnergy, cost, err := budgetUsage(now.With(ts).BeginningOfMonth())
+	if err != nil {
+		site.log.ERROR.Println("budget:", err)
+		return
+	}
+
+	status := budgetStatus(budget, ts, energy, gridEnergy, cost)
+
+	prev, ok := site.swapBudgetStatus(status)
+	if !ok {
+		return
+	}
+
+	// publish before notifying for use in message templates
+	site.publish(keys.BudgetStatus, status)
+
+	// persist for notifying and enforcing only once per month across restarts
+	if err := settings.SetJson(keys.BudgetStatus, status); err != nil {
+		site.log.ERROR.Println("budget:", err)
+	}
+
+	if status.ProjectedProgress >= 1 && !status.Exhausted && (prev == nil || prev.ProjectedProgress < 1) {
+		site.log.WARN.Printf("budget: projected to be exceeded (%.0f%%)", 100*status.ProjectedProgress)
+		site.pushEvent(evBudgetWarning)
+	}
+
+	if status.Exhausted && (prev == nil || !prev.Exhausted) {
+		site.log.WARN.Println("budget: exhausted")
+		site.pushEvent(evBudgetExhausted)
+
+		if budget.Enforce {
+			site.enforceBudget()
+		}
+	}
+}
+
+// budgetDue returns the budget if its status is due for update
+func (site *Site) budgetDue() (site.Budget, bool) {
+	site.Lock()
+	defer site.Unlock()
+
+	if !site.budget.Configured() || time.Since(site.budgetUpdated) < budgetInterval {
+		return site.budget, false
+	}
+
+	site.budgetUpdated = time.Now()
+
+	return site.budget, true
+}
+
+// swapBudgetStatus stores the budget status and returns the previous status of the same month.
+// It returns false if the budget has been removed meanwhile.
+func (site *Site) swapBudgetStatus(status site.BudgetStatus) (*site.BudgetStatus, bool) {
+	site.Lock()
+	defer site.Unlock()
+
+	if !site.budget.Configured() {
+		return nil, false
+	}
+
+	// reset notifications on new month
+	prev := site.budgetStatus
+	if prev != nil && !prev.Month.Equal(status.Month) {
+		prev = nil
+	}
+
+	site.budgetStatus = &status
+
+	return prev, true
+}
+
+// enforceBudget switches grid charging loadpoints to pv mode. Users may switch back.
+func (site *Site) enforceBudget() {
+	for i, lp := range site.loadpoints {
+		if mode := lp.GetMode(); mode == api.ModeNow || mode == api.ModeMinPV {
+			site.log.INFO.Printf("budget: switching loadpoint %d from %s to pv mode", i+1, mode)
+			lp.SetMode(api.ModePV)
+		}
+	}
+}
+
+// pushEvent sends a site notification
+func (site *Site) pushEvent(event string) {
+	if site.pushChan != nil {
+		site.pushChan <- push.Event{Event: event}
+	}
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetStatus(t *testing.T) {
+	// 10 of 30 days elapsed
+	ts := time.Date(2026, 6, 11, 0, 0, 0, 0, time.Local)
+
+	for _, tc := range []struct {
+		title               string
+		budget              site.Budget
+		gridEnergy, cost    float64
+		progress, projected float64
+		warning, exhausted  bool
+	}{
+		{"no budget", site.Budget{}, 10, 5, 0, 0, false, false},
+		{"energy", site.Budget{Energy: 100}, 20, 5, 0.2, 0.6, false, false},
+		{"energy projected", site.Budget{Energy: 100}, 40, 5, 0.4, 1.2, true, false},
+		{"cost dominates", site.Budget{Energy: 100, Cost: 10}, 20, 5, 0.5, 1.5, true, false},
+		{"exhausted", site.Budget{Cost: 10}, 0, 10, 1, 3, true, true},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			res := budgetStatus(tc.budget, ts, tc.gridEnergy, tc.gridEnergy, tc.cost)
+
+			assert.Equal(t, time.Date(2026, 6, 1, 0, 0, 0, 0, time.Local), res.Month)
+			assert.InDelta(t, 3*tc.gridEnergy, res.ProjectedGridEnergy, 1e-6)
+			assert.InDelta(t, tc.progress, res.Progress, 1e-6)
+			assert.InDelta(t, tc.projected, res.ProjectedProgress, 1e-6)
+			assert.Equal(t, tc.warning, res.ProjectedProgress >= 1)
+			assert.Equal(t, tc.exhausted, res.Exhausted)
+		})
+	}
+}
+
+func TestUpdateBudgetNotifies(t *testing.T) {
+	defer func(f func(time.Time) (float64, float64, float64, error)) { budgetUsage = f }(budgetUsage)
+
+	var gridEnergy float64
+	budgetUsage = func(time.Time) (float64, float64, float64, error) {
+		return gridEnergy, gridEnergy, 0, nil
+	}
+
+	pushChan := make(chan push.Event, 2)
+	s := &Site{log: util.NewLogger("foo"), pushChan: pushChan, budget: site.Budget{Energy: 100}}
+
+	update := func() {
+		s.budgetUpdated = time.Time{}
+		s.updateBudget()
+	}
+
+	update()
+	require.NotNil(t, s.GetBudgetStatus())
+	assert.Empty(t, pushChan)
+
+	gridEnergy = 100
+	update()
+	assert.Equal(t, evBudgetExhausted, (<-pushChan).Event)
+
+	// notify once only
+	update()
+	assert.Empty(t, pushChan)
+}
+
+func TestUpdateBudgetUnlocked(t *testing.T) {
+	defer func(f func(time.Time) (float64, float64, float64, error)) { budgetUsage = f }(budgetUsage)
+
+	budgetUsage = func(time.Time) (float64, float64, float64, error) {
+		return 100, 100, 0, nil
+	}
+
+	// unbuffered channels block until received
+	uiChan := make(chan util.Param)
+	pushChan := make(chan push.Event)
+	s := &Site{log: util.NewLogger("foo"), uiChan: uiChan, pushChan: pushChan, budget: site.Budget{Energy: 100}}
+
+	go s.updateBudget()
+
+	assert.Equal(t, keys.BudgetStatus, (<-uiChan).Key)
+
+	// site remains accessible while publishing and notifying
+	assert.True(t, s.GetBudgetStatus().Exhausted)
+	assert.Equal(t, evBudgetExhausted, (<-pushChan).Event)
+}
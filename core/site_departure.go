@@ -0,0 +1,56 @@
+package core
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/core/session"
+	"github.com/evcc-io/evcc/server/db"
+)
+
+const (
+	departureInterval = time.Hour // departure detection interval
+	departureHistory  = 8         // weeks of sessions considered for departure detection
+)
+
+// departureSessions returns the vehicle's sessions started since the given time
+var departureSessions = func(vehicle string, from time.Time) (session.Sessions, error) {
+	if db.Instance == nil {
+		return nil, errors.New("database not available")
+	}
+
+	var res session.Sessions
+	err := db.Instance.Where("vehicle = ? AND created >= ?", vehicle, from).Find(&res).Error
+
+	return res, err
+}
+
+// updateDepartures detects the typical departure times of vehicles with enabled departure detection
+func (site *Site) updateDepartures() {
+	if time.Since(site.departuresUpdated) < departureInterval {
+		return
+	}
+	site.departuresUpdated = time.Now()
+
+	for _, v := range site.Vehicles().Settings() {
+		if v.GetDeparture().Mode == "" {
+			continue
+		}
+
+		sessions, err := departureSessions(v.Instance().GetTitle(), time.Now().AddDate(0, 0, -7*departureHistory))
+		if err != nil {
+			site.log.ERROR.Println("departure:", err)
+			return
+		}
+
+		times := sessions.DepartureTimes(time.Local)
+		if slices.Equal(times, v.GetDepartureTimes()) {
+			continue
+		}
+
+		if err := v.SetDepartureTimes(times); err != nil {
+			site.log.ERROR.Println("departure:", err)
+		}
+	}
+}
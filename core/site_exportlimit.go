@@ -0,0 +1,184 @@
+package core
+
+import (
+	"math"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/samber/lo"
+)
+
+const (
+	productionLimitHysteresis = 50  // W, minimum limit change before writing to the inverter
+	productionLimitMinShare   = 0.1 // minimum share of max AC power used for weighting to allow throttled inverters to recover
+)
+
+// productionLimiter is a pv inverter supporting production limitation
+type productionLimiter interface {
+	api.ProductionLimiter
+	api.MaxACPowerGetter
+}
+
+// distributeProductionLimit distributes the allowed total production among inverters proportionally
+// to their current production. Limits are capped at each inverter's max AC power and the remainder
+// is redistributed among the remaining inverters.
+func distributeProductionLimit(total float64, production, maxPower []float64) []float64 {
+	res := make([]float64, len(production))
+
+	weight := func(i int) float64 {
+		return max(production[i], productionLimitMinShare*maxPower[i], 0)
+	}
+
+	remaining := max(total, 0)
+	open := lo.Range(len(production))
+
+	for len(open) > 0 {
+		sum := lo.SumBy(open, weight)
+
+		share := func(i int) float64 {
+			if sum > 0 {
+				return weight(i) / sum
+			}
+			return 1 / float64(len(open))
+		}
+
+		saturated := lo.Filter(open, func(i, _ int) bool {
+			return remaining*share(i) >= maxPower[i]
+		})
+
+		if len(saturated) == 0 {
+			for _, i := range open {
+				res[i] = remaining * share(i)
+			}
+			break
+		}
+
+		for _, i := range saturated {
+			res[i] = maxPower[i]
+			remaining -= maxPower[i]
+		}
+
+		open = lo.Without(open, saturated...)
+	}
+
+	return res
+}
+
+// productionLimiters returns the controllable pv inverters
+func (site *Site) productionLimiters() []config.Device[api.Meter] {
+	return lo.Filter(site.pvMeters, func(dev config.Device[api.Meter], _ int) bool {
+		_, ok := dev.Instance().(productionLimiter)
+		return ok
+	})
+}
+
+// updateExportLimit distributes the site export limit among controllable pv inverters.
+// Inverters failing to accept their limit are treated as uncontrollable and their share
+// is redistributed among the remaining inverters.
+func (site *Site) updateExportLimit() {
+	limiters := site.productionLimiters()
+	if len(limiters) == 0 {
+		return
+	}
+
+	limit := site.effectiveExportLimit()
+	if limit == nil {
+		site.releaseProductionLimits(limiters)
+		return
+	}
+
+	// total production allowed for keeping grid export within limit
+	total := site.pvPower + site.gridPower + *limit
+
+	pvPower := func(dev config.Device[api.Meter]) float64 {
+		if i := lo.IndexOf(site.pvMeters, dev); i >= 0 && i < len(site.pvPowers) {
+			return site.pvPowers[i]
+		}
+		return 0
+	}
+
+	for len(limiters) > 0 {
+		// production of uncontrollable inverters cannot be limited
+		controlled := lo.SumBy(limiters, pvPower)
+		available := total - (site.pvPower - controlled)
+
+		production := lo.Map(limiters, func(dev config.Device[api.Meter], _ int) float64 {
+			return pvPower(dev)
+		})
+		maxPower := lo.Map(limiters, func(dev config.Device[api.Meter], _ int) float64 {
+			return dev.Instance().(productionLimiter).MaxACPower()
+		})
+
+		limits := distributeProductionLimit(available, production, maxPower)
+
+		var failed []config.Device[api.Meter]
+		for i, dev := range limiters {
+			if err := site.setProductionLimit(dev, limits[i], maxPower[i]); err != nil {
+				site.log.ERROR.Printf("pv %s production limit: %v", deviceTitleOrName(dev), err)
+				failed = append(failed, dev)
+			}
+		}
+
+		if len(failed) == 0 {
+			return
+		}
+
+		limiters = lo.Without(limiters, failed...)
+	}
+}
+
+// setProductionLimit writes the production limit if it has changed sufficiently
+func (site *Site) setProductionLimit(dev config.Device[api.Meter], limit, maxPower float64) error {
+	name := dev.Config().Name
+
+	prev, ok := site.productionLimits[name]
+	if !ok {
+		prev = maxPower
+	}
+
+	if math.Abs(limit-prev) < productionLimitHysteresis && !(limit == maxPower && prev != maxPower) {
+		return nil
+	}
+
+	if site.dryRun {
+		site.log.INFO.Printf("pv %s production limit (dry-run): %.0fW", deviceTitleOrName(dev), limit)
+		site.productionLimits[name] = limit
+		return nil
+	}
+
+	site.log.DEBUG.Printf("pv %s production limit: %.0fW", deviceTitleOrName(dev), limit)
+
+	if err := dev.Instance().(productionLimiter).SetProductionLimit(limit); err != nil {
+		// limit has not been applied, including api.ErrNotAvailable
+		delete(site.productionLimits, name)
+		return err
+	}
+
+	site.productionLimits[name] = limit
+
+	return nil
+}
+
+// releaseProductionLimits resets all previously limited inverters to their max AC power
+func (site *Site) releaseProductionLimits(limiters []config.Device[api.Meter]) {
+	for _, dev := range limiters {
+		name := dev.Config().Name
+		if _, ok := site.productionLimits[name]; !ok {
+			continue
+		}
+
+		if site.dryRun {
+			site.log.INFO.Printf("pv %s production limit released (dry-run)", deviceTitleOrName(dev))
+			delete(site.productionLimits, name)
+			continue
+		}
+
+		maxPower := dev.Instance().(productionLimiter).MaxACPower()
+		if err := dev.Instance().(productionLimiter).SetProductionLimit(maxPower); err != nil {
+			site.log.ERROR.Printf("pv %s production limit: %v", deviceTitleOrName(dev), err)
+			continue
+		}
+
+		delete(site.productionLimits, name)
+	}
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeProductionLimit(t *testing.T) {
+	for _, tc := range []struct {
+		title                string
+		total                float64
+		production, maxPower []float64
+		expected             []float64
+	}{
+		{"proportional", 6000, []float64{4000, 2000}, []float64{10000, 10000}, []float64{4000, 2000}},
+		{"proportional reduced", 3000, []float64{4000, 2000}, []float64{10000, 10000}, []float64{2000, 1000}},
+		{"saturated", 9000, []float64{4000, 2000}, []float64{5000, 10000}, []float64{5000, 4000}},
+		{"all saturated", 20000, []float64{4000, 2000}, []float64{5000, 5000}, []float64{5000, 5000}},
+		{"no production", 2000, []float64{0, 0}, []float64{5000, 5000}, []float64{1000, 1000}},
+		{"minimum share", 1200, []float64{1000, 0}, []float64{5000, 5000}, []float64{800, 400}},
+		{"negative", -1000, []float64{4000, 2000}, []float64{5000, 5000}, []float64{0, 0}},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			res := distributeProductionLimit(tc.total, tc.production, tc.maxPower)
+			assert.InDeltaSlice(t, tc.expected, res, 1e-6)
+		})
+	}
+}
+
+type productionLimiterMeter struct {
+	maxPower float64
+	limit    *float64
+	err      error
+}
+
+func (m *productionLimiterMeter) CurrentPower() (float64, error) {
+	return 0, nil
+}
+
+func (m *productionLimiterMeter) MaxACPower() float64 {
+	return m.maxPower
+}
+
+func (m *productionLimiterMeter) SetProductionLimit(limit float64) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.limit = &limit
+	return nil
+}
+
+func TestUpdateExportLimit(t *testing.T) {
+	pv1 := &productionLimiterMeter{maxPower: 10000}
+	pv2 := &productionLimiterMeter{maxPower: 10000, err: api.ErrNotAvailable} // limit not applied
+
+	site := &Site{
+		log: util.NewLogger("foo"),
+		pvMeters: []config.Device[api.Meter]{
+			config.NewStaticDevice(config.Named{Name: "pv1"}, api.Meter(pv1)),
+			config.NewStaticDevice(config.Named{Name: "pv2"}, api.Meter(pv2)),
+		},
+		pvPowers:         []float64{4000, 4000},
+		pvPower:          8000,
+		gridPower:        -6000,
+		productionLimits: make(map[string]float64),
+	}
+
+	// no limit
+	site.updateExportLimit()
+	assert.Nil(t, pv1.limit)
+
+	// pv2 not applying the limit, pv1 takes the entire reduction
+	site.exportLimit = lo.ToPtr(2000.0)
+	site.updateExportLimit()
+	assert.Equal(t, 0.0, *pv1.limit)
+	assert.Equal(t, map[string]float64{"pv1": 0}, site.productionLimits)
+
+	// release limit
+	site.exportLimit = nil
+	site.updateExportLimit()
+	assert.Equal(t, 10000.0, *pv1.limit)
+	assert.Empty(t, site.productionLimits)
+
+	// dry-run
+	pv1.limit = nil
+	site.dryRun = true
+	site.exportLimit = lo.ToPtr(2000.0)
+	site.updateExportLimit()
+	assert.Nil(t, pv1.limit)
+	assert.Equal(t, map[string]float64{"pv1": 2000, "pv2": 2000}, site.productionLimits)
+
+	site.exportLimit = nil
+	site.updateExportLimit()
+	assert.Nil(t, pv1.limit)
+	assert.Empty(t, site.productionLimits)
+}
+
+func TestEffectiveExportLimit(t *testing.T) {
+	site := &Site{log: util.NewLogger("foo")}
+	assert.Nil(t, site.effectiveExportLimit())
+
+	site.externalExportLimit = lo.ToPtr(3000.0)
+	assert.Equal(t, 3000.0, *site.effectiveExportLimit())
+
+	site.exportLimit = lo.ToPtr(5000.0)
+	assert.Equal(t, 3000.0, *site.effectiveExportLimit())
+
+	site.exportLimit = lo.ToPtr(1000.0)
+	assert.Equal(t, 1000.0, *site.effectiveExportLimit())
+
+	site.externalExportLimit = nil
+	assert.Equal(t, 1000.0, *site.effectiveExportLimit())
+}
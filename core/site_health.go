@@ -0,0 +1,35 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/util/modbus"
+)
+
+// unhealthyConnections returns the addresses of unresponsive device connections
+var unhealthyConnections = modbus.Unhealthy
+
+// activeEndpoints returns the active uris of device connections with failover uris
+var activeEndpoints = modbus.ActiveEndpoints
+
+// updateConnectionHealth flags unresponsive device connections before the loadpoints are updated
+func (site *Site) updateConnectionHealth() {
+	unhealthy := unhealthyConnections()
+
+	for _, addr := range unhealthy {
+		if !slices.Contains(site.unhealthy, addr) {
+			site.log.WARN.Printf("connection %s not responding", addr)
+		}
+	}
+
+	for _, addr := range site.unhealthy {
+		if !slices.Contains(unhealthy, addr) {
+			site.log.INFO.Printf("connection %s responding again", addr)
+		}
+	}
+
+	site.unhealthy = unhealthy
+	site.publish(keys.UnhealthyConnections, unhealthy)
+	site.publish(keys.ActiveEndpoints, activeEndpoints())
+}
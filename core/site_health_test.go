@@ -0,0 +1,32 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateConnectionHealth(t *testing.T) {
+	defer func(f func() []string) { unhealthyConnections = f }(unhealthyConnections)
+	defer func(f func() map[string]string) { activeEndpoints = f }(activeEndpoints)
+
+	unhealthy := []string{"192.168.0.1:8899"}
+	unhealthyConnections = func() []string { return unhealthy }
+
+	endpoints := map[string]string{"192.168.0.1:502,192.168.1.1:502": "192.168.1.1:502"}
+	activeEndpoints = func() map[string]string { return endpoints }
+
+	ch := make(chan util.Param, 2)
+	s := &Site{log: util.NewLogger("foo"), uiChan: ch}
+
+	s.updateConnectionHealth()
+	assert.Equal(t, util.Param{Key: "unhealthyConnections", Val: unhealthy}, <-ch)
+	assert.Equal(t, util.Param{Key: "activeEndpoints", Val: endpoints}, <-ch)
+
+	unhealthy = nil
+	s.updateConnectionHealth()
+	assert.Empty(t, (<-ch).Val)
+	assert.Empty(t, s.unhealthy)
+	<-ch
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/samber/lo"
+)
+
+// distributeImportLimit distributes the allowed total charge power among loadpoints.
+// Loadpoints are funded with their min power in order of priority, loadpoints not fitting
+// into the total are stopped. The remainder is distributed among the funded loadpoints
+// proportionally to their current charge power, but at least their min power to allow recovery.
+// The unallocated remainder is returned for loadpoints not taking part in the distribution.
+func distributeImportLimit(total float64, power, minPower []float64, priority []int) ([]float64, float64) {
+	res := make([]float64, len(power))
+	remaining := max(total, 0)
+
+	order := lo.Range(len(power))
+	slices.SortStableFunc(order, func(i, j int) int {
+		return cmp.Compare(priority[j], priority[i])
+	})
+
+	var funded []int
+	for _, i := range order {
+		if remaining >= minPower[i] {
+			res[i] = minPower[i]
+			remaining -= minPower[i]
+			funded = append(funded, i)
+		}
+	}
+
+	if len(funded) == 0 {
+		return res, remaining
+	}
+
+	weight := func(i int) float64 {
+		return max(power[i], minPower[i], 0)
+	}
+
+	sum := lo.SumBy(funded, weight)
+
+	for _, i := range funded {
+		if sum > 0 {
+			res[i] += remaining * weight(i) / sum
+		} else {
+			res[i] += remaining / float64(len(funded))
+		}
+	}
+
+	return res, 0
+}
+
+// updateImportLimit limits the charge power of the loadpoints for keeping grid import within the site import limit
+func (site *Site) updateImportLimit(totalChargePower float64) {
+	limit := site.GetImportLimit()
+	if limit == nil || site.gridMeter == nil {
+		for _, lp := range site.loadpoints {
+			lp.importLimit = nil
+		}
+		return
+	}
+
+	// charge power allowed for keeping grid import within limit
+	total := *limit - (site.gridPower - totalChargePower)
+
+	active := lo.Filter(site.loadpoints, func(lp *Loadpoint, _ int) bool {
+		return lp.GetMode() != api.ModeOff && lp.connected()
+	})
+
+	power := lo.Map(active, func(lp *Loadpoint, _ int) float64 {
+		return lp.GetChargePower()
+	})
+	minPower := lo.Map(active, func(lp *Loadpoint, _ int) float64 {
+		return lp.EffectiveMinPower()
+	})
+	priority := lo.Map(active, func(lp *Loadpoint, _ int) int {
+		return lp.EffectivePriority()
+	})
+
+	limits, remaining := distributeImportLimit(total, power, minPower, priority)
+
+	for _, lp := range site.loadpoints {
+		limit := remaining
+		if i := slices.Index(active, lp); i >= 0 {
+			limit = limits[i]
+		}
+
+		if lp.importLimit == nil || *lp.importLimit != limit {
+			lp.log.DEBUG.Printf("import limit: %.0fW", limit)
+		}
+
+		lp.importLimit = &limit
+	}
+}
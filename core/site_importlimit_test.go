@@ -0,0 +1,31 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeImportLimit(t *testing.T) {
+	for _, tc := range []struct {
+		title           string
+		total           float64
+		power, minPower []float64
+		priority        []int
+		expected        []float64
+		remaining       float64
+	}{
+		{"proportional", 8000, []float64{6000, 3000}, []float64{1000, 1000}, []int{0, 0}, []float64{5000, 3000}, 0},
+		{"recovery", 20000, []float64{1000, 0}, []float64{1000, 1000}, []int{0, 0}, []float64{10000, 10000}, 0},
+		{"priority", 1500, []float64{3000, 3000}, []float64{1000, 1000}, []int{0, 1}, []float64{0, 1500}, 0},
+		{"below min power", 500, []float64{3000}, []float64{1000}, []int{0}, []float64{0}, 500},
+		{"no loadpoints", 3000, nil, nil, nil, []float64{}, 3000},
+		{"negative", -1000, []float64{3000, 3000}, []float64{1000, 1000}, []int{0, 0}, []float64{0, 0}, 0},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			res, remaining := distributeImportLimit(tc.total, tc.power, tc.minPower, tc.priority)
+			assert.InDeltaSlice(t, tc.expected, res, 1e-6)
+			assert.InDelta(t, tc.remaining, remaining, 1e-6)
+		})
+	}
+}
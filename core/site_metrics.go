@@ -0,0 +1,19 @@
+package core
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var updateDurationMetric prometheus.Histogram
+
+func init() {
+	updateDurationMetric = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "evcc",
+		Subsystem: "site",
+		Name:      "update_duration_seconds",
+		Help:      "A histogram of control loop durations",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	})
+
+	prometheus.MustRegister(updateDurationMetric)
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/jinzhu/now"
+)
+
+// preDischargeMargin is the forecasted surplus required relative to the battery's missing energy
+const preDischargeMargin = 1.2
+
+// solarPeak returns the start of the forecasted pv peak on the day of ts.
+// The peak is only valid if the forecast covers declining production afterwards.
+func solarPeak(solar api.Rates, ts time.Time) (time.Time, bool) {
+	eod := now.With(ts).EndOfDay()
+
+	peak := -1
+	var last int
+	for i, r := range solar {
+		if r.End.Before(ts) || r.Start.After(eod) {
+			continue
+		}
+		if peak < 0 || r.Value > solar[peak].Value {
+			peak = i
+		}
+		last = i
+	}
+
+	if peak < 0 || peak == last {
+		return time.Time{}, false
+	}
+
+	return solar[peak].Start, true
+}
+
+// preDischargeRequired checks if the forecasted pv surplus until the pv peak exceeds the energy missing to fill the battery.
+// In that case the battery would be full before the peak anyway and can be discharged into home and vehicle consumption.
+func preDischargeRequired(solar api.Rates, ts time.Time, homePower, soc, capacity float64) bool {
+	peak, ok := solarPeak(solar, ts)
+	if !ok || !ts.Before(peak) || capacity <= 0 {
+		return false
+	}
+
+	surplus := solarEnergy(solar, ts, peak) - homePower*peak.Sub(ts).Hours()
+	missing := capacity * 1e3 * (100 - soc) / 100
+
+	return surplus > 0 && surplus >= preDischargeMargin*missing
+}
+
+// batteryCapacityKnown checks if all batteries report their capacity
+func (site *Site) batteryCapacityKnown() bool {
+	for _, dev := range site.batteryMeters {
+		if _, ok := dev.Instance().(api.BatteryCapacity); !ok {
+			return false
+		}
+	}
+	return site.batteryConfigured()
+}
+
+// updateBatteryPreDischarge determines if the battery should be discharged ahead of the pv peak.
+// While active, the battery is put into discharge mode by the battery mode control.
+func (site *Site) updateBatteryPreDischarge(homePower float64) {
+	var active bool
+
+	if site.GetBatteryPreDischarge() && site.batteryCapacityKnown() {
+		site.RLock()
+		soc, capacity, prioritySoc := site.batterySoc, site.batteryCapacity, site.prioritySoc
+		site.RUnlock()
+
+		solar := tariff.Rates(site.GetTariff(api.TariffUsageSolar))
+		active = soc > prioritySoc && preDischargeRequired(solar, time.Now(), homePower, soc, capacity)
+	}
+
+	site.Lock()
+	if active != site.batteryPreDischargeActive {
+		site.log.DEBUG.Println("battery pre-discharge:", active)
+	}
+	site.batteryPreDischargeActive = active
+	site.Unlock()
+
+	site.publish(keys.BatteryPreDischargeActive, active)
+}
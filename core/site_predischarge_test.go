@@ -0,0 +1,49 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/jinzhu/now"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreDischargeRequired(t *testing.T) {
+	bod := now.BeginningOfDay()
+
+	solar := func(from, to, peak int) api.Rates {
+		var res api.Rates
+		for h := from; h < to; h++ {
+			res = append(res, api.Rate{
+				Start: bod.Add(time.Duration(h) * time.Hour),
+				End:   bod.Add(time.Duration(h+1) * time.Hour),
+				Value: 6e3 - 500*math.Abs(float64(h-peak)),
+			})
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		name          string
+		solar         api.Rates
+		hour          int
+		soc, capacity float64
+		expected      bool
+	}{
+		{"surplus exceeds missing energy", solar(6, 18, 12), 7, 50, 10, true},
+		{"surplus below missing energy", solar(6, 18, 12), 7, 0, 20, false},
+		{"at peak", solar(6, 18, 12), 12, 50, 10, false},
+		{"after early peak", solar(6, 18, 10), 11, 50, 10, false},
+		{"before late peak", solar(6, 18, 14), 12, 50, 10, true},
+		{"incomplete forecast", solar(6, 10, 12), 7, 50, 10, false},
+		{"no forecast", nil, 7, 50, 10, false},
+		{"unknown capacity", solar(6, 18, 12), 7, 50, 0, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := bod.Add(time.Duration(tc.hour) * time.Hour)
+			assert.Equal(t, tc.expected, preDischargeRequired(tc.solar, ts, 500, tc.soc, tc.capacity))
+		})
+	}
+}
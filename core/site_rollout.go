@@ -0,0 +1,364 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/samber/lo"
+)
+
+const (
+	rolloutMinCharging     = time.Hour       // minimum charging time per group before evaluating a rollout
+	rolloutTolerance       = 0.2             // canary may be this much worse than the remaining loadpoints
+	rolloutPersistInterval = 5 * time.Minute // persist accumulated charging time at most this often
+)
+
+func newRollout(id int, settings, previous site.RolloutSettings, duration time.Duration, loadpoints int) *site.Rollout {
+	return &site.Rollout{
+		Loadpoint: id,
+		Settings:  settings,
+		Previous:  previous,
+		Started:   time.Now(),
+		Duration:  duration,
+		Metrics:   make([]site.RolloutMetrics, loadpoints),
+	}
+}
+
+// restoreRollout restores a persisted rollout
+func restoreRollout() (*site.Rollout, error) {
+	var res site.Rollout
+	if err := settings.Json(keys.Rollout, &res); err != nil {
+		return nil, err
+	}
+	if res.Settings.Empty() {
+		return nil, errors.New("empty rollout settings")
+	}
+	return &res, nil
+}
+
+// validateRolloutSettings checks the settings independent of any loadpoint
+func validateRolloutSettings(s site.RolloutSettings) error {
+	var errs []error
+
+	if s.Empty() {
+		errs = append(errs, errors.New("no settings"))
+	}
+
+	if s.MinCurrent != nil && s.MaxCurrent != nil && *s.MinCurrent > *s.MaxCurrent {
+		errs = append(errs, errors.New("min current must be smaller or equal than max current"))
+	}
+
+	if s.Phases != nil && *s.Phases != 0 && *s.Phases != 1 && *s.Phases != 3 {
+		errs = append(errs, fmt.Errorf("invalid number of phases: %d", *s.Phases))
+	}
+
+	if s.Priority != nil && *s.Priority < 0 {
+		errs = append(errs, fmt.Errorf("invalid priority: %d", *s.Priority))
+	}
+
+	return errors.Join(errs...)
+}
+
+// rolloutCapture returns the loadpoint's current values of the settings modified by s
+func rolloutCapture(lp loadpoint.API, s site.RolloutSettings) site.RolloutSettings {
+	var res site.RolloutSettings
+
+	if s.Thresholds != nil {
+		res.Thresholds = lo.ToPtr(lp.GetThresholds())
+	}
+	if s.MinCurrent != nil || s.MaxCurrent != nil {
+		// currents are restored as pair to keep min <= max
+		res.MinCurrent = lo.ToPtr(lp.GetMinCurrent())
+		res.MaxCurrent = lo.ToPtr(lp.GetMaxCurrent())
+	}
+	if s.Phases != nil {
+		res.Phases = lo.ToPtr(lp.GetPhasesConfigured())
+	}
+	if s.Priority != nil {
+		res.Priority = lo.ToPtr(lp.GetPriority())
+	}
+
+	return res
+}
+
+// rolloutApply applies the settings to the loadpoint
+func rolloutApply(lp loadpoint.API, s site.RolloutSettings) error {
+	if s.Thresholds != nil {
+		lp.SetThresholds(*s.Thresholds)
+	}
+
+	if s.MinCurrent != nil || s.MaxCurrent != nil {
+		minCurrent, maxCurrent := lp.GetMinCurrent(), lp.GetMaxCurrent()
+		if s.MinCurrent != nil {
+			minCurrent = *s.MinCurrent
+		}
+		if s.MaxCurrent != nil {
+			maxCurrent = *s.MaxCurrent
+		}
+
+		if minCurrent > maxCurrent {
+			return errors.New("min current must be smaller or equal than max current")
+		}
+
+		// keep min <= max while changing currents
+		if minCurrent > lp.GetMaxCurrent() {
+			if err := lp.SetMaxCurrent(maxCurrent); err != nil {
+				return err
+			}
+			if err := lp.SetMinCurrent(minCurrent); err != nil {
+				return err
+			}
+		} else {
+			if err := lp.SetMinCurrent(minCurrent); err != nil {
+				return err
+			}
+			if err := lp.SetMaxCurrent(maxCurrent); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.Phases != nil {
+		if err := lp.SetPhasesConfigured(*s.Phases); err != nil {
+			return err
+		}
+	}
+
+	if s.Priority != nil {
+		lp.SetPriority(*s.Priority)
+	}
+
+	return nil
+}
+
+// updateRolloutMetrics accumulates charging time and interruptions from the loadpoint status transitions.
+// Returns true if the interruption count changed.
+func updateRolloutMetrics(metrics []site.RolloutMetrics, prev, status []api.ChargeStatus, elapsed time.Duration) bool {
+	if len(prev) != len(status) || len(metrics) != len(status) {
+		return false
+	}
+
+	var changed bool
+	for i, s := range status {
+		if prev[i] == api.StatusC && s == api.StatusC {
+			metrics[i].Charging += elapsed
+		}
+
+		if prev[i] == api.StatusC && s == api.StatusB {
+			metrics[i].Interruptions++
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// rolloutPassed compares the canary's interruption rate against the remaining loadpoints.
+// Returns false for ok if there is not enough charging data for a decision.
+func rolloutPassed(r *site.Rollout) (passed bool, ok bool) {
+	canary := r.Metrics[r.Loadpoint-1]
+
+	var others site.RolloutMetrics
+	for i, m := range r.Metrics {
+		if i != r.Loadpoint-1 {
+			others.Charging += m.Charging
+			others.Interruptions += m.Interruptions
+		}
+	}
+
+	if canary.Charging < rolloutMinCharging || others.Charging < rolloutMinCharging {
+		return false, false
+	}
+
+	return canary.Rate() <= others.Rate()*(1+rolloutTolerance), true
+}
+
+// GetRollout returns the active rollout
+func (site *Site) GetRollout() *site.Rollout {
+	site.RLock()
+	defer site.RUnlock()
+
+	if site.rollout == nil {
+		return nil
+	}
+
+	res := *site.rollout
+	res.Metrics = slices.Clone(res.Metrics)
+
+	return &res
+}
+
+// StartRollout applies the settings to the canary loadpoint for the given observation period
+func (site *Site) StartRollout(id int, settings site.RolloutSettings, duration time.Duration) error {
+	site.rolloutMu.Lock()
+	defer site.rolloutMu.Unlock()
+
+	if err := validateRolloutSettings(settings); err != nil {
+		return err
+	}
+	if len(site.loadpoints) < 2 {
+		return errors.New("rollout requires at least two loadpoints")
+	}
+	if id < 1 || id > len(site.loadpoints) {
+		return fmt.Errorf("invalid loadpoint: %d", id)
+	}
+	if duration <= 0 {
+		return errors.New("invalid duration")
+	}
+	if site.GetRollout() != nil {
+		return errors.New("rollout already active")
+	}
+
+	lp := site.loadpoints[id-1]
+	previous := rolloutCapture(lp, settings)
+
+	if err := rolloutApply(lp, settings); err != nil {
+		if err := rolloutApply(lp, previous); err != nil {
+			site.log.ERROR.Printf("rollout: restoring loadpoint %d: %v", id, err)
+		}
+		return err
+	}
+
+	site.log.INFO.Printf("rollout: starting on loadpoint %d for %v", id, duration)
+
+	site.Lock()
+	defer site.Unlock()
+
+	site.rolloutStatus = nil
+	site.setRollout(newRollout(id, settings, previous, duration, len(site.loadpoints)))
+
+	return nil
+}
+
+// CancelRollout reverts the canary loadpoint and stops the rollout
+func (site *Site) CancelRollout() {
+	site.rolloutMu.Lock()
+	defer site.rolloutMu.Unlock()
+
+	r := site.GetRollout()
+	if r == nil {
+		return
+	}
+
+	site.log.INFO.Printf("rollout: cancelled on loadpoint %d", r.Loadpoint)
+
+	site.revertRollout(r)
+}
+
+// setRollout persists and publishes the rollout. Caller must hold the lock.
+func (site *Site) setRollout(r *site.Rollout) {
+	site.rollout = r
+	site.rolloutPersisted = time.Now()
+
+	if r == nil {
+		settings.SetString(keys.Rollout, "")
+		site.publish(keys.Rollout, nil)
+		return
+	}
+
+	if err := settings.SetJson(keys.Rollout, r); err != nil {
+		site.log.ERROR.Println("rollout:", err)
+	}
+	site.publish(keys.Rollout, *r)
+}
+
+// revertRollout restores the canary's previous settings and clears the rollout.
+// Caller must hold the rollout lock, but not the site lock.
+func (site *Site) revertRollout(r *site.Rollout) {
+	if id := r.Loadpoint; id <= len(site.loadpoints) {
+		if err := rolloutApply(site.loadpoints[id-1], r.Previous); err != nil {
+			site.log.ERROR.Printf("rollout: reverting loadpoint %d: %v", id, err)
+		}
+	}
+
+	site.Lock()
+	defer site.Unlock()
+
+	site.setRollout(nil)
+}
+
+// updateRollout collects loadpoint metrics and completes the rollout after the observation period
+func (site *Site) updateRollout() {
+	site.rolloutMu.Lock()
+	defer site.rolloutMu.Unlock()
+
+	if site.GetRollout() == nil {
+		return
+	}
+
+	status := lo.Map(site.loadpoints, func(lp *Loadpoint, _ int) api.ChargeStatus {
+		return lp.GetStatus()
+	})
+
+	r, done := site.collectRollout(status)
+	if !done {
+		return
+	}
+
+	// loadpoints changed
+	if len(r.Metrics) != len(site.loadpoints) {
+		site.log.WARN.Println("rollout: loadpoints changed, reverting")
+		site.revertRollout(r)
+		return
+	}
+
+	passed, ok := rolloutPassed(r)
+	if !ok {
+		// keep collecting until enough charging data is available
+		return
+	}
+
+	if !passed {
+		site.log.WARN.Printf("rollout: loadpoint %d performed worse than remaining loadpoints, reverting", r.Loadpoint)
+		site.revertRollout(r)
+		return
+	}
+
+	site.log.INFO.Printf("rollout: loadpoint %d passed, applying site-wide", r.Loadpoint)
+
+	for i, lp := range site.loadpoints {
+		if err := rolloutApply(lp, r.Settings); err != nil {
+			site.log.ERROR.Printf("rollout: applying to loadpoint %d: %v", i+1, err)
+		}
+	}
+
+	site.Lock()
+	defer site.Unlock()
+
+	site.setRollout(nil)
+}
+
+// collectRollout updates the rollout metrics from the loadpoint status and persists them on change.
+// Returns a copy of the rollout and whether the observation period is over.
+func (site *Site) collectRollout(status []api.ChargeStatus) (*site.Rollout, bool) {
+	site.Lock()
+	defer site.Unlock()
+
+	r := site.rollout
+	if r == nil {
+		return nil, false
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(site.rolloutUpdated)
+	site.rolloutUpdated = now
+
+	changed := updateRolloutMetrics(r.Metrics, site.rolloutStatus, status, elapsed)
+	site.rolloutStatus = status
+
+	if changed || now.Sub(site.rolloutPersisted) >= rolloutPersistInterval {
+		site.setRollout(r)
+	}
+
+	res := *r
+	res.Metrics = slices.Clone(r.Metrics)
+
+	return &res, len(r.Metrics) != len(status) || now.Sub(r.Started) >= r.Duration
+}
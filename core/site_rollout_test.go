@@ -0,0 +1,101 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRolloutPassed(t *testing.T) {
+	m := func(charging time.Duration, interruptions int) site.RolloutMetrics {
+		return site.RolloutMetrics{Charging: charging, Interruptions: interruptions}
+	}
+
+	for _, tc := range []struct {
+		title          string
+		metrics        []site.RolloutMetrics
+		passed, result bool
+	}{
+		{"insufficient canary data", []site.RolloutMetrics{m(time.Minute, 0), m(10*time.Hour, 5)}, false, false},
+		{"insufficient reference data", []site.RolloutMetrics{m(10*time.Hour, 0), m(time.Minute, 0)}, false, false},
+		{"better", []site.RolloutMetrics{m(10*time.Hour, 2), m(10*time.Hour, 5)}, true, true},
+		{"within tolerance", []site.RolloutMetrics{m(10*time.Hour, 6), m(5*time.Hour, 2), m(5*time.Hour, 3)}, true, true},
+		{"worse", []site.RolloutMetrics{m(10*time.Hour, 10), m(10*time.Hour, 5)}, false, true},
+		{"no interruptions", []site.RolloutMetrics{m(2*time.Hour, 0), m(2*time.Hour, 0)}, true, true},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			passed, ok := rolloutPassed(&site.Rollout{Loadpoint: 1, Metrics: tc.metrics})
+			assert.Equal(t, tc.result, ok)
+			assert.Equal(t, tc.passed, passed)
+		})
+	}
+}
+
+func TestRolloutSettings(t *testing.T) {
+	assert.Error(t, validateRolloutSettings(site.RolloutSettings{}))
+	assert.Error(t, validateRolloutSettings(site.RolloutSettings{MinCurrent: lo.ToPtr(16.0), MaxCurrent: lo.ToPtr(6.0)}))
+	assert.Error(t, validateRolloutSettings(site.RolloutSettings{Phases: lo.ToPtr(2)}))
+	assert.NoError(t, validateRolloutSettings(site.RolloutSettings{MaxCurrent: lo.ToPtr(10.0), Phases: lo.ToPtr(1)}))
+}
+
+func TestRolloutCaptureApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	prev := loadpoint.ThresholdsConfig{Enable: loadpoint.ThresholdConfig{Delay: time.Minute}}
+	next := loadpoint.ThresholdsConfig{Enable: loadpoint.ThresholdConfig{Delay: 2 * time.Minute, Threshold: 100}}
+
+	s := site.RolloutSettings{
+		Thresholds: &next,
+		MinCurrent: lo.ToPtr(20.0),
+		MaxCurrent: lo.ToPtr(32.0),
+		Phases:     lo.ToPtr(1),
+	}
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().GetThresholds().Return(prev)
+	lp.EXPECT().GetMinCurrent().Return(6.0).AnyTimes()
+	lp.EXPECT().GetMaxCurrent().Return(16.0).AnyTimes()
+	lp.EXPECT().GetPhasesConfigured().Return(3)
+
+	// only modified settings are captured, currents as pair
+	res := rolloutCapture(lp, s)
+	assert.Equal(t, site.RolloutSettings{
+		Thresholds: &prev,
+		MinCurrent: lo.ToPtr(6.0),
+		MaxCurrent: lo.ToPtr(16.0),
+		Phases:     lo.ToPtr(3),
+	}, res)
+
+	// max current is raised before min current exceeds previous max
+	gomock.InOrder(
+		lp.EXPECT().SetThresholds(next),
+		lp.EXPECT().SetMaxCurrent(32.0),
+		lp.EXPECT().SetMinCurrent(20.0),
+		lp.EXPECT().SetPhasesConfigured(1).Return(errors.New("phase switching not supported")),
+	)
+
+	require.Error(t, rolloutApply(lp, s))
+}
+
+func TestUpdateRolloutMetrics(t *testing.T) {
+	metrics := make([]site.RolloutMetrics, 2)
+
+	// no previous status
+	assert.False(t, updateRolloutMetrics(metrics, nil, []api.ChargeStatus{api.StatusC, api.StatusC}, time.Minute))
+
+	// charging time only
+	assert.False(t, updateRolloutMetrics(metrics, []api.ChargeStatus{api.StatusC, api.StatusB}, []api.ChargeStatus{api.StatusC, api.StatusC}, time.Minute))
+	assert.Equal(t, []site.RolloutMetrics{{Charging: time.Minute}, {}}, metrics)
+
+	// interruption
+	assert.True(t, updateRolloutMetrics(metrics, []api.ChargeStatus{api.StatusC, api.StatusC}, []api.ChargeStatus{api.StatusC, api.StatusB}, time.Minute))
+	assert.Equal(t, []site.RolloutMetrics{{Charging: 2 * time.Minute}, {Interruptions: 1}}, metrics)
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/evcc-io/evcc/core/journal"
+	"github.com/evcc-io/evcc/core/loadpoint"
+)
+
+// SimulationStep is the outcome of a single simulated control loop iteration
+type SimulationStep struct {
+	Iteration    int
+	GridPower    float64
+	PvPower      float64
+	BatteryPower float64
+	Loadpoint    loadpoint.API
+	Commands     []journal.Entry // charger commands that would have been sent
+}
+
+// Simulate runs count control loop iterations in dry-run mode. Devices are read as usual,
+// but charger commands including vehicle wake-up, §14a dimming and indicators, battery modes
+// and production limits are not sent and sessions are not persisted.
+// Charger configuration is neither checked nor restored since the api server is not running.
+// Like Run, each iteration updates the next loadpoint.
+func (site *Site) Simulate(count int, interval time.Duration, fn func(SimulationStep)) error {
+	if len(site.loadpoints) == 0 {
+		return errors.New("no loadpoints configured")
+	}
+
+	site.dryRun = true
+	for _, lp := range site.loadpoints {
+		lp.setDryRun()
+	}
+
+	for i := range count {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+
+		lp := site.loadpoints[i%len(site.loadpoints)]
+
+		var last int64
+		if entries := lp.GetJournal(); len(entries) > 0 {
+			last = entries[len(entries)-1].ID
+		}
+
+		site.update(lp)
+
+		var commands []journal.Entry
+		for _, e := range lp.GetJournal() {
+			if e.ID > last {
+				commands = append(commands, e)
+			}
+		}
+
+		site.RLock()
+		step := SimulationStep{
+			Iteration:    i + 1,
+			GridPower:    site.gridPower,
+			PvPower:      site.pvPower,
+			BatteryPower: site.batteryPower,
+			Loadpoint:    lp,
+			Commands:     commands,
+		}
+		site.RUnlock()
+
+		fn(step)
+	}
+
+	return nil
+}
@@ -48,6 +48,24 @@ func (site *Site) greenShare(powerFrom float64, powerTo float64) float64 {
 	return share
 }
 
+// greenShares returns the green share of home, all, AC and DC coupled loadpoints.
+// DC coupled chargers draw from the hybrid inverter's DC bus and are hence supplied
+// by pv and battery before any AC consumption.
+func (site *Site) greenShares(homePower, nonChargePower, totalChargePower, dcChargePower float64) (float64, float64, float64, float64) {
+	acChargePower := totalChargePower - dcChargePower
+
+	home := site.greenShare(dcChargePower, dcChargePower+homePower)
+	ac := site.greenShare(dcChargePower+nonChargePower, dcChargePower+nonChargePower+acChargePower)
+	dc := site.greenShare(0, dcChargePower)
+
+	loadpoints := ac
+	if totalChargePower > 0 {
+		loadpoints = (ac*acChargePower + dc*dcChargePower) / totalChargePower
+	}
+
+	return home, loadpoints, ac, dc
+}
+
 // effectivePrice calculates the real energy price based on self-produced and grid-imported energy.
 func (site *Site) effectivePrice(greenShare float64) *float64 {
 	if grid, err := tariff.Now(site.GetTariff(api.TariffUsageGrid)); err == nil {
@@ -127,6 +127,58 @@ func TestGreenShare(t *testing.T) {
 	}
 }
 
+func TestGreenSharesDC(t *testing.T) {
+	tc := []struct {
+		title                                string
+		grid, pv, battery, home, ac, dc      float64
+		homeShare, lpShare, acShare, dcShare float64
+	}{
+		{
+			"no dc charger",
+			1000, 1000, 0, 0, 2000, 0,
+			1, 0.5, 0.5, 1,
+		},
+		{
+			"dc charger supplied by pv first",
+			1000, 5000, 0, 2000, 0, 4000,
+			0.5, 1, 0, 1,
+		},
+		{
+			"dc charger exceeding pv",
+			2000, 2000, 0, 1000, 0, 3000,
+			0, 2.0 / 3, 0, 2.0 / 3,
+		},
+		{
+			"dc and ac charger",
+			0, 2000, 2000, 1000, 1000, 2000,
+			1, 1, 1, 1,
+		},
+		{
+			"dc and ac charger with grid",
+			2000, 3000, 0, 1000, 2000, 2000,
+			1, 0.5, 0, 1,
+		},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.title, func(t *testing.T) {
+			s := &Site{
+				gridPower:    tc.grid,
+				pvPower:      tc.pv,
+				batteryPower: tc.battery,
+			}
+
+			nonChargePower := tc.home + max(0, -tc.battery)
+			home, loadpoints, ac, dc := s.greenShares(tc.home, nonChargePower, tc.ac+tc.dc, tc.dc)
+
+			assert.InDelta(t, tc.homeShare, home, 1e-6, "home")
+			assert.InDelta(t, tc.lpShare, loadpoints, 1e-6, "loadpoints")
+			assert.InDelta(t, tc.acShare, ac, 1e-6, "ac")
+			assert.InDelta(t, tc.dcShare, dc, 1e-6, "dc")
+		})
+	}
+}
+
 func TestRequiredBatteryMode(t *testing.T) {
 	tc := []struct {
 		gridChargeActive bool
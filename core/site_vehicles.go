@@ -31,6 +31,10 @@ type vehicleStruct struct {
 	Features       []string                  `json:"features,omitempty"`
 	Plan           *planStruct               `json:"plan,omitempty"`
 	RepeatingPlans []api.RepeatingPlanStruct `json:"repeatingPlans"`
+	Maintenance    *api.MaintenanceStruct    `json:"maintenance,omitempty"`
+	Departure      *api.DepartureStruct      `json:"departure,omitempty"`
+	DepartureTimes []api.DepartureTimeStruct `json:"departureTimes,omitempty"`
+	Identifiers    []string                  `json:"identifiers,omitempty"`
 }
 
 // publishVehicles returns a list of vehicle titles
@@ -45,6 +49,16 @@ func (site *Site) publishVehicles() {
 			plan = &planStruct{Soc: soc, Precondition: int64(precondition.Seconds()), Time: time}
 		}
 
+		var maintenance *api.MaintenanceStruct
+		if policy := v.GetMaintenance(); policy != (api.MaintenanceStruct{}) {
+			maintenance = &policy
+		}
+
+		var departure *api.DepartureStruct
+		if d := v.GetDeparture(); d.Mode != "" {
+			departure = &d
+		}
+
 		instance := v.Instance()
 		ac := instance.OnIdentified()
 
@@ -61,6 +75,10 @@ func (site *Site) publishVehicles() {
 			Features:       lo.Map(instance.Features(), func(f api.Feature, _ int) string { return f.String() }),
 			Plan:           plan,
 			RepeatingPlans: v.GetRepeatingPlans(),
+			Maintenance:    maintenance,
+			Departure:      departure,
+			DepartureTimes: v.GetDepartureTimes(),
+			Identifiers:    v.GetIdentifiers(),
 		}
 
 		if lp := site.coordinator.Owner(instance); lp != nil {
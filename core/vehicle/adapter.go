@@ -123,6 +123,9 @@ func (v *adapter) SetRepeatingPlans(plans []api.RepeatingPlanStruct) error {
 		if _, err := time.Parse("15:04", plan.Time); err != nil {
 			return fmt.Errorf("invalid time: %v", err)
 		}
+		if plan.Soc < 0 || plan.Soc > 100 {
+			return fmt.Errorf("soc out of range: %d", plan.Soc)
+		}
 	}
 
 	v.log.DEBUG.Printf("update repeating plans for %s to: %v", v.name, plans)
@@ -144,3 +147,97 @@ func (v *adapter) GetRepeatingPlans() []api.RepeatingPlanStruct {
 
 	return []api.RepeatingPlanStruct{}
 }
+
+// GetMaintenance returns the battery maintenance policy
+func (v *adapter) GetMaintenance() api.MaintenanceStruct {
+	var res api.MaintenanceStruct
+	_ = settings.Json(v.key()+keys.Maintenance, &res)
+	return res
+}
+
+// SetMaintenance sets the battery maintenance policy
+func (v *adapter) SetMaintenance(policy api.MaintenanceStruct) error {
+	if policy.MinSoc < 0 || policy.MaxSoc > 100 || policy.MinSoc >= policy.MaxSoc {
+		return fmt.Errorf("invalid soc range: %d-%d", policy.MinSoc, policy.MaxSoc)
+	}
+	if policy.After < 0 {
+		return fmt.Errorf("invalid idle duration: %d", policy.After)
+	}
+
+	v.log.DEBUG.Printf("set %s maintenance: %d-%d%% after %v (active: %t)", v.name, policy.MinSoc, policy.MaxSoc, time.Duration(policy.After)*time.Second, policy.Active)
+
+	if err := settings.SetJson(v.key()+keys.Maintenance, policy); err != nil {
+		return err
+	}
+
+	v.publish()
+
+	return nil
+}
+
+// GetDeparture returns the departure detection settings
+func (v *adapter) GetDeparture() api.DepartureStruct {
+	var res api.DepartureStruct
+	_ = settings.Json(v.key()+keys.Departure, &res)
+	return res
+}
+
+// SetDeparture sets the departure detection settings
+func (v *adapter) SetDeparture(departure api.DepartureStruct) error {
+	switch departure.Mode {
+	case "", api.DepartureModeSuggest, api.DepartureModeAuto:
+	default:
+		return fmt.Errorf("invalid departure mode: %s", departure.Mode)
+	}
+	if departure.Soc < 0 || departure.Soc > 100 || departure.Mode == api.DepartureModeAuto && departure.Soc == 0 {
+		return fmt.Errorf("invalid soc: %d", departure.Soc)
+	}
+
+	v.log.DEBUG.Printf("set %s departure: mode %s, soc %d%%", v.name, departure.Mode, departure.Soc)
+
+	if err := settings.SetJson(v.key()+keys.Departure, departure); err != nil {
+		return err
+	}
+
+	v.publish()
+
+	return nil
+}
+
+// GetDepartureTimes returns the detected departure times
+func (v *adapter) GetDepartureTimes() []api.DepartureTimeStruct {
+	var res []api.DepartureTimeStruct
+	_ = settings.Json(v.key()+keys.DepartureTimes, &res)
+	return res
+}
+
+// SetDepartureTimes stores the detected departure times
+func (v *adapter) SetDepartureTimes(times []api.DepartureTimeStruct) error {
+	if err := settings.SetJson(v.key()+keys.DepartureTimes, times); err != nil {
+		return err
+	}
+
+	v.publish()
+
+	return nil
+}
+
+// GetIdentifiers returns the learned identifiers
+func (v *adapter) GetIdentifiers() []string {
+	var res []string
+	_ = settings.Json(v.key()+keys.Identifiers, &res)
+	return res
+}
+
+// SetIdentifiers stores the learned identifiers
+func (v *adapter) SetIdentifiers(ids []string) error {
+	v.log.DEBUG.Printf("set %s identifiers: %v", v.name, ids)
+
+	if err := settings.SetJson(v.key()+keys.Identifiers, ids); err != nil {
+		return err
+	}
+
+	v.publish()
+
+	return nil
+}
@@ -48,6 +48,25 @@ type API interface {
 	// SetRepeatingPlans stores every repeating plan
 	SetRepeatingPlans([]api.RepeatingPlanStruct) error
 
+	// GetMaintenance returns the battery maintenance policy
+	GetMaintenance() api.MaintenanceStruct
+	// SetMaintenance sets the battery maintenance policy
+	SetMaintenance(api.MaintenanceStruct) error
+
+	// GetDeparture returns the departure detection settings
+	GetDeparture() api.DepartureStruct
+	// SetDeparture sets the departure detection settings
+	SetDeparture(api.DepartureStruct) error
+	// GetDepartureTimes returns the detected departure times
+	GetDepartureTimes() []api.DepartureTimeStruct
+	// SetDepartureTimes stores the detected departure times
+	SetDepartureTimes([]api.DepartureTimeStruct) error
+
+	// GetIdentifiers returns the learned identifiers
+	GetIdentifiers() []string
+	// SetIdentifiers stores the learned identifiers
+	SetIdentifiers([]string) error
+
 	// // GetMinCurrent returns the min charging current
 	// GetMinCurrent() float64
 	// // SetMinCurrent sets the min charging current
@@ -56,3 +56,43 @@ func (v *dummy) SetRepeatingPlans(plans []api.RepeatingPlanStruct) error {
 func (v *dummy) GetRepeatingPlans() []api.RepeatingPlanStruct {
 	return []api.RepeatingPlanStruct{}
 }
+
+// GetMaintenance returns the battery maintenance policy
+func (v *dummy) GetMaintenance() api.MaintenanceStruct {
+	return api.MaintenanceStruct{}
+}
+
+// SetMaintenance sets the battery maintenance policy
+func (v *dummy) SetMaintenance(policy api.MaintenanceStruct) error {
+	return nil
+}
+
+// GetDeparture returns the departure detection settings
+func (v *dummy) GetDeparture() api.DepartureStruct {
+	return api.DepartureStruct{}
+}
+
+// SetDeparture sets the departure detection settings
+func (v *dummy) SetDeparture(departure api.DepartureStruct) error {
+	return nil
+}
+
+// GetDepartureTimes returns the detected departure times
+func (v *dummy) GetDepartureTimes() []api.DepartureTimeStruct {
+	return nil
+}
+
+// SetDepartureTimes stores the detected departure times
+func (v *dummy) SetDepartureTimes(times []api.DepartureTimeStruct) error {
+	return nil
+}
+
+// GetIdentifiers returns the learned identifiers
+func (v *dummy) GetIdentifiers() []string {
+	return nil
+}
+
+// SetIdentifiers stores the learned identifiers
+func (v *dummy) SetIdentifiers(ids []string) error {
+	return nil
+}
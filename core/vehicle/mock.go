@@ -41,6 +41,48 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// GetDeparture mocks base method.
+func (m *MockAPI) GetDeparture() api.DepartureStruct {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeparture")
+	ret0, _ := ret[0].(api.DepartureStruct)
+	return ret0
+}
+
+// GetDeparture indicates an expected call of GetDeparture.
+func (mr *MockAPIMockRecorder) GetDeparture() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeparture", reflect.TypeOf((*MockAPI)(nil).GetDeparture))
+}
+
+// GetDepartureTimes mocks base method.
+func (m *MockAPI) GetDepartureTimes() []api.DepartureTimeStruct {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepartureTimes")
+	ret0, _ := ret[0].([]api.DepartureTimeStruct)
+	return ret0
+}
+
+// GetDepartureTimes indicates an expected call of GetDepartureTimes.
+func (mr *MockAPIMockRecorder) GetDepartureTimes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartureTimes", reflect.TypeOf((*MockAPI)(nil).GetDepartureTimes))
+}
+
+// GetIdentifiers mocks base method.
+func (m *MockAPI) GetIdentifiers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentifiers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetIdentifiers indicates an expected call of GetIdentifiers.
+func (mr *MockAPIMockRecorder) GetIdentifiers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentifiers", reflect.TypeOf((*MockAPI)(nil).GetIdentifiers))
+}
+
 // GetLimitSoc mocks base method.
 func (m *MockAPI) GetLimitSoc() int {
 	m.ctrl.T.Helper()
@@ -55,6 +97,20 @@ func (mr *MockAPIMockRecorder) GetLimitSoc() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimitSoc", reflect.TypeOf((*MockAPI)(nil).GetLimitSoc))
 }
 
+// GetMaintenance mocks base method.
+func (m *MockAPI) GetMaintenance() api.MaintenanceStruct {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenance")
+	ret0, _ := ret[0].(api.MaintenanceStruct)
+	return ret0
+}
+
+// GetMaintenance indicates an expected call of GetMaintenance.
+func (mr *MockAPIMockRecorder) GetMaintenance() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenance", reflect.TypeOf((*MockAPI)(nil).GetMaintenance))
+}
+
 // GetMinSoc mocks base method.
 func (m *MockAPI) GetMinSoc() int {
 	m.ctrl.T.Helper()
@@ -127,6 +183,48 @@ func (mr *MockAPIMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockAPI)(nil).Name))
 }
 
+// SetDeparture mocks base method.
+func (m *MockAPI) SetDeparture(arg0 api.DepartureStruct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeparture", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeparture indicates an expected call of SetDeparture.
+func (mr *MockAPIMockRecorder) SetDeparture(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeparture", reflect.TypeOf((*MockAPI)(nil).SetDeparture), arg0)
+}
+
+// SetDepartureTimes mocks base method.
+func (m *MockAPI) SetDepartureTimes(arg0 []api.DepartureTimeStruct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDepartureTimes", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDepartureTimes indicates an expected call of SetDepartureTimes.
+func (mr *MockAPIMockRecorder) SetDepartureTimes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepartureTimes", reflect.TypeOf((*MockAPI)(nil).SetDepartureTimes), arg0)
+}
+
+// SetIdentifiers mocks base method.
+func (m *MockAPI) SetIdentifiers(arg0 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIdentifiers", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIdentifiers indicates an expected call of SetIdentifiers.
+func (mr *MockAPIMockRecorder) SetIdentifiers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdentifiers", reflect.TypeOf((*MockAPI)(nil).SetIdentifiers), arg0)
+}
+
 // SetLimitSoc mocks base method.
 func (m *MockAPI) SetLimitSoc(soc int) {
 	m.ctrl.T.Helper()
@@ -139,6 +237,20 @@ func (mr *MockAPIMockRecorder) SetLimitSoc(soc any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimitSoc", reflect.TypeOf((*MockAPI)(nil).SetLimitSoc), soc)
 }
 
+// SetMaintenance mocks base method.
+func (m *MockAPI) SetMaintenance(arg0 api.MaintenanceStruct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaintenance indicates an expected call of SetMaintenance.
+func (mr *MockAPIMockRecorder) SetMaintenance(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenance", reflect.TypeOf((*MockAPI)(nil).SetMaintenance), arg0)
+}
+
 // SetMinSoc mocks base method.
 func (m *MockAPI) SetMinSoc(soc int) {
 	m.ctrl.T.Helper()
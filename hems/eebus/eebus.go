@@ -23,13 +23,10 @@ type EEBus struct {
 	uc *eebus.UseCasesCS
 
 	root api.Circuit
+	site site.API
 
-	status        status
-	statusUpdated time.Time
-
-	consumptionLimit *ucapi.LoadLimit // LPC-041
-	failsafeLimit    float64
-	failsafeDuration time.Duration
+	consumption *limiter // LPC
+	production  *limiter // LPP
 
 	heartbeat *util.Value[struct{}]
 	interval  time.Duration
@@ -40,6 +37,10 @@ type Limits struct {
 	ConsumptionLimit                    float64
 	FailsafeConsumptionActivePowerLimit float64
 	FailsafeDurationMinimum             time.Duration
+
+	ContractualProductionNominalMax    float64
+	ProductionLimit                    float64
+	FailsafeProductionActivePowerLimit float64
 }
 
 // NewFromConfig creates an EEBus HEMS from generic config
@@ -80,11 +81,11 @@ func NewFromConfig(ctx context.Context, other map[string]interface{}, site site.
 	}
 	site.SetCircuit(lpc)
 
-	return NewEEBus(ctx, cc.Ski, cc.Limits, lpc, cc.Interval)
+	return NewEEBus(ctx, cc.Ski, cc.Limits, lpc, site, cc.Interval)
 }
 
 // NewEEBus creates EEBus charger
-func NewEEBus(ctx context.Context, ski string, limits Limits, root api.Circuit, interval time.Duration) (*EEBus, error) {
+func NewEEBus(ctx context.Context, ski string, limits Limits, root api.Circuit, site site.API, interval time.Duration) (*EEBus, error) {
 	if eebus.Instance == nil {
 		return nil, errors.New("eebus not configured")
 	}
@@ -92,18 +93,33 @@ func NewEEBus(ctx context.Context, ski string, limits Limits, root api.Circuit,
 	c := &EEBus{
 		log:       util.NewLogger("eebus"),
 		root:      root,
+		site:      site,
 		uc:        eebus.Instance.ControllableSystem(),
 		Connector: eebus.NewConnector(),
 		heartbeat: util.NewValue[struct{}](2 * time.Minute), // LPC-031
 		interval:  interval,
+	}
 
-		consumptionLimit: &ucapi.LoadLimit{
+	c.consumption = &limiter{
+		log: util.NewLogger("lpc"),
+		limit: &ucapi.LoadLimit{ // LPC-041
 			Value:        limits.ConsumptionLimit,
 			IsChangeable: true,
 		},
-
 		failsafeLimit:    limits.FailsafeConsumptionActivePowerLimit,
 		failsafeDuration: limits.FailsafeDurationMinimum,
+		apply:            c.setConsumptionLimit,
+	}
+
+	c.production = &limiter{
+		log: util.NewLogger("lpp"),
+		limit: &ucapi.LoadLimit{
+			Value:        limits.ProductionLimit,
+			IsChangeable: true,
+		},
+		failsafeLimit:    limits.FailsafeProductionActivePowerLimit,
+		failsafeDuration: limits.FailsafeDurationMinimum,
+		apply:            c.setProductionLimit,
 	}
 
 	// simulate a received heartbeat
@@ -134,16 +150,31 @@ func NewEEBus(ctx context.Context, ski string, limits Limits, root api.Circuit,
 	if err := c.uc.LPC.SetConsumptionNominalMax(limits.ContractualConsumptionNominalMax); err != nil {
 		c.log.ERROR.Println("LPC SetConsumptionNominalMax:", err)
 	}
-	if err := c.uc.LPC.SetConsumptionLimit(*c.consumptionLimit); err != nil {
+	if err := c.uc.LPC.SetConsumptionLimit(*c.consumption.limit); err != nil {
 		c.log.ERROR.Println("LPC SetConsumptionLimit:", err)
 	}
-	if err := c.uc.LPC.SetFailsafeConsumptionActivePowerLimit(c.failsafeLimit, true); err != nil {
+	if err := c.uc.LPC.SetFailsafeConsumptionActivePowerLimit(c.consumption.failsafeLimit, true); err != nil {
 		c.log.ERROR.Println("LPC SetFailsafeConsumptionActivePowerLimit:", err)
 	}
-	if err := c.uc.LPC.SetFailsafeDurationMinimum(c.failsafeDuration, true); err != nil {
+	if err := c.uc.LPC.SetFailsafeDurationMinimum(c.consumption.failsafeDuration, true); err != nil {
 		c.log.ERROR.Println("LPC SetFailsafeDurationMinimum:", err)
 	}
 
+	if limits.ContractualProductionNominalMax > 0 {
+		if err := c.uc.LPP.SetProductionNominalMax(limits.ContractualProductionNominalMax); err != nil {
+			c.log.ERROR.Println("LPP SetProductionNominalMax:", err)
+		}
+	}
+	if err := c.uc.LPP.SetProductionLimit(*c.production.limit); err != nil {
+		c.log.ERROR.Println("LPP SetProductionLimit:", err)
+	}
+	if err := c.uc.LPP.SetFailsafeProductionActivePowerLimit(c.production.failsafeLimit, true); err != nil {
+		c.log.ERROR.Println("LPP SetFailsafeProductionActivePowerLimit:", err)
+	}
+	if err := c.uc.LPP.SetFailsafeDurationMinimum(c.production.failsafeDuration, true); err != nil {
+		c.log.ERROR.Println("LPP SetFailsafeDurationMinimum:", err)
+	}
+
 	return c, nil
 }
 
@@ -155,73 +186,29 @@ func (c *EEBus) Run() {
 	}
 }
 
-// TODO check state machine against spec
 func (c *EEBus) run() error {
-	c.mux.RLock()
-	defer c.mux.RUnlock()
+	c.mux.Lock()
+	defer c.mux.Unlock()
 
-	c.log.TRACE.Println("status:", c.status)
-
-	// check heartbeat
 	_, heartbeatErr := c.heartbeat.Get()
-	if heartbeatErr != nil && c.status != StatusFailsafe {
-		// LPC-914/2
-		c.log.WARN.Println("missing heartbeat- entering failsafe mode")
-		c.setStatusAndLimit(StatusFailsafe, c.failsafeLimit)
 
-		return nil
-	}
-
-	// TODO
-	// status init
-	// status Unlimited/controlled
-	// status Unlimited/autonomous
-
-	switch c.status {
-	case StatusUnlimited:
-		// LPC-914/1
-		if c.consumptionLimit != nil && c.consumptionLimit.IsActive {
-			c.log.WARN.Println("active consumption limit")
-			c.setStatusAndLimit(StatusLimited, c.consumptionLimit.Value)
-		}
-
-	case StatusLimited:
-		// limit updated?
-		if !c.consumptionLimit.IsActive {
-			c.log.WARN.Println("inactive consumption limit")
-			c.setStatusAndLimit(StatusUnlimited, 0)
-			break
-		}
-
-		c.setLimit(c.consumptionLimit.Value)
-
-		// LPC-914/1
-		if d := c.consumptionLimit.Duration; d > 0 && time.Since(c.statusUpdated) > d {
-			c.consumptionLimit = nil
-
-			c.log.DEBUG.Println("limit duration exceeded- return to normal")
-			c.setStatusAndLimit(StatusUnlimited, 0)
-		}
-
-	case StatusFailsafe:
-		// LPC-914/2
-		if d := c.failsafeDuration; heartbeatErr == nil && time.Since(c.statusUpdated) > d {
-			c.log.DEBUG.Println("heartbeat returned and failsafe duration exceeded- return to normal")
-			c.setStatusAndLimit(StatusUnlimited, 0)
-		}
-	}
+	c.consumption.run(heartbeatErr)
+	c.production.run(heartbeatErr)
 
 	return nil
 }
 
-func (c *EEBus) setStatusAndLimit(status status, limit float64) {
-	c.status = status
-	c.statusUpdated = time.Now()
-
-	c.setLimit(limit)
+// setConsumptionLimit applies the consumption limit to the root circuit and thereby the loadpoints
+func (c *EEBus) setConsumptionLimit(active bool, limit float64) {
+	c.root.Dim(active && limit > 0)
+	c.root.SetMaxPower(limit)
 }
 
-func (c *EEBus) setLimit(limit float64) {
-	c.root.Dim(limit > 0)
-	c.root.SetMaxPower(limit)
+// setProductionLimit applies the production limit as site export limit, including a 0W limit
+func (c *EEBus) setProductionLimit(active bool, limit float64) {
+	if active {
+		c.site.SetExternalExportLimit(&limit)
+	} else {
+		c.site.SetExternalExportLimit(nil)
+	}
 }
@@ -0,0 +1,83 @@
+package eebus
+
+import (
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// limiter implements the limitation state machine shared by LPC and LPP
+type limiter struct {
+	log *util.Logger
+
+	status        status
+	statusUpdated time.Time
+
+	limit            *ucapi.LoadLimit
+	failsafeLimit    float64
+	failsafeDuration time.Duration
+
+	// apply applies the limit if active, otherwise releases the limit
+	apply func(active bool, limit float64)
+}
+
+// TODO check state machine against spec
+func (l *limiter) run(heartbeatErr error) {
+	l.log.TRACE.Println("status:", l.status)
+
+	// check heartbeat
+	if heartbeatErr != nil && l.status != StatusFailsafe {
+		// LPC-914/2
+		l.log.WARN.Println("missing heartbeat- entering failsafe mode")
+		l.setStatusAndLimit(StatusFailsafe, true, l.failsafeLimit)
+
+		return
+	}
+
+	// TODO
+	// status init
+	// status Unlimited/controlled
+	// status Unlimited/autonomous
+
+	switch l.status {
+	case StatusUnlimited:
+		// LPC-914/1
+		if l.limit != nil && l.limit.IsActive {
+			l.log.WARN.Println("active limit")
+			l.setStatusAndLimit(StatusLimited, true, l.limit.Value)
+		}
+
+	case StatusLimited:
+		// limit updated?
+		if l.limit == nil || !l.limit.IsActive {
+			l.log.WARN.Println("inactive limit")
+			l.setStatusAndLimit(StatusUnlimited, false, 0)
+			break
+		}
+
+		l.apply(true, l.limit.Value)
+
+		// LPC-914/1
+		if d := l.limit.Duration; d > 0 && time.Since(l.statusUpdated) > d {
+			l.limit = nil
+
+			l.log.DEBUG.Println("limit duration exceeded- return to normal")
+			l.setStatusAndLimit(StatusUnlimited, false, 0)
+		}
+
+	case StatusFailsafe:
+		// LPC-914/2
+		if d := l.failsafeDuration; heartbeatErr == nil && time.Since(l.statusUpdated) > d {
+			l.log.DEBUG.Println("heartbeat returned and failsafe duration exceeded- return to normal")
+			l.setStatusAndLimit(StatusUnlimited, false, 0)
+		}
+	}
+}
+
+func (l *limiter) setStatusAndLimit(status status, active bool, limit float64) {
+	l.status = status
+	l.statusUpdated = time.Now()
+
+	l.apply(active, limit)
+}
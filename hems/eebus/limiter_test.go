@@ -0,0 +1,47 @@
+package eebus
+
+import (
+	"errors"
+	"testing"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiterZeroLimit(t *testing.T) {
+	var (
+		active bool
+		limit  = -1.0
+	)
+
+	l := &limiter{
+		log:           util.NewLogger("foo"),
+		limit:         &ucapi.LoadLimit{Value: 0, IsActive: true},
+		failsafeLimit: 1000,
+		apply: func(a bool, v float64) {
+			active, limit = a, v
+		},
+	}
+
+	// 0W limit is applied
+	l.run(nil)
+	assert.Equal(t, StatusLimited, l.status)
+	assert.True(t, active)
+	assert.Equal(t, 0.0, limit)
+
+	l.run(nil)
+	assert.True(t, active)
+
+	// released when inactive
+	l.limit = &ucapi.LoadLimit{Value: 0, IsActive: false}
+	l.run(nil)
+	assert.Equal(t, StatusUnlimited, l.status)
+	assert.False(t, active)
+
+	// failsafe
+	l.run(errors.New("heartbeat"))
+	assert.Equal(t, StatusFailsafe, l.status)
+	assert.True(t, active)
+	assert.Equal(t, 1000.0, limit)
+}
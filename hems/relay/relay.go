@@ -13,6 +13,9 @@ import (
 	"github.com/evcc-io/evcc/util"
 )
 
+// minPower is the power guaranteed by §14a EnWG while dimmed
+const minPower = 4200
+
 type Relay struct {
 	log *util.Logger
 
@@ -20,6 +23,7 @@ type Relay struct {
 	limit    func() (bool, error)
 	maxPower float64
 	interval time.Duration
+	dimmed   bool
 }
 
 // NewFromConfig creates an Relay HEMS from generic config
@@ -29,6 +33,7 @@ func NewFromConfig(ctx context.Context, other map[string]interface{}, site site.
 		Limit    plugin.Config
 		Interval time.Duration
 	}{
+		MaxPower: minPower,
 		Interval: 10 * time.Second,
 	}
 
@@ -65,6 +70,15 @@ func NewFromConfig(ctx context.Context, other map[string]interface{}, site site.
 
 // NewRelay creates Relay HEMS
 func NewRelay(root api.Circuit, limit func() (bool, error), maxPower float64, interval time.Duration) (*Relay, error) {
+	if limit == nil {
+		return nil, errors.New("missing limit")
+	}
+
+	// zero max power would disable the circuit's power validation
+	if maxPower <= 0 {
+		return nil, errors.New("max power must be positive")
+	}
+
 	c := &Relay{
 		log:      util.NewLogger("relay"),
 		root:     root,
@@ -73,6 +87,10 @@ func NewRelay(root api.Circuit, limit func() (bool, error), maxPower float64, in
 		interval: interval,
 	}
 
+	if maxPower < minPower {
+		c.log.WARN.Printf("max power %.0fW below §14a EnWG minimum of %dW", maxPower, minPower)
+	}
+
 	return c, nil
 }
 
@@ -95,6 +113,15 @@ func (c *Relay) run() error {
 		power = c.maxPower
 	}
 
+	if limit != c.dimmed {
+		if limit {
+			c.log.INFO.Printf("dimming active: max power %.0fW", power)
+		} else {
+			c.log.INFO.Println("dimming released")
+		}
+		c.dimmed = limit
+	}
+
 	c.root.Dim(limit)
 	c.root.SetMaxPower(power)
 
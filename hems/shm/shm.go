@@ -44,12 +44,13 @@ type SEMP struct {
 	hostURI string
 	port    int
 	site    site.API
+	control bool
 }
 
 type Config struct {
-	AllowControl_ bool   `json:"allowControl,omitempty"` // deprecated
-	VendorId      string `json:"vendorId"`
-	DeviceId      string `json:"deviceId"`
+	AllowControl bool   `json:"allowControl,omitempty"` // accept SHM control signals in PV modes
+	VendorId     string `json:"vendorId"`
+	DeviceId     string `json:"deviceId"`
 }
 
 // NewFromConfig creates a new SEMP instance from configuration and starts it
@@ -82,11 +83,12 @@ func NewFromConfig(cfg Config, site site.API, addr string, router *mux.Router) e
 	}
 
 	s := &SEMP{
-		log:  util.NewLogger("semp"),
-		site: site,
-		uid:  uid.String(),
-		vid:  vendorId,
-		did:  did,
+		log:     util.NewLogger("semp"),
+		site:    site,
+		uid:     uid.String(),
+		vid:     vendorId,
+		did:     did,
+		control: cfg.AllowControl,
 	}
 
 	// find external port
@@ -374,7 +376,7 @@ func (s *SEMP) deviceStatus(id int, lp loadpoint.API) DeviceStatus {
 
 	res := DeviceStatus{
 		DeviceID:          s.deviceID(id),
-		EMSignalsAccepted: false,
+		EMSignalsAccepted: s.controllable(lp),
 		PowerInfo: PowerInfo{
 			AveragePower:      int(chargePower),
 			AveragingInterval: 60,
@@ -452,15 +454,43 @@ func (s *SEMP) allPlanningRequest() (res []PlanningRequest) {
 	return res
 }
 
+// controllable returns true if the loadpoint accepts SHM control signals
+func (s *SEMP) controllable(lp loadpoint.API) bool {
+	mode := lp.GetMode()
+	return s.control && (mode == api.ModeMinPV || mode == api.ModePV)
+}
+
 func (s *SEMP) deviceControlHandler(w http.ResponseWriter, r *http.Request) {
 	var msg EM2Device
 
-	if err := xml.NewDecoder(r.Body).Decode(&msg); err == nil {
-		s.log.TRACE.Printf("recv: %+v", msg)
-	} else {
-		s.log.ERROR.Printf("recv: %+v", msg)
+	if err := xml.NewDecoder(r.Body).Decode(&msg); err != nil {
+		s.log.ERROR.Printf("recv: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
 	}
 
+	s.log.TRACE.Printf("recv: %+v", msg)
+
 	// ignore control requests
-	w.WriteHeader(http.StatusBadRequest)
+	if !s.control {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, dev := range msg.DeviceControl {
+		for id, lp := range s.site.Loadpoints() {
+			if dev.DeviceID != s.deviceID(id) || !s.controllable(lp) {
+				continue
+			}
+
+			demand := loadpoint.RemoteSoftDisable
+			if dev.On {
+				demand = loadpoint.RemoteEnable
+			}
+
+			lp.RemoteControl(sempController, demand)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
 }
@@ -17,6 +17,9 @@ var (
 
 	//go:embed i18n/*.json
 	i18n embed.FS
+
+	//go:embed schema.json
+	schema embed.FS
 )
 
 // init loads embedded assets unless live assets are already loaded
@@ -33,6 +36,8 @@ func init() {
 		if err != nil {
 			panic(err)
 		}
+
+		assets.Schema = schema
 	}
 }
 
@@ -63,7 +63,7 @@ func NewModbusMbmdFromConfig(ctx context.Context, other map[string]interface{})
 	modbus.Lock()
 	defer modbus.Unlock()
 
-	conn, err := modbus.NewConnection(ctx, cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Settings.Protocol(), cc.ID)
+	conn, err := modbus.NewConnectionWithSettings(ctx, cc.Settings)
 	if err != nil {
 		return nil, err
 	}
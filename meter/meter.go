@@ -2,19 +2,21 @@ package meter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/meter/measurement"
 	"github.com/evcc-io/evcc/plugin"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/diagnostics"
 )
 
 func init() {
 	registry.AddCtx(api.Custom, NewConfigurableFromConfig)
 }
 
-//go:generate go tool decorate -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.BatterySocLimiter,GetSocLimits,func() (float64, float64)" -t "api.BatteryPowerLimiter,GetPowerLimits,func() (float64, float64)" -t "api.BatteryController,SetBatteryMode,func(api.BatteryMode) error" -t "api.MaxACPowerGetter,MaxACPower,func() float64"
+//go:generate go tool decorate -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.BatterySocLimiter,GetSocLimits,func() (float64, float64)" -t "api.BatteryPowerLimiter,GetPowerLimits,func() (float64, float64)" -t "api.BatteryController,SetBatteryMode,func(api.BatteryMode) error" -t "api.MaxACPowerGetter,MaxACPower,func() float64" -t "api.ProductionLimiter,SetProductionLimit,func(float64) error"
 
 // NewConfigurableFromConfig creates api.Meter from config
 func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}) (api.Meter, error) {
@@ -23,7 +25,8 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 		measurement.Phases `mapstructure:",squash"` // optional
 
 		// pv
-		pvMaxACPower `mapstructure:",squash"`
+		pvMaxACPower    `mapstructure:",squash"`
+		ProductionLimit *plugin.Config // optional
 
 		// battery
 		batteryCapacity    `mapstructure:",squash"`
@@ -43,6 +46,9 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 		return nil, err
 	}
 
+	// collect plugin diagnostics
+	ctx, diag := diagnostics.WithCollector(ctx)
+
 	powerG, energyG, err := cc.Energy.Configure(ctx)
 	if err != nil {
 		return nil, err
@@ -83,11 +89,22 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 		}
 	}
 
+	// decorate production limit, requires max ac power
+	if cc.ProductionLimit != nil && cc.MaxACPower == 0 {
+		return nil, errors.New("production limit requires maxacpower")
+	}
+
+	productionLimitS, err := cc.ProductionLimit.FloatSetter(ctx, "productionLimit")
+	if err != nil {
+		return nil, fmt.Errorf("production limit: %w", err)
+	}
+
 	res := m.Decorate(
 		energyG, currentsG, voltagesG, powersG,
 		socG, cc.batteryCapacity.Decorator(), cc.batterySocLimits.Decorator(), cc.batteryPowerLimits.Decorator(), batModeS,
-		cc.pvMaxACPower.Decorator(),
+		cc.pvMaxACPower.Decorator(), productionLimitS,
 	)
+	diag.Register(res)
 
 	return res, nil
 }
@@ -114,11 +131,12 @@ func (m *Meter) Decorate(
 	batterySocLimits, batteryPowerLimits func() (float64, float64),
 	setBatteryMode func(api.BatteryMode) error,
 	maxACPower func() float64,
+	setProductionLimit func(float64) error,
 ) api.Meter {
 	return decorateMeter(m,
 		totalEnergy, currents, voltages, powers,
 		batterySoc, batteryCapacity, batterySocLimits, batteryPowerLimits, setBatteryMode,
-		maxACPower,
+		maxACPower, setProductionLimit,
 	)
 }
 
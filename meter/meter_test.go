@@ -28,3 +28,26 @@ func TestACPower(t *testing.T) {
 	_, ok = m.(api.MaxACPowerGetter)
 	assert.True(t, ok, "api.MaxACPowerGetter")
 }
+
+func TestProductionLimitRequiresMaxACPower(t *testing.T) {
+	other := map[string]any{
+		"power": map[string]any{
+			"source": "const",
+			"value":  1000,
+		},
+		"productionlimit": map[string]any{
+			"source": "js",
+			"script": "0",
+		},
+	}
+
+	_, err := NewConfigurableFromConfig(context.TODO(), other)
+	require.Error(t, err)
+
+	other["maxacpower"] = 5000
+
+	m, err := NewConfigurableFromConfig(context.TODO(), other)
+	require.NoError(t, err)
+	_, ok := m.(api.ProductionLimiter)
+	assert.True(t, ok, "api.ProductionLimiter")
+}
@@ -0,0 +1,37 @@
+package meter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/plugin"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/templates"
+)
+
+func init() {
+	registry.AddCtx("playback", NewMeterPlaybackFromConfig)
+}
+
+// NewMeterPlaybackFromConfig creates the recorded meter serving the recorded plugin values
+func NewMeterPlaybackFromConfig(ctx context.Context, other map[string]interface{}) (api.Meter, error) {
+	var cc struct {
+		Recording string
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	r, err := plugin.LoadRecording(cc.Recording)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Class != templates.Meter.String() {
+		return nil, fmt.Errorf("invalid recording: %s is a %s", r.Device, r.Class)
+	}
+
+	return NewFromConfig(plugin.WithPlayback(ctx, r), r.Type, r.Config)
+}
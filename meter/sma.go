@@ -1,7 +1,6 @@
 package meter
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -71,7 +70,11 @@ func NewSMA(uri, password, iface string, serial uint32, scale float64, capacity
 		}
 
 	default:
-		return nil, errors.New("missing uri or serial")
+		// zero-config: energy meter multicasting its telegrams
+		sm.device, err = discoverer.EnergyMeter(password)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// call UpdateValues first to check if we get an error
@@ -0,0 +1,110 @@
+package meter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/meter/sungrow"
+	"github.com/evcc-io/evcc/util"
+)
+
+// SungrowWiNet meter implementation using the local websocket api of the WiNet-S dongle
+type SungrowWiNet struct {
+	usage string
+	conn  *sungrow.Connection
+}
+
+func init() {
+	registry.Add("sungrow-winet", NewSungrowWiNetFromConfig)
+}
+
+//go:generate go tool decorate -f decorateSungrowWiNet -b *SungrowWiNet -r api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64"
+
+// NewSungrowWiNetFromConfig creates a Sungrow WiNet-S meter from generic config
+func NewSungrowWiNetFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		batteryCapacity `mapstructure:",squash"`
+		URI, Usage      string
+		Cache           time.Duration
+	}{
+		Cache: time.Second,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	return NewSungrowWiNet(cc.URI, cc.Usage, cc.Cache, cc.batteryCapacity.Decorator())
+}
+
+// NewSungrowWiNet creates a Sungrow WiNet-S meter
+func NewSungrowWiNet(uri, usage string, cache time.Duration, capacity func() float64) (api.Meter, error) {
+	switch usage {
+	case "grid", "pv", "battery":
+	default:
+		return nil, fmt.Errorf("invalid usage: %s", usage)
+	}
+
+	conn, err := sungrow.NewConnection(uri, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &SungrowWiNet{
+		usage: usage,
+		conn:  conn,
+	}
+
+	var (
+		totalEnergy     func() (float64, error)
+		batterySoc      func() (float64, error)
+		batteryCapacity func() float64
+	)
+
+	switch usage {
+	case "pv":
+		totalEnergy = m.totalEnergy
+	case "battery":
+		batterySoc = m.batterySoc
+		batteryCapacity = capacity
+	}
+
+	return decorateSungrowWiNet(m, totalEnergy, batterySoc, batteryCapacity), nil
+}
+
+// difference returns the difference of the two values
+func (m *SungrowWiNet) difference(plus, minus string) (float64, error) {
+	p, err := m.conn.Value(plus)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := m.conn.Value(minus)
+	if err != nil {
+		return 0, err
+	}
+
+	return p - n, nil
+}
+
+// CurrentPower implements the api.Meter interface
+func (m *SungrowWiNet) CurrentPower() (float64, error) {
+	switch m.usage {
+	case "grid":
+		return m.difference(sungrow.PurchasedPower, sungrow.FeedNetworkPower)
+	case "pv":
+		return m.conn.Value(sungrow.TotalDCPower)
+	default:
+		return m.difference(sungrow.BatteryDischargePower, sungrow.BatteryChargePower)
+	}
+}
+
+func (m *SungrowWiNet) totalEnergy() (float64, error) {
+	res, err := m.conn.Value(sungrow.TotalYield)
+	return res / 1e3, err
+}
+
+func (m *SungrowWiNet) batterySoc() (float64, error) {
+	return m.conn.Value(sungrow.BatterySoc)
+}
@@ -0,0 +1,111 @@
+package meter
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateSungrowWiNet(base *SungrowWiNet, meterEnergy func() (float64, error), battery func() (float64, error), batteryCapacity func() float64) api.Meter {
+	switch {
+	case battery == nil && meterEnergy == nil:
+		return base
+
+	case battery == nil && meterEnergy != nil:
+		return &struct {
+			*SungrowWiNet
+			api.MeterEnergy
+		}{
+			SungrowWiNet: base,
+			MeterEnergy: &decorateSungrowWiNetMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && meterEnergy == nil:
+		return &struct {
+			*SungrowWiNet
+			api.Battery
+		}{
+			SungrowWiNet: base,
+			Battery: &decorateSungrowWiNetBatteryImpl{
+				battery: battery,
+			},
+		}
+
+	case battery != nil && batteryCapacity == nil && meterEnergy != nil:
+		return &struct {
+			*SungrowWiNet
+			api.Battery
+			api.MeterEnergy
+		}{
+			SungrowWiNet: base,
+			Battery: &decorateSungrowWiNetBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decorateSungrowWiNetMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && batteryCapacity != nil && meterEnergy == nil:
+		return &struct {
+			*SungrowWiNet
+			api.Battery
+			api.BatteryCapacity
+		}{
+			SungrowWiNet: base,
+			Battery: &decorateSungrowWiNetBatteryImpl{
+				battery: battery,
+			},
+			BatteryCapacity: &decorateSungrowWiNetBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+		}
+
+	case battery != nil && batteryCapacity != nil && meterEnergy != nil:
+		return &struct {
+			*SungrowWiNet
+			api.Battery
+			api.BatteryCapacity
+			api.MeterEnergy
+		}{
+			SungrowWiNet: base,
+			Battery: &decorateSungrowWiNetBatteryImpl{
+				battery: battery,
+			},
+			BatteryCapacity: &decorateSungrowWiNetBatteryCapacityImpl{
+				batteryCapacity: batteryCapacity,
+			},
+			MeterEnergy: &decorateSungrowWiNetMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateSungrowWiNetBatteryImpl struct {
+	battery func() (float64, error)
+}
+
+func (impl *decorateSungrowWiNetBatteryImpl) Soc() (float64, error) {
+	return impl.battery()
+}
+
+type decorateSungrowWiNetBatteryCapacityImpl struct {
+	batteryCapacity func() float64
+}
+
+func (impl *decorateSungrowWiNetBatteryCapacityImpl) Capacity() float64 {
+	return impl.batteryCapacity()
+}
+
+type decorateSungrowWiNetMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decorateSungrowWiNetMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
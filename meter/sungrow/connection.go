@@ -0,0 +1,269 @@
+package sungrow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+const lang = "en_us"
+
+var (
+	errTokenExpired = errors.New("token expired")
+	errService      = errors.New("service failed")
+)
+
+// inverter device types reported by the devicelist service
+var inverterTypes = []int{21, 35}
+
+// Connection is the WiNet-S websocket connection.
+// Recent WiNet-S firmware disables Modbus TCP and only offers this local api.
+type Connection struct {
+	mu      sync.Mutex
+	log     *util.Logger
+	uri     string
+	timeout time.Duration
+	conn    *websocket.Conn
+	token   string
+	devID   string
+	valuesG util.Cacheable[map[string]Value]
+}
+
+var (
+	connections = make(map[string]*Connection)
+	mu          sync.Mutex
+)
+
+// NewConnection returns the shared connection to the WiNet-S dongle
+func NewConnection(uri string, cache time.Duration) (*Connection, error) {
+	if uri == "" {
+		return nil, errors.New("missing uri")
+	}
+
+	u, err := url.Parse(util.DefaultScheme(strings.TrimRight(uri, "/"), "ws"))
+	if err != nil {
+		return nil, err
+	}
+
+	// plain websocket api listens on port 8082
+	if u.Port() == "" && u.Scheme == "ws" {
+		u.Host = net.JoinHostPort(u.Hostname(), "8082")
+	}
+	u.Path = "/ws/home/overview"
+	uri = u.String()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if c, ok := connections[uri]; ok {
+		return c, nil
+	}
+
+	c := &Connection{
+		log:     util.NewLogger("sungrow"),
+		uri:     uri,
+		timeout: request.Timeout,
+	}
+
+	c.valuesG = util.ResettableCached(c.values, cache)
+
+	c.mu.Lock()
+	err = c.connect()
+	c.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	connections[uri] = c
+
+	return c, nil
+}
+
+// connect opens the websocket and obtains token and inverter device id
+func (c *Connection) connect() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, c.uri, nil)
+	if err != nil {
+		return err
+	}
+
+	c.conn = conn
+	c.token = ""
+
+	res, err := c.roundtrip(Request{Service: "connect"})
+	if err != nil {
+		c.close()
+		return err
+	}
+
+	c.token = res.Token
+
+	if c.devID == "" {
+		if c.devID, err = c.inverter(); err != nil {
+			c.close()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inverter returns the device id of the inverter
+func (c *Connection) inverter() (string, error) {
+	res, err := c.roundtrip(Request{Service: "devicelist", Type: "0", IsCheckToken: "0"})
+	if err != nil {
+		return "", err
+	}
+
+	var devices []Device
+	if err := json.Unmarshal(res.List, &devices); err != nil {
+		return "", err
+	}
+
+	for _, d := range devices {
+		for _, typ := range inverterTypes {
+			if d.DevType == typ {
+				c.log.DEBUG.Printf("found inverter %s (id %d)", d.DevModel, d.DevID)
+				return strconv.Itoa(d.DevID), nil
+			}
+		}
+	}
+
+	if len(devices) > 0 {
+		return strconv.Itoa(devices[0].DevID), nil
+	}
+
+	return "", errors.New("inverter not found")
+}
+
+func (c *Connection) close() {
+	if c.conn != nil {
+		c.conn.Close(websocket.StatusNormalClosure, "")
+		c.conn = nil
+	}
+}
+
+// roundtrip sends the request and waits for the response of the same service
+func (c *Connection) roundtrip(req Request) (ResultData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	req.Lang = lang
+	req.Token = c.token
+	if req.Service == "real" || req.Service == "real_battery" {
+		req.Time = time.Now().UnixMilli()
+	}
+
+	if err := wsjson.Write(ctx, c.conn, req); err != nil {
+		return ResultData{}, err
+	}
+
+	for {
+		var res Response
+		if err := wsjson.Read(ctx, c.conn, &res); err != nil {
+			return ResultData{}, err
+		}
+
+		if res.ResultData.Service != req.Service {
+			continue
+		}
+
+		switch res.ResultCode {
+		case ResultSuccess:
+			return res.ResultData, nil
+		case ResultTokenExpired:
+			return ResultData{}, errTokenExpired
+		default:
+			return ResultData{}, fmt.Errorf("%w: %s: %s (%d)", errService, req.Service, res.ResultMsg, res.ResultCode)
+		}
+	}
+}
+
+// request executes the request, reconnecting once if the connection was lost or the token has expired
+func (c *Connection) request(req Request) (ResultData, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		req.DevID = c.devID
+
+		res, err := c.roundtrip(req)
+		if err == nil || errors.Is(err, errService) {
+			return res, err
+		}
+
+		c.log.DEBUG.Printf("%s: %v, reconnecting", req.Service, err)
+		c.close()
+	}
+
+	if err := c.connect(); err != nil {
+		return ResultData{}, err
+	}
+
+	req.DevID = c.devID
+
+	res, err := c.roundtrip(req)
+	if err != nil && !errors.Is(err, errService) {
+		c.close()
+	}
+
+	return res, err
+}
+
+// values returns the values of the real and real_battery services by data name
+func (c *Connection) values() (map[string]Value, error) {
+	res := make(map[string]Value)
+
+	for _, service := range []string{"real", "real_battery"} {
+		data, err := c.request(Request{Service: service})
+		if err != nil {
+			// inverters without battery do not support real_battery
+			if service == "real_battery" && errors.Is(err, errService) {
+				c.log.TRACE.Printf("%s: %v", service, err)
+				continue
+			}
+			return nil, err
+		}
+
+		var values []Value
+		if err := json.Unmarshal(data.List, &values); err != nil {
+			return nil, err
+		}
+
+		for _, v := range values {
+			res[v.DataName] = v
+		}
+	}
+
+	return res, nil
+}
+
+// Value returns the value of the data name
+func (c *Connection) Value(name string) (float64, error) {
+	res, err := c.valuesG.Get()
+	if err != nil {
+		return 0, err
+	}
+
+	v, ok := res[name]
+	if !ok {
+		return 0, fmt.Errorf("%s not available", name)
+	}
+
+	return v.Float()
+}
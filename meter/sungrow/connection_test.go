@@ -0,0 +1,86 @@
+package sungrow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func winetServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+
+		ctx := context.Background()
+
+		for {
+			var req Request
+			if err := wsjson.Read(ctx, conn, &req); err != nil {
+				return
+			}
+
+			var res string
+			switch req.Service {
+			case "connect":
+				res = `{"result_code":1,"result_msg":"success","result_data":{"service":"connect","token":"token"}}`
+			case "devicelist":
+				res = `{"result_code":1,"result_msg":"success","result_data":{"service":"devicelist","list":[{"dev_id":2,"dev_type":35,"dev_model":"SH10RT"}]}}`
+			case "real":
+				assert.Equal(t, "token", req.Token)
+				assert.Equal(t, "2", req.DevID)
+				res = `{"result_code":1,"result_msg":"success","result_data":{"service":"real","list":[
+					{"data_name":"I18N_COMMON_TOTAL_DCPOWER","data_value":"3.20","data_unit":"kW"},
+					{"data_name":"I18N_COMMON_TOTAL_YIELD","data_value":"1234.5","data_unit":"kWh"},
+					{"data_name":"I18N_COMMON_FEED_NETWORK_TOTAL_ACTIVE_POWER","data_value":"1.00","data_unit":"kW"},
+					{"data_name":"I18N_CONFIG_KEY_4060","data_value":"--","data_unit":"kW"}
+				]}}`
+			case "real_battery":
+				res = `{"result_code":1,"result_msg":"success","result_data":{"service":"real_battery","list":[
+					{"data_name":"I18N_COMMON_BATTERY_SOC","data_value":"55.0","data_unit":"%"}
+				]}}`
+			}
+
+			// notification of another service is skipped
+			_ = conn.Write(ctx, websocket.MessageText, []byte(`{"result_code":1,"result_data":{"service":"notice"}}`))
+
+			if err := conn.Write(ctx, websocket.MessageText, []byte(res)); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestConnection(t *testing.T) {
+	srv := winetServer(t)
+	defer srv.Close()
+
+	conn, err := NewConnection(strings.Replace(srv.URL, "http://", "ws://", 1), time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "2", conn.devID)
+
+	for name, expected := range map[string]float64{
+		TotalDCPower:     3200,
+		TotalYield:       1234500,
+		FeedNetworkPower: 1000,
+		PurchasedPower:   0,
+		BatterySoc:       55,
+	} {
+		res, err := conn.Value(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, res, name)
+	}
+
+	_, err = conn.Value(BatteryChargePower)
+	assert.Error(t, err)
+}
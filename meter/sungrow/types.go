@@ -0,0 +1,80 @@
+package sungrow
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// result codes
+const (
+	ResultSuccess      = 1
+	ResultTokenExpired = 106
+)
+
+// data names of the real and real_battery services
+const (
+	TotalDCPower          = "I18N_COMMON_TOTAL_DCPOWER"
+	TotalYield            = "I18N_COMMON_TOTAL_YIELD"
+	FeedNetworkPower      = "I18N_COMMON_FEED_NETWORK_TOTAL_ACTIVE_POWER"
+	PurchasedPower        = "I18N_CONFIG_KEY_4060"
+	BatterySoc            = "I18N_COMMON_BATTERY_SOC"
+	BatteryChargePower    = "I18N_CONFIG_KEY_3907"
+	BatteryDischargePower = "I18N_CONFIG_KEY_3921"
+)
+
+type Request struct {
+	Lang         string `json:"lang"`
+	Token        string `json:"token"`
+	Service      string `json:"service"`
+	DevID        string `json:"dev_id,omitempty"`
+	Type         string `json:"type,omitempty"`
+	IsCheckToken string `json:"is_check_token,omitempty"`
+	Time         int64  `json:"time123456,omitempty"`
+}
+
+type Response struct {
+	ResultCode int        `json:"result_code"`
+	ResultMsg  string     `json:"result_msg"`
+	ResultData ResultData `json:"result_data"`
+}
+
+// ResultData contains devices for the devicelist service and values for the real services
+type ResultData struct {
+	Service string          `json:"service"`
+	Token   string          `json:"token"`
+	List    json.RawMessage `json:"list"`
+}
+
+type Device struct {
+	DevID    int    `json:"dev_id"`
+	DevType  int    `json:"dev_type"`
+	DevModel string `json:"dev_model"`
+	DevSN    string `json:"dev_sn"`
+}
+
+type Value struct {
+	DataName  string `json:"data_name"`
+	DataValue string `json:"data_value"`
+	DataUnit  string `json:"data_unit"`
+}
+
+// Float returns the value scaled to W, Wh or %. Values not available are reported as "--".
+func (v Value) Float() (float64, error) {
+	if v.DataValue == "--" || v.DataValue == "" {
+		return 0, nil
+	}
+
+	f, err := strconv.ParseFloat(v.DataValue, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.HasPrefix(v.DataUnit, "k") {
+		f *= 1e3
+	} else if strings.HasPrefix(v.DataUnit, "M") {
+		f *= 1e6
+	}
+
+	return f, nil
+}
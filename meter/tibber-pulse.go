@@ -1,12 +1,14 @@
 package meter
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -44,7 +46,56 @@ func baseVersion(v string) string {
 }
 
 type Tibber struct {
-	data *util.Monitor[tibber.LiveMeasurement]
+	log  *util.Logger
+	data *util.Monitor[tibberMeasurement]
+
+	mu       sync.Mutex
+	id       string                 // subscription id
+	received time.Time              // last message received, including incomplete measurements
+	last     tibberMeasurement      // last valid measurement
+	raw      tibber.LiveMeasurement // fields received so far
+}
+
+// tibberMeasurement is the last complete measurement.
+// Interpolated is set if values missing from the stream have been carried over from the previous measurement.
+type tibberMeasurement struct {
+	Power, PowerProduction          float64
+	CurrentL1, CurrentL2, CurrentL3 float64
+	Interpolated                    bool
+}
+
+// merge updates the measurement with the values received from the stream.
+// Values missing from the stream are carried over, the measurement is flagged as interpolated
+// if these values were present in the previous measurement.
+// Returns false if the live measurement contains no power values, i.e. the Pulse has lost the meter.
+func (m tibberMeasurement) merge(prev, lm tibber.LiveMeasurement) (tibberMeasurement, bool) {
+	if lm.Power == nil && lm.PowerProduction == nil {
+		return m, false
+	}
+
+	res := tibberMeasurement{}
+
+	for _, v := range []struct {
+		dst       *float64
+		src, prev *float64
+		val       float64
+	}{
+		{&res.Power, lm.Power, prev.Power, m.Power},
+		{&res.PowerProduction, lm.PowerProduction, prev.PowerProduction, m.PowerProduction},
+		{&res.CurrentL1, lm.CurrentL1, prev.CurrentL1, m.CurrentL1},
+		{&res.CurrentL2, lm.CurrentL2, prev.CurrentL2, m.CurrentL2},
+		{&res.CurrentL3, lm.CurrentL3, prev.CurrentL3, m.CurrentL3},
+	} {
+		if v.src != nil {
+			*v.dst = *v.src
+			continue
+		}
+
+		*v.dst = v.val
+		res.Interpolated = res.Interpolated || v.prev != nil
+	}
+
+	return res, true
 }
 
 func NewTibberFromConfig(ctx context.Context, other map[string]interface{}) (api.Meter, error) {
@@ -52,8 +103,10 @@ func NewTibberFromConfig(ctx context.Context, other map[string]interface{}) (api
 		Token   string
 		HomeID  string
 		Timeout time.Duration
+		Gap     time.Duration
 	}{
 		Timeout: 2 * time.Minute,
+		Gap:     30 * time.Second,
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -91,7 +144,8 @@ func NewTibberFromConfig(ctx context.Context, other map[string]interface{}) (api
 	}
 
 	t := &Tibber{
-		data: util.NewMonitor[tibber.LiveMeasurement](cc.Timeout),
+		log:  log,
+		data: util.NewMonitor[tibberMeasurement](cc.Timeout),
 	}
 
 	// subscription client
@@ -141,7 +195,7 @@ func NewTibberFromConfig(ctx context.Context, other map[string]interface{}) (api
 
 	done := make(chan error, 1)
 	go func(done chan error) {
-		done <- t.subscribe(client, cc.HomeID, log)
+		done <- t.subscribe(client, cc.HomeID)
 	}(done)
 
 	select {
@@ -210,21 +264,29 @@ func NewTibberFromConfig(ctx context.Context, other map[string]interface{}) (api
 		}
 	}()
 
+	if cc.Gap > 0 {
+		t.mu.Lock()
+		t.received = time.Now()
+		t.mu.Unlock()
+
+		go t.watchdog(ctx, client, cc.HomeID, cc.Gap)
+	}
+
 	log.DEBUG.Printf("!! User-Agent set to %s", getUserAgent())
 
 	return t, nil
 }
 
-func (t *Tibber) subscribe(client *graphql.SubscriptionClient, homeID string, log *util.Logger) error {
+func (t *Tibber) subscribe(client *graphql.SubscriptionClient, homeID string) error {
 	var query struct {
 		tibber.LiveMeasurement `graphql:"liveMeasurement(homeId: $homeId)"`
 	}
 
-	_, err := client.Subscribe(&query, map[string]any{
+	id, err := client.Subscribe(&query, map[string]any{
 		"homeId": graphql.ID(homeID),
 	}, func(data []byte, err error) error {
 		if err != nil {
-			log.ERROR.Printf("Error during subscription: %v", err)
+			t.log.ERROR.Printf("Error during subscription: %v", err)
 			return err
 		}
 
@@ -233,18 +295,91 @@ func (t *Tibber) subscribe(client *graphql.SubscriptionClient, homeID string, lo
 		}
 
 		if err := json.Unmarshal(data, &res); err != nil {
-			log.ERROR.Printf("Error unmarshaling data: %v", err)
+			t.log.ERROR.Printf("Error unmarshaling data: %v", err)
 			return err
 		}
 
-		t.data.Set(res.LiveMeasurement)
+		t.update(res.LiveMeasurement)
 
 		return nil
 	})
 
+	if err == nil {
+		t.mu.Lock()
+		t.id = id
+		t.mu.Unlock()
+	}
+
 	return err
 }
 
+// update merges the live measurement into the current measurement.
+// Measurements without power values are dropped instead of being treated as 0W.
+func (t *Tibber) update(lm tibber.LiveMeasurement) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.received = time.Now()
+
+	res, ok := t.last.merge(t.raw, lm)
+	if !ok {
+		t.log.DEBUG.Println("ignoring measurement without power values")
+		return
+	}
+
+	if res.Interpolated {
+		t.log.DEBUG.Println("incomplete measurement, using previous values")
+	}
+
+	// fields are considered available once received
+	t.last = res
+	t.raw = tibber.LiveMeasurement{
+		Power:           cmp.Or(lm.Power, t.raw.Power),
+		PowerProduction: cmp.Or(lm.PowerProduction, t.raw.PowerProduction),
+		CurrentL1:       cmp.Or(lm.CurrentL1, t.raw.CurrentL1),
+		CurrentL2:       cmp.Or(lm.CurrentL2, t.raw.CurrentL2),
+		CurrentL3:       cmp.Or(lm.CurrentL3, t.raw.CurrentL3),
+	}
+	t.data.Set(res)
+}
+
+// watchdog re-subscribes if the stream has stopped delivering data while the websocket is still connected
+func (t *Tibber) watchdog(ctx context.Context, client *graphql.SubscriptionClient, homeID string, gap time.Duration) {
+	ticker := time.NewTicker(gap / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		t.mu.Lock()
+		id, since := t.id, time.Since(t.received)
+		t.mu.Unlock()
+
+		if since < gap {
+			continue
+		}
+
+		t.log.WARN.Printf("no data received for %v, re-subscribing", since.Round(time.Second))
+
+		if err := client.Unsubscribe(id); err != nil {
+			t.log.DEBUG.Printf("unsubscribe: %v", err)
+		}
+
+		// wait a full gap before re-subscribing again
+		t.mu.Lock()
+		t.received = time.Now()
+		t.mu.Unlock()
+
+		if err := t.subscribe(client, homeID); err != nil {
+			t.log.ERROR.Printf("re-subscribe: %v", err)
+		}
+	}
+}
+
 func (t *Tibber) CurrentPower() (float64, error) {
 	res, err := t.data.Get()
 	if err != nil {
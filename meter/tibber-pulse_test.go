@@ -0,0 +1,54 @@
+package meter
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/meter/tibber"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTibberMerge(t *testing.T) {
+	prev := tibberMeasurement{Power: 1000, CurrentL1: 4}
+	raw := tibber.LiveMeasurement{Power: lo.ToPtr(1000.0), CurrentL1: lo.ToPtr(4.0)}
+
+	tc := []struct {
+		name string
+		lm   tibber.LiveMeasurement
+		res  tibberMeasurement
+		ok   bool
+	}{
+		{
+			"complete",
+			tibber.LiveMeasurement{Power: lo.ToPtr(500.0), CurrentL1: lo.ToPtr(2.0)},
+			tibberMeasurement{Power: 500, CurrentL1: 2},
+			true,
+		},
+		{
+			"missing current",
+			tibber.LiveMeasurement{Power: lo.ToPtr(500.0)},
+			tibberMeasurement{Power: 500, CurrentL1: 4, Interpolated: true},
+			true,
+		},
+		{
+			"production only",
+			tibber.LiveMeasurement{PowerProduction: lo.ToPtr(300.0), CurrentL1: lo.ToPtr(1.0)},
+			tibberMeasurement{Power: 1000, PowerProduction: 300, CurrentL1: 1, Interpolated: true},
+			true,
+		},
+		{
+			"stream drop",
+			tibber.LiveMeasurement{},
+			prev,
+			false,
+		},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := prev.merge(raw, tc.lm)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
@@ -40,13 +40,15 @@ type Price struct {
 	// Level    string
 }
 
+// LiveMeasurement is the Pulse live measurement.
+// Values are null if not provided by the meter or while the Pulse is reconnecting to the HAN port.
 type LiveMeasurement struct {
 	// Timestamp                       time.Time
-	Power                           float64
-	PowerProduction                 float64
-	LastMeterConsumption            float64
-	LastMeterProduction             float64
-	CurrentL1, CurrentL2, CurrentL3 float64
+	Power                           *float64
+	PowerProduction                 *float64
+	LastMeterConsumption            *float64
+	LastMeterProduction             *float64
+	CurrentL1, CurrentL2, CurrentL3 *float64
 	// Currency                        string
 	// AccumulatedConsumption          float64
 	// AccumulatedCost                 float64
@@ -0,0 +1,158 @@
+package meter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/meter/victron"
+	"github.com/evcc-io/evcc/plugin/mqtt"
+	"github.com/evcc-io/evcc/util"
+)
+
+// VictronGX meter implementation using the mqtt broker of Victron GX devices
+type VictronGX struct {
+	usage  string
+	conn   *victron.Connection
+	minSoc float64
+	maxSoc float64
+}
+
+func init() {
+	registry.Add("victron-gx", NewVictronGXFromConfig)
+}
+
+//go:generate go tool decorate -f decorateVictronGX -b *VictronGX -r api.Meter -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.BatteryController,SetBatteryMode,func(api.BatteryMode) error"
+
+// NewVictronGXFromConfig creates a Victron GX meter from generic config
+func NewVictronGXFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		mqtt.Config     `mapstructure:",squash"`
+		batteryCapacity `mapstructure:",squash"`
+		PortalID        string
+		Usage           string
+		MinSoc, MaxSoc  float64
+		Timeout         time.Duration
+	}{
+		MinSoc:  10,
+		MaxSoc:  100,
+		Timeout: 2 * victron.KeepaliveInterval,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	return NewVictronGX(cc.Config, cc.PortalID, cc.Usage, cc.MinSoc, cc.MaxSoc, cc.Timeout, cc.batteryCapacity.Decorator())
+}
+
+// NewVictronGX creates a Victron GX meter
+func NewVictronGX(mqttconf mqtt.Config, portalID, usage string, minSoc, maxSoc float64, timeout time.Duration, capacity func() float64) (api.Meter, error) {
+	conn, err := victron.NewConnection(mqttconf, portalID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &VictronGX{
+		usage:  usage,
+		conn:   conn,
+		minSoc: minSoc,
+		maxSoc: maxSoc,
+	}
+
+	var paths []string
+
+	switch usage {
+	case "grid":
+		for i := 1; i <= 3; i++ {
+			paths = append(paths, fmt.Sprintf(victron.GridPower, i))
+		}
+	case "pv":
+		paths = append(paths, victron.DcPvPower)
+		for i := 1; i <= 3; i++ {
+			paths = append(paths, fmt.Sprintf(victron.PvOnGridPower, i), fmt.Sprintf(victron.PvOnOutputPower, i))
+		}
+	case "battery":
+		paths = append(paths, victron.BatteryPower, victron.BatterySoc)
+	default:
+		return nil, fmt.Errorf("invalid usage: %s", usage)
+	}
+
+	if err := conn.Subscribe(paths...); err != nil {
+		return nil, err
+	}
+
+	if usage == "battery" {
+		return decorateVictronGX(m, m.batterySoc, capacity, m.setBatteryMode), nil
+	}
+
+	return m, nil
+}
+
+// sum returns the sum of the phase values of the path
+func (m *VictronGX) sum(path string) (float64, error) {
+	var res float64
+
+	for i := 1; i <= 3; i++ {
+		v, err := m.conn.Value(fmt.Sprintf(path, i))
+		if err != nil {
+			return 0, err
+		}
+		res += v
+	}
+
+	return res, nil
+}
+
+// CurrentPower implements the api.Meter interface
+func (m *VictronGX) CurrentPower() (float64, error) {
+	switch m.usage {
+	case "grid":
+		return m.sum(victron.GridPower)
+
+	case "pv":
+		res, err := m.conn.Value(victron.DcPvPower)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, path := range []string{victron.PvOnGridPower, victron.PvOnOutputPower} {
+			v, err := m.sum(path)
+			if err != nil {
+				return 0, err
+			}
+			res += v
+		}
+
+		return res, nil
+
+	default:
+		// battery power is positive when charging
+		res, err := m.conn.Value(victron.BatteryPower)
+		return -res, err
+	}
+}
+
+func (m *VictronGX) batterySoc() (float64, error) {
+	return m.conn.Value(victron.BatterySoc)
+}
+
+// setBatteryMode controls the battery using the ESS settings
+func (m *VictronGX) setBatteryMode(mode api.BatteryMode) error {
+	switch mode {
+	case api.BatteryNormal:
+		m.conn.Write(victron.MaxDischargePower, victron.MaxDischargeNoLimit)
+		m.conn.Write(victron.MinimumSocLimit, m.minSoc)
+	case api.BatteryHold:
+		m.conn.Write(victron.MaxDischargePower, 0)
+		m.conn.Write(victron.MinimumSocLimit, m.minSoc)
+	case api.BatteryCharge:
+		// ESS charges from grid up to the minimum soc
+		m.conn.Write(victron.MaxDischargePower, victron.MaxDischargeNoLimit)
+		m.conn.Write(victron.MinimumSocLimit, m.maxSoc)
+	default:
+		return api.ErrNotAvailable
+	}
+
+	return nil
+}
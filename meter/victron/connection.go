@@ -0,0 +1,202 @@
+package victron
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/plugin/mqtt"
+	"github.com/evcc-io/evcc/util"
+)
+
+// KeepaliveInterval is the interval for requesting the GX device to keep publishing.
+// The GX device stops publishing 60s after the last keepalive request.
+const KeepaliveInterval = 30 * time.Second
+
+// system paths published by the GX device
+const (
+	GridPower       = "system/0/Ac/Grid/L%d/Power"
+	PvOnGridPower   = "system/0/Ac/PvOnGrid/L%d/Power"
+	PvOnOutputPower = "system/0/Ac/PvOnOutput/L%d/Power"
+	DcPvPower       = "system/0/Dc/Pv/Power"
+	BatteryPower    = "system/0/Dc/Battery/Power"
+	BatterySoc      = "system/0/Dc/Battery/Soc"
+	Serial          = "system/0/Serial"
+
+	// ESS settings
+	MinimumSocLimit   = "settings/0/Settings/CGwacs/BatteryLife/MinimumSocLimit"
+	MaxDischargePower = "settings/0/Settings/CGwacs/MaxDischargePower"
+)
+
+// MaxDischargeNoLimit disables the ESS discharge power limit
+const MaxDischargeNoLimit = -1
+
+// Payload is the value message of the GX device. Values not available are null.
+type Payload struct {
+	Value *float64 `json:"value"`
+}
+
+var (
+	mu          sync.Mutex
+	connections = make(map[string]*Connection)
+)
+
+// Connection is the mqtt connection to the GX device
+type Connection struct {
+	log      *util.Logger
+	client   *mqtt.Client
+	portalID string
+	timeout  time.Duration
+
+	mu     sync.Mutex
+	values map[string]*util.Monitor[float64]
+}
+
+// NewConnection returns the shared connection to the GX device's mqtt broker.
+// The portal id is discovered if not configured.
+func NewConnection(cc mqtt.Config, portalID string, timeout time.Duration) (*Connection, error) {
+	log := util.NewLogger("victron")
+
+	client, err := mqtt.RegisteredClientOrDefault(log, cc)
+	if err != nil {
+		return nil, err
+	}
+
+	if portalID == "" {
+		if portalID, err = discover(client, timeout); err != nil {
+			return nil, err
+		}
+		log.DEBUG.Printf("discovered portal id: %s", portalID)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := cc.Broker + portalID
+	if conn, ok := connections[key]; ok {
+		return conn, nil
+	}
+
+	conn := &Connection{
+		log:      log,
+		client:   client,
+		portalID: portalID,
+		timeout:  timeout,
+		values:   make(map[string]*util.Monitor[float64]),
+	}
+
+	go conn.keepalive()
+
+	connections[key] = conn
+
+	return conn, nil
+}
+
+// discover returns the portal id of the first GX device publishing its serial.
+// The serial is published also without keepalive requests.
+func discover(client *mqtt.Client, timeout time.Duration) (string, error) {
+	res := make(chan string, 1)
+
+	if err := client.Listen("N/+/"+Serial, func(payload string) {
+		var p struct {
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal([]byte(payload), &p); err == nil && p.Value != "" {
+			select {
+			case res <- p.Value:
+			default:
+			}
+		}
+	}); err != nil {
+		return "", err
+	}
+
+	select {
+	case id := <-res:
+		return id, nil
+	case <-time.After(timeout):
+		return "", errors.New("portal id not found")
+	}
+}
+
+func (c *Connection) topic(prefix, path string) string {
+	return fmt.Sprintf("%s/%s/%s", prefix, c.portalID, path)
+}
+
+// keepalive requests the GX device to keep publishing its values
+func (c *Connection) keepalive() {
+	for tick := time.Tick(KeepaliveInterval); ; <-tick {
+		c.client.Publish(c.topic("R", "keepalive"), false, "")
+	}
+}
+
+// handler updates the value of the path
+func (c *Connection) handler(monitor *util.Monitor[float64]) func(string) {
+	return func(payload string) {
+		var res Payload
+		if err := json.Unmarshal([]byte(payload), &res); err != nil {
+			c.log.ERROR.Println(err)
+			return
+		}
+
+		var v float64
+		if res.Value != nil {
+			v = *res.Value
+		}
+
+		monitor.Set(v)
+	}
+}
+
+// monitor returns the value monitor of the path, subscribing on first use
+func (c *Connection) monitor(path string) (*util.Monitor[float64], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if m, ok := c.values[path]; ok {
+		return m, nil
+	}
+
+	m := util.NewMonitor[float64](c.timeout)
+	if err := c.client.Listen(c.topic("N", path), c.handler(m)); err != nil {
+		return nil, err
+	}
+
+	c.values[path] = m
+
+	return m, nil
+}
+
+// Subscribe subscribes to the paths for retrieving their values with Value
+func (c *Connection) Subscribe(paths ...string) error {
+	for _, path := range paths {
+		if _, err := c.monitor(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value returns the latest value of the path
+func (c *Connection) Value(path string) (float64, error) {
+	m, err := c.monitor(path)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := m.Get()
+	if errors.Is(err, api.ErrOutdated) {
+		err = fmt.Errorf("%s: %w", strings.TrimPrefix(path, "system/0/"), err)
+	}
+
+	return res, err
+}
+
+// Write writes the value of the path
+func (c *Connection) Write(path string, value float64) {
+	c.client.Publish(c.topic("W", path), false, fmt.Sprintf(`{"value": %v}`, value))
+}
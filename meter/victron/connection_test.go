@@ -0,0 +1,40 @@
+package victron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	conn := &Connection{
+		log:      util.NewLogger("test"),
+		portalID: "c0619ab1234",
+	}
+
+	assert.Equal(t, "N/c0619ab1234/system/0/Dc/Battery/Soc", conn.topic("N", BatterySoc))
+
+	m := util.NewMonitor[float64](time.Minute)
+	h := conn.handler(m)
+
+	h(`{"value": 1234.5}`)
+	res, err := m.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 1234.5, res)
+
+	// not available
+	h(`{"value": null}`)
+	res, err = m.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, res)
+
+	// invalid payload keeps the value
+	h(`{"value": 1}`)
+	h(`invalid`)
+	res, err = m.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, res)
+}
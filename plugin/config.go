@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/diagnostics"
 	reg "github.com/evcc-io/evcc/util/registry"
+	"github.com/spf13/cast"
 )
 
 var registry = reg.New[Plugin]("plugin")
@@ -70,11 +73,18 @@ func plugin[T any](typ string, ctx context.Context, config *Config) (T, error) {
 		return zero, err
 	}
 
-	plugin, err := factory(ctx, config.Other)
+	plugin, err := factory(withoutRecording(ctx), config.Other)
 	if err != nil {
 		return zero, err
 	}
 
+	// plugins may provide device diagnostics
+	if dv, ok := plugin.(api.DiagnosticValues); ok {
+		if c := diagnostics.FromContext(ctx); c != nil {
+			c.Add(dv)
+		}
+	}
+
 	prov, ok := plugin.(T)
 	if !ok {
 		return zero, fmt.Errorf("invalid plugin source for type %s: %s", typ, config.Source)
@@ -84,42 +94,66 @@ func plugin[T any](typ string, ctx context.Context, config *Config) (T, error) {
 }
 
 func (c *Config) IntGetter(ctx context.Context) (func() (int64, error), error) {
+	if r := playbackFromContext(ctx); r != nil && c != nil {
+		return playbackGetter(r, "int", c, cast.ToInt64E), nil
+	}
+
 	prov, err := plugin[IntGetter]("int", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
 	}
 
-	return prov.IntGetter()
+	g, err := prov.IntGetter()
+	return recordGetter(ctx, "int", c, g), err
 }
 
 func (c *Config) FloatGetter(ctx context.Context) (func() (float64, error), error) {
+	if r := playbackFromContext(ctx); r != nil && c != nil {
+		return playbackGetter(r, "float", c, cast.ToFloat64E), nil
+	}
+
 	prov, err := plugin[FloatGetter]("float", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
 	}
 
-	return prov.FloatGetter()
+	g, err := prov.FloatGetter()
+	return recordGetter(ctx, "float", c, g), err
 }
 
 func (c *Config) StringGetter(ctx context.Context) (func() (string, error), error) {
+	if r := playbackFromContext(ctx); r != nil && c != nil {
+		return playbackGetter(r, "string", c, cast.ToStringE), nil
+	}
+
 	prov, err := plugin[StringGetter]("string", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
 	}
 
-	return prov.StringGetter()
+	g, err := prov.StringGetter()
+	return recordGetter(ctx, "string", c, g), err
 }
 
 func (c *Config) BoolGetter(ctx context.Context) (func() (bool, error), error) {
+	if r := playbackFromContext(ctx); r != nil && c != nil {
+		return playbackGetter(r, "bool", c, cast.ToBoolE), nil
+	}
+
 	prov, err := plugin[BoolGetter]("bool", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
 	}
 
-	return prov.BoolGetter()
+	g, err := prov.BoolGetter()
+	return recordGetter(ctx, "bool", c, g), err
 }
 
 func (c *Config) IntSetter(ctx context.Context, param string) (func(int64) error, error) {
+	if playbackFromContext(ctx) != nil && c != nil {
+		return playbackSetter[int64](ctx, param), nil
+	}
+
 	prov, err := plugin[IntSetter]("int", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
@@ -128,7 +162,11 @@ func (c *Config) IntSetter(ctx context.Context, param string) (func(int64) error
 	return prov.IntSetter(param)
 }
 
-func (c *Config) FloatSetter(ctx context.Context, param string) (func(float642 float64) error, error) {
+func (c *Config) FloatSetter(ctx context.Context, param string) (func(float64) error, error) {
+	if playbackFromContext(ctx) != nil && c != nil {
+		return playbackSetter[float64](ctx, param), nil
+	}
+
 	prov, err := plugin[FloatSetter]("float", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
@@ -138,6 +176,10 @@ func (c *Config) FloatSetter(ctx context.Context, param string) (func(float642 f
 }
 
 func (c *Config) StringSetter(ctx context.Context, param string) (func(string) error, error) {
+	if playbackFromContext(ctx) != nil && c != nil {
+		return playbackSetter[string](ctx, param), nil
+	}
+
 	prov, err := plugin[StringSetter]("string", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
@@ -147,6 +189,10 @@ func (c *Config) StringSetter(ctx context.Context, param string) (func(string) e
 }
 
 func (c *Config) BoolSetter(ctx context.Context, param string) (func(bool) error, error) {
+	if playbackFromContext(ctx) != nil && c != nil {
+		return playbackSetter[bool](ctx, param), nil
+	}
+
 	prov, err := plugin[BoolSetter]("bool", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
@@ -156,6 +202,10 @@ func (c *Config) BoolSetter(ctx context.Context, param string) (func(bool) error
 }
 
 func (c *Config) BytesSetter(ctx context.Context, param string) (func([]byte) error, error) {
+	if playbackFromContext(ctx) != nil && c != nil {
+		return playbackSetter[[]byte](ctx, param), nil
+	}
+
 	prov, err := plugin[BytesSetter]("bytes", ctx, c)
 	if prov == nil || err != nil {
 		return nil, err
@@ -3,8 +3,10 @@ package plugin
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,7 +20,9 @@ type Modbus struct {
 	log   *util.Logger
 	conn  *modbus.Connection
 	reg   modbus.Register
+	block *modbus.Block
 	scale float64
+	enum  map[int64]string
 }
 
 func init() {
@@ -30,7 +34,9 @@ func NewModbusFromConfig(ctx context.Context, other map[string]interface{}) (Plu
 	cc := struct {
 		modbus.Settings `mapstructure:",squash"`
 		Register        modbus.Register
+		Block           *modbus.Block // shared register block containing the register
 		Scale           float64
+		Enum            map[int64]string // register value to label mapping
 		Delay           time.Duration
 		ConnectDelay    time.Duration
 		Timeout         time.Duration
@@ -45,7 +51,7 @@ func NewModbusFromConfig(ctx context.Context, other map[string]interface{}) (Plu
 	modbus.Lock()
 	defer modbus.Unlock()
 
-	conn, err := modbus.NewConnection(ctx, cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Settings.Protocol(), cc.ID)
+	conn, err := modbus.NewConnectionWithSettings(ctx, cc.Settings)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +72,41 @@ func NewModbusFromConfig(ctx context.Context, other map[string]interface{}) (Plu
 		return nil, err
 	}
 
+	if cc.Block != nil {
+		if err := cc.Block.Error(); err != nil {
+			return nil, err
+		}
+
+		if cc.Block.Cache == 0 {
+			cc.Block.Cache = time.Second
+		}
+
+		op, err := cc.Register.Operation()
+		if err != nil {
+			return nil, err
+		}
+
+		if fc, _ := cc.Block.FuncCode(); op.FuncCode == fc && !cc.Block.Contains(op) {
+			return nil, fmt.Errorf("register %d outside block", op.Addr)
+		}
+	}
+
 	mb := &Modbus{
 		log:   log,
 		conn:  conn,
 		reg:   cc.Register,
+		block: cc.Block,
 		scale: cc.Scale,
+		enum:  cc.Enum,
 	}
 	return mb, nil
 }
 
 func (m *Modbus) readBytes(op modbus.RegisterOperation) ([]byte, error) {
+	if m.block != nil && m.block.Contains(op) {
+		return m.block.Read(m.conn, op)
+	}
+
 	switch op.FuncCode {
 	case gridx.FuncCodeReadHoldingRegisters:
 		return m.conn.ReadHoldingRegisters(op.Addr, op.Length)
@@ -131,6 +162,10 @@ var _ StringGetter = (*Modbus)(nil)
 
 // StringGetter implements StringProvider
 func (m *Modbus) StringGetter() (func() (string, error), error) {
+	if len(m.enum) > 0 {
+		return m.enumGetter()
+	}
+
 	op, err := m.reg.Operation()
 	if err != nil {
 		return nil, err
@@ -142,10 +177,28 @@ func (m *Modbus) StringGetter() (func() (string, error), error) {
 			return "", err
 		}
 
-		return strings.TrimSpace(string(bytes.Trim(b, "\x00"))), nil
+		return strings.TrimSpace(string(bytes.Trim(m.reg.Reorder(b), "\x00"))), nil
 	}, nil
 }
 
+// enumGetter returns the label of the register value or the value itself if not mapped
+func (m *Modbus) enumGetter() (func() (string, error), error) {
+	g, err := m.IntGetter()
+
+	return func() (string, error) {
+		res, err := g()
+		if err != nil {
+			return "", err
+		}
+
+		if label, ok := m.enum[res]; ok {
+			return label, nil
+		}
+
+		return strconv.FormatInt(res, 10), nil
+	}, err
+}
+
 var _ BoolGetter = (*Modbus)(nil)
 
 // BoolGetter implements BoolProvider
@@ -169,9 +222,25 @@ func (m *Modbus) writeFunc() (func(float64) error, error) {
 		return nil, err
 	}
 
+	// single register value
+	encode16 := func(val float64) (uint16, error) {
+		b, err := encode(val)
+		if err != nil {
+			return 0, err
+		}
+		if len(b) != 2 {
+			return 0, fmt.Errorf("invalid register length: %d", len(b)/2)
+		}
+		return binary.BigEndian.Uint16(b), nil
+	}
+
 	return func(val float64) error {
 		val *= m.scale
 
+		if m.block != nil {
+			defer m.block.Invalidate(m.conn)
+		}
+
 		switch op.FuncCode {
 		case gridx.FuncCodeWriteSingleCoil:
 			var uval uint16
@@ -182,7 +251,30 @@ func (m *Modbus) writeFunc() (func(float64) error, error) {
 			return err
 
 		case gridx.FuncCodeWriteSingleRegister:
-			_, err = m.conn.WriteSingleRegister(op.Addr, uint16(val))
+			u, err := encode16(val)
+			if err != nil {
+				return err
+			}
+
+			if m.reg.BitMask != "" {
+				if u, err = m.readModifyWrite(op.Addr, u); err != nil {
+					return err
+				}
+			}
+
+			_, err = m.conn.WriteSingleRegister(op.Addr, u)
+			return err
+
+		case gridx.FuncCodeMaskWriteRegister:
+			u, err := encode16(val)
+			if err != nil {
+				return err
+			}
+
+			and, or, err := m.reg.MaskValues(u)
+			if err == nil {
+				_, err = m.conn.MaskWriteRegister(op.Addr, and, or)
+			}
 			return err
 
 		case gridx.FuncCodeWriteMultipleRegisters:
@@ -198,6 +290,21 @@ func (m *Modbus) writeFunc() (func(float64) error, error) {
 	}, nil
 }
 
+// readModifyWrite returns the current register value with the masked bits replaced by the value
+func (m *Modbus) readModifyWrite(addr, val uint16) (uint16, error) {
+	and, or, err := m.reg.MaskValues(val)
+	if err != nil {
+		return 0, err
+	}
+
+	b, err := m.conn.ReadHoldingRegisters(addr, 1)
+	if err != nil {
+		return 0, fmt.Errorf("read failed: %w", err)
+	}
+
+	return binary.BigEndian.Uint16(b)&and | or&^and, nil
+}
+
 var _ FloatSetter = (*Modbus)(nil)
 
 // FloatSetter implements FloatSetter
@@ -245,6 +352,10 @@ func (m *Modbus) BytesSetter(_ string) (func([]byte) error, error) {
 	}
 
 	return func(val []byte) error {
+		if m.block != nil {
+			defer m.block.Invalidate(m.conn)
+		}
+
 		switch op.FuncCode {
 		case gridx.FuncCodeWriteMultipleRegisters:
 			_, err = m.conn.WriteMultipleRegisters(op.Addr, uint16(len(val)/2), val)
@@ -0,0 +1,125 @@
+package plugin
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/tariff/openadr"
+	"github.com/evcc-io/evcc/util"
+)
+
+// OpenADR provider returns the currently active event value of an OpenADR 3 program,
+// e.g. an import capacity limit to be used as dynamic circuit limit
+type OpenADR struct {
+	mu        sync.Mutex
+	client    *openadr.Client
+	program   string
+	payload   string
+	scale     float64
+	def       float64
+	cache     time.Duration
+	updated   time.Time
+	values    []openadr.Value
+	programID string
+}
+
+func init() {
+	registry.Add("openadr", NewOpenADRFromConfig)
+}
+
+// NewOpenADRFromConfig creates OpenADR provider
+func NewOpenADRFromConfig(other map[string]interface{}) (Plugin, error) {
+	cc := struct {
+		URI          string
+		ClientID     string
+		ClientSecret string
+		TokenURL     string
+		Program      string
+		Payload      string
+		Scale        float64
+		Default      float64
+		Cache        time.Duration
+	}{
+		Payload: openadr.PayloadImportCapacityLimit,
+		Scale:   1,
+		Cache:   5 * time.Minute,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.URI == "" || cc.Program == "" {
+		return nil, errors.New("missing uri or program")
+	}
+
+	if cc.ClientID == "" || cc.ClientSecret == "" {
+		return nil, api.ErrMissingCredentials
+	}
+
+	log := util.NewLogger("openadr").Redact(cc.ClientID, cc.ClientSecret)
+
+	client, err := openadr.NewClient(log, cc.URI, cc.ClientID, cc.ClientSecret, cc.TokenURL)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &OpenADR{
+		client:  client,
+		program: cc.Program,
+		payload: strings.ToUpper(cc.Payload),
+		scale:   cc.Scale,
+		def:     cc.Default,
+		cache:   cc.Cache,
+	}
+
+	return o, nil
+}
+
+func (p *OpenADR) update() error {
+	if time.Since(p.updated) < p.cache {
+		return nil
+	}
+
+	if p.programID == "" {
+		id, err := p.client.ProgramID(p.program)
+		if err != nil {
+			return err
+		}
+		p.programID = id
+	}
+
+	events, err := p.client.Events(p.programID)
+	if err != nil {
+		return err
+	}
+
+	p.values = openadr.Values(events, p.payload)
+	p.updated = time.Now()
+
+	return nil
+}
+
+var _ FloatGetter = (*OpenADR)(nil)
+
+// FloatGetter creates handler for float64.
+// Returns the configured default value if no event is active.
+func (p *OpenADR) FloatGetter() (func() (float64, error), error) {
+	return func() (float64, error) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if err := p.update(); err != nil {
+			return 0, err
+		}
+
+		if v, ok := openadr.At(p.values, time.Now()); ok {
+			return v * p.scale, nil
+		}
+
+		return p.def, nil
+	}, nil
+}
@@ -0,0 +1,235 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+type (
+	ctxRecording struct{}
+	ctxPlayback  struct{}
+)
+
+// Recording contains the values returned by a device's plugins for reproducing its behavior.
+// Values are keyed by plugin creation order, type and source to avoid storing secrets.
+type Recording struct {
+	Device  string              `json:"device"`
+	Class   string              `json:"class"`
+	Type    string              `json:"type"`
+	Config  map[string]any      `json:"config"` // redacted device configuration
+	Started time.Time           `json:"started"`
+	Values  map[string][]Sample `json:"values"`
+
+	mu      sync.Mutex
+	plugins int            // created plugins
+	pos     map[string]int // playback position
+}
+
+// Sample is a single recorded plugin response
+type Sample struct {
+	Time  time.Time `json:"time"`
+	Value any       `json:"value,omitempty"`
+	Error string    `json:"error,omitempty"`
+}
+
+// NewRecording creates a recording for the given device
+func NewRecording(device, class, typ string, config map[string]any) *Recording {
+	return &Recording{
+		Device:  device,
+		Class:   class,
+		Type:    typ,
+		Config:  util.RedactConfigMap(config),
+		Started: time.Now(),
+		Values:  make(map[string][]Sample),
+	}
+}
+
+// LoadRecording reads a recording from file
+func LoadRecording(file string) (*Recording, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Recording
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, fmt.Errorf("invalid recording: %w", err)
+	}
+
+	return &res, nil
+}
+
+// Save writes the recording to file
+func (r *Recording) Save(file string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, b, 0o644)
+}
+
+// Samples returns the total number of recorded samples
+func (r *Recording) Samples() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res int
+	for _, v := range r.Values {
+		res += len(v)
+	}
+
+	return res
+}
+
+// WithRecording records the plugin values of the device created using the context
+func WithRecording(ctx context.Context, r *Recording) context.Context {
+	return context.WithValue(ctx, ctxRecording{}, r)
+}
+
+// WithPlayback replaces the plugins of the device created using the context by the recorded values
+func WithPlayback(ctx context.Context, r *Recording) context.Context {
+	return context.WithValue(ctx, ctxPlayback{}, r)
+}
+
+func recordingFromContext(ctx context.Context) *Recording {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(ctxRecording{}).(*Recording)
+	return r
+}
+
+func playbackFromContext(ctx context.Context) *Recording {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(ctxPlayback{}).(*Recording)
+	return r
+}
+
+// withoutRecording prevents recording plugins nested inside recorded plugins
+func withoutRecording(ctx context.Context) context.Context {
+	if recordingFromContext(ctx) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxRecording{}, (*Recording)(nil))
+}
+
+// key returns the key of the next created plugin
+func (r *Recording) key(typ string, config *Config) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := fmt.Sprintf("%d/%s/%s", r.plugins, typ, config.Source)
+	r.plugins++
+
+	return res
+}
+
+func (r *Recording) add(key string, val any, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := Sample{Time: time.Now(), Value: val}
+	if err != nil {
+		s.Error = err.Error()
+	}
+
+	r.Values[key] = append(r.Values[key], s)
+}
+
+// next returns the next recorded sample, restarting at the beginning when all samples have been served
+func (r *Recording) next(key string) (Sample, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	samples := r.Values[key]
+	if len(samples) == 0 {
+		return Sample{}, fmt.Errorf("no recorded values: %s", key)
+	}
+
+	if r.pos == nil {
+		r.pos = make(map[string]int)
+	}
+
+	pos := r.pos[key] % len(samples)
+	r.pos[key] = pos + 1
+
+	return samples[pos], nil
+}
+
+// recordedErrors are restored with their identity for errors.Is checks
+var recordedErrors = []error{
+	api.ErrNotAvailable, api.ErrMustRetry, api.ErrOutdated, api.ErrTimeout, api.ErrAsleep,
+}
+
+func sampleError(s string) error {
+	for _, err := range recordedErrors {
+		if s == err.Error() {
+			return err
+		}
+	}
+	return errors.New(s)
+}
+
+// recordGetter records the getter's results if recording is active for the context
+func recordGetter[T any](ctx context.Context, typ string, config *Config, g func() (T, error)) func() (T, error) {
+	r := recordingFromContext(ctx)
+	if r == nil || g == nil {
+		return g
+	}
+
+	key := r.key(typ, config)
+
+	return func() (T, error) {
+		res, err := g()
+		r.add(key, res, err)
+		return res, err
+	}
+}
+
+// playbackGetter serves the recorded values
+func playbackGetter[T any](r *Recording, typ string, config *Config, conv func(any) (T, error)) func() (T, error) {
+	key := r.key(typ, config)
+
+	return func() (T, error) {
+		var zero T
+
+		s, err := r.next(key)
+		if err != nil {
+			return zero, err
+		}
+
+		if s.Error != "" {
+			return zero, sampleError(s.Error)
+		}
+
+		if s.Value == nil {
+			return zero, nil
+		}
+
+		return conv(s.Value)
+	}
+}
+
+// playbackSetter ignores set operations
+func playbackSetter[T any](ctx context.Context, param string) func(T) error {
+	log := contextLogger(ctx, util.NewLogger("playback"))
+
+	return func(val T) error {
+		log.DEBUG.Printf("playback: set %s: %v", param, val)
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingPlayback(t *testing.T) {
+	calc := Config{
+		Source: "calc",
+		Other: map[string]any{
+			"add": []map[string]any{
+				{"source": "const", "value": 1},
+				{"source": "const", "value": 2},
+			},
+		},
+	}
+	status := Config{Source: "const", Other: map[string]any{"value": "C"}}
+
+	rec := NewRecording("test", "meter", "custom", map[string]any{"password": "secret"})
+	ctx := WithRecording(context.TODO(), rec)
+
+	fg, err := calc.FloatGetter(ctx)
+	require.NoError(t, err)
+	sg, err := status.StringGetter(ctx)
+	require.NoError(t, err)
+
+	for range 2 {
+		f, err := fg()
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, f)
+
+		s, err := sg()
+		require.NoError(t, err)
+		assert.Equal(t, "C", s)
+	}
+
+	// nested plugins are not recorded
+	assert.Len(t, rec.Values, 2)
+	assert.Equal(t, 4, rec.Samples())
+	assert.NotEqual(t, "secret", rec.Config["password"])
+
+	file := filepath.Join(t.TempDir(), "test.json")
+	require.NoError(t, rec.Save(file))
+
+	pb, err := LoadRecording(file)
+	require.NoError(t, err)
+	ctx = WithPlayback(context.TODO(), pb)
+
+	fg, err = calc.FloatGetter(ctx)
+	require.NoError(t, err)
+	sg, err = status.StringGetter(ctx)
+	require.NoError(t, err)
+
+	// values are repeated once exhausted
+	for range 3 {
+		f, err := fg()
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, f)
+
+		s, err := sg()
+		require.NoError(t, err)
+		assert.Equal(t, "C", s)
+	}
+
+	// setters are ignored
+	set, err := (&Config{Source: "const", Other: map[string]any{"value": 1}}).IntSetter(ctx, "current")
+	require.NoError(t, err)
+	require.NoError(t, set(16))
+}
+
+func TestRecordingErrors(t *testing.T) {
+	rec := NewRecording("test", "meter", "custom", nil)
+	rec.add("0/float/http", nil, api.ErrNotAvailable)
+	rec.add("0/float/http", nil, api.ErrTimeout)
+
+	fg := playbackGetter(rec, "float", &Config{Source: "http"}, func(v any) (float64, error) {
+		return v.(float64), nil
+	})
+
+	_, err := fg()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+	_, err = fg()
+	assert.ErrorIs(t, err, api.ErrTimeout)
+}
@@ -2,7 +2,11 @@ package sma
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -133,6 +137,39 @@ func (d *Discoverer) DeviceBySerial(serial uint32, password string) *Device {
 	return d.get(serial, password)
 }
 
+// EnergyMeter returns the energy meter sending telegrams on the network once discovery has finished.
+// Returns an error if none or multiple energy meters are found.
+func (d *Discoverer) EnergyMeter(password string) (*Device, error) {
+	for atomic.LoadUint32(&d.done) == 0 {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	var res []*Device
+	for _, device := range d.devices {
+		if device.IsEnergyMeter() {
+			res = append(res, device)
+		}
+	}
+
+	switch len(res) {
+	case 0:
+		return nil, errors.New("no energy meter found")
+	case 1:
+		res[0].SetPassword(password)
+		return res[0], nil
+	default:
+		serials := make([]string, 0, len(res))
+		for _, device := range res {
+			serials = append(serials, strconv.FormatUint(uint64(device.SerialNumber()), 10))
+		}
+		slices.Sort(serials)
+		return nil, fmt.Errorf("multiple energy meters found, configure serial: %s", strings.Join(serials, ", "))
+	}
+}
+
 // DeviceByIP with the given serial number
 func (d *Discoverer) DeviceByIP(ip, password string) (*Device, error) {
 	d.mux.Lock()
@@ -0,0 +1,133 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+)
+
+// SolarmanV5 provides register data pushed by SolarmanV5 loggers
+type SolarmanV5 struct {
+	data   *util.Monitor[[]byte]
+	info   *util.Monitor[modbus.SolarmanV5LoggerInfo]
+	start  int // first register of the pushed data
+	offset int // byte offset of the register within the pushed data
+	length int
+	decode func([]byte) float64
+	scale  float64
+}
+
+func init() {
+	registry.Add("solarmanv5", NewSolarmanV5FromConfig)
+}
+
+// NewSolarmanV5FromConfig creates SolarmanV5 provider.
+// The pushed data is a block of consecutive registers starting at the start register.
+// Register addresses are absolute register addresses like for the modbus plugin.
+func NewSolarmanV5FromConfig(other map[string]interface{}) (Plugin, error) {
+	cc := struct {
+		Listen       string // listen address, loggers on other hosts require an external interface
+		Port         int
+		LoggerSerial modbus.LoggerSerial
+		Start        uint16 // first register of the pushed data
+		Register     modbus.Register
+		Scale        float64
+	}{
+		Listen: "localhost",
+		Port:   10000,
+		Scale:  1,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	// pushing loggers cannot be detected automatically
+	if cc.LoggerSerial == 0 || cc.LoggerSerial == modbus.LoggerSerialAuto {
+		return nil, errors.New("missing loggerserial")
+	}
+
+	if cc.Register.Address < cc.Start {
+		return nil, fmt.Errorf("register address %d before start %d", cc.Register.Address, cc.Start)
+	}
+
+	length, err := cc.Register.Length()
+	if err != nil {
+		return nil, err
+	}
+
+	decode, err := cc.Register.DecodeFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := modbus.GetSolarmanV5Server(net.JoinHostPort(cc.Listen, strconv.Itoa(cc.Port)))
+	if err != nil {
+		return nil, err
+	}
+
+	o := &SolarmanV5{
+		data:   server.Data(uint32(cc.LoggerSerial)),
+		info:   server.LoggerInfo(uint32(cc.LoggerSerial)),
+		start:  int(cc.Start),
+		offset: 2 * int(cc.Register.Address-cc.Start),
+		length: 2 * int(length),
+		decode: decode,
+		scale:  cc.Scale,
+	}
+
+	return o, nil
+}
+
+var _ FloatGetter = (*SolarmanV5)(nil)
+
+// FloatGetter creates handler for float64
+func (p *SolarmanV5) FloatGetter() (func() (float64, error), error) {
+	return func() (float64, error) {
+		b, err := p.data.Get()
+		if err != nil {
+			return 0, err
+		}
+
+		if len(b) < p.offset+p.length {
+			return 0, fmt.Errorf("register out of range: %d", p.start+p.offset/2)
+		}
+
+		return p.scale * p.decode(b[p.offset:p.offset+p.length]), nil
+	}, nil
+}
+
+var _ IntGetter = (*SolarmanV5)(nil)
+
+// IntGetter creates handler for int64
+func (p *SolarmanV5) IntGetter() (func() (int64, error), error) {
+	g, err := p.FloatGetter()
+
+	return func() (int64, error) {
+		res, err := g()
+		return int64(math.Round(res)), err
+	}, err
+}
+
+var _ api.DiagnosticValues = (*SolarmanV5)(nil)
+
+// DiagnosticValues implements the api.DiagnosticValues interface and provides the logger status received during handshake
+func (p *SolarmanV5) DiagnosticValues() (map[string]any, error) {
+	info, err := p.info.Get()
+	if err != nil {
+		return nil, api.ErrNotAvailable
+	}
+
+	return map[string]any{
+		"loggerFirmware": info.Firmware,
+		"loggerRssi":     info.RSSI,
+		"loggerUptime":   info.CurrentUptime().Truncate(time.Second).Seconds(),
+	}, nil
+}
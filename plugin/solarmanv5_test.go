@@ -0,0 +1,45 @@
+package plugin
+
+import (
+	"net"
+	"testing"
+
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolarmanV5RegisterAddress(t *testing.T) {
+	cfg := func(address int) map[string]any {
+		return map[string]any{
+			"port":         0,
+			"loggerserial": "2712345678",
+			"start":        100,
+			"register": map[string]any{
+				"address":  address,
+				"type":     "holding",
+				"encoding": "uint16",
+			},
+		}
+	}
+
+	_, err := NewSolarmanV5FromConfig(cfg(99))
+	require.Error(t, err)
+
+	p, err := NewSolarmanV5FromConfig(cfg(102))
+	require.NoError(t, err)
+
+	// listens on localhost by default
+	server, err := modbus.GetSolarmanV5Server("localhost:0")
+	require.NoError(t, err)
+	assert.True(t, server.Addr().(*net.TCPAddr).IP.IsLoopback())
+
+	server.Data(2712345678).Set([]byte{0, 1, 0, 2, 0, 3})
+
+	g, err := p.(FloatGetter).FloatGetter()
+	require.NoError(t, err)
+
+	res, err := g()
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, res)
+}
@@ -48,7 +48,7 @@ func NewModbusSunspecFromConfig(ctx context.Context, other map[string]interface{
 	modbus.Lock()
 	defer modbus.Unlock()
 
-	conn, err := modbus.NewConnection(ctx, cc.URI, cc.Device, cc.Comset, cc.Baudrate, cc.Settings.Protocol(), cc.ID)
+	conn, err := modbus.NewConnectionWithSettings(ctx, cc.Settings)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func NewModbusSunspecFromConfig(ctx context.Context, other map[string]interface{
 
 	devices := sunspecDevices.Get(conn)
 	if devices == nil {
-		devices, err = sunsdev.DeviceTree(conn)
+		devices, err = sunspecDeviceTree(log, conn)
 		if err != nil && !errors.Is(err, meters.ErrPartiallyOpened) {
 			return nil, err
 		}
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gosunspec "github.com/andig/gosunspec"
+	"github.com/andig/gosunspec/impl"
+	"github.com/andig/gosunspec/layout"
+	bus "github.com/andig/gosunspec/modbus"
+	"github.com/andig/gosunspec/models/model1"
+	"github.com/andig/gosunspec/smdx"
+	"github.com/andig/gosunspec/spi"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/server/db/cache"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+	sunsdev "github.com/volkszaehler/mbmd/meters/sunspec"
+)
+
+// sunspecModelLayout is the location of a model in the device's address space
+type sunspecModelLayout struct {
+	Device int    `json:"device"`
+	Model  uint16 `json:"model"`
+	Addr   uint16 `json:"addr"` // address of the first block
+	Length uint16 `json:"length"`
+}
+
+// sunspecLayout is the persisted model layout of a SunSpec device. Opening a device with a known layout
+// avoids scanning the model chain which takes multiple seconds for devices with many models.
+type sunspecLayout struct {
+	Serial string               `json:"serial"`
+	Models []sunspecModelLayout `json:"models"`
+}
+
+var _ layout.AddressSpaceLayout = (*sunspecLayout)(nil)
+
+// Open builds the device tree from the layout without accessing the device
+func (l *sunspecLayout) Open(a layout.AddressSpaceDriver) (spi.ArraySPI, error) {
+	array := impl.NewArray()
+
+	var dev spi.DeviceSPI
+	device := -1
+
+	for _, ml := range l.Models {
+		me := smdx.GetModel(ml.Model)
+		if me == nil {
+			return nil, fmt.Errorf("unknown model: %d", ml.Model)
+		}
+
+		if ml.Device != device {
+			device = ml.Device
+			dev = impl.NewDevice()
+			if err := array.AddDevice(dev); err != nil {
+				return nil, err
+			}
+		}
+
+		m := impl.NewContiguousModel(me, ml.Length, a)
+
+		offset := ml.Addr
+		m.Do(spi.WithBlockSPI(func(b spi.BlockSPI) {
+			b.SetAnchor(offset)
+			offset += b.Length()
+		}))
+
+		if err := dev.AddModel(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return array, nil
+}
+
+// sunspecLayoutOf returns the layout of the device tree
+func sunspecLayoutOf(devices []gosunspec.Device) sunspecLayout {
+	var res sunspecLayout
+
+	for i, d := range devices {
+		d.Do(spi.WithModelSPI(func(m spi.ModelSPI) {
+			b, err := m.Block(0)
+			if err != nil {
+				return
+			}
+
+			addr, ok := b.(spi.BlockSPI).Anchor().(uint16)
+			if !ok {
+				return
+			}
+
+			res.Models = append(res.Models, sunspecModelLayout{
+				Device: i,
+				Model:  uint16(m.Id()),
+				Addr:   addr,
+				Length: m.Length(),
+			})
+		}))
+	}
+
+	return res
+}
+
+// sunspecSerial reads the serial number of the first device's common model
+func sunspecSerial(devices []gosunspec.Device) (res string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	if len(devices) == 0 {
+		return "", errors.New("device not found")
+	}
+
+	b := devices[0].MustModel(model1.ModelID).MustBlock(0)
+	if err := b.Read(model1.SN); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(b.MustPoint(model1.SN).StringValue()), nil
+}
+
+func sunspecLayoutKey(conn *modbus.Connection) string {
+	return "sunspec:" + conn.Addr()
+}
+
+// sunspecDeviceTree returns the device tree using the persisted layout if the device's serial number matches.
+// The layout is re-verified in background and updated for the next start if the device has changed.
+func sunspecDeviceTree(log *util.Logger, conn *modbus.Connection) ([]gosunspec.Device, error) {
+	if db.Instance == nil {
+		return sunsdev.DeviceTree(conn)
+	}
+
+	key := sunspecLayoutKey(conn)
+
+	var cached sunspecLayout
+	if err := cache.Get(key, &cached); err == nil {
+		if in, err := bus.OpenWithLayout(conn, &cached); err == nil {
+			devices := in.Collect(gosunspec.AllDevices)
+
+			if serial, err := sunspecSerial(devices); err == nil && serial == cached.Serial {
+				log.DEBUG.Printf("using cached layout for %s", conn.Addr())
+				go sunspecVerifyLayout(log, conn, cached)
+				return devices, nil
+			}
+		}
+	}
+
+	devices, err := sunsdev.DeviceTree(conn)
+	if err == nil {
+		sunspecPersistLayout(log, conn, devices)
+	}
+
+	return devices, err
+}
+
+// sunspecPersistLayout stores the layout of completely opened device trees
+func sunspecPersistLayout(log *util.Logger, conn *modbus.Connection, devices []gosunspec.Device) {
+	serial, err := sunspecSerial(devices)
+	if err != nil {
+		log.DEBUG.Printf("not caching layout: %v", err)
+		return
+	}
+
+	l := sunspecLayoutOf(devices)
+	l.Serial = serial
+
+	if err := cache.Put(sunspecLayoutKey(conn), l); err != nil {
+		log.ERROR.Printf("cache layout: %v", err)
+	}
+}
+
+// sunspecVerifyLayout scans the device and updates the persisted layout if it has changed
+func sunspecVerifyLayout(log *util.Logger, conn *modbus.Connection, cached sunspecLayout) {
+	devices, err := sunsdev.DeviceTree(conn)
+	if err != nil {
+		if !errors.Is(err, meters.ErrPartiallyOpened) {
+			log.DEBUG.Printf("verify layout: %v", err)
+		}
+		return
+	}
+
+	if l := sunspecLayoutOf(devices); !slices.Equal(l.Models, cached.Models) {
+		log.WARN.Printf("model layout of %s has changed, restart to apply", conn.Addr())
+		sunspecPersistLayout(log, conn, devices)
+	}
+}
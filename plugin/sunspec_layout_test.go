@@ -0,0 +1,34 @@
+package plugin
+
+import (
+	"testing"
+
+	gosunspec "github.com/andig/gosunspec"
+	"github.com/andig/gosunspec/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSunspecLayout(t *testing.T) {
+	for _, slab := range [][]byte{memory.ComplexNonZeroSlab, memory.TwoDeviceSlab} {
+		in, err := memory.Open(slab)
+		require.NoError(t, err)
+
+		scanned := in.Collect(gosunspec.AllDevices)
+		l := sunspecLayoutOf(scanned)
+		require.NotEmpty(t, l.Models)
+
+		in, err = memory.OpenWithLayout(slab, &l)
+		require.NoError(t, err)
+
+		devices := in.Collect(gosunspec.AllDevices)
+		assert.Len(t, devices, len(scanned))
+		assert.Equal(t, l, sunspecLayoutOf(devices))
+
+		expected, err := sunspecSerial(scanned)
+		require.NoError(t, err)
+		serial, err := sunspecSerial(devices)
+		require.NoError(t, err)
+		assert.Equal(t, expected, serial)
+	}
+}
@@ -2,6 +2,7 @@ package push
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,14 @@ import (
 
 // Messenger implements message sending
 type Messenger interface {
-	Send(title, msg string)
+	Send(title, msg string) error
+}
+
+// RecipientMessenger is implemented by messengers sending to multiple recipients individually
+type RecipientMessenger interface {
+	Messenger
+	Recipients() []string
+	SendTo(recipient, title, msg string) error
 }
 
 var registry = reg.New[Messenger]("messenger")
@@ -29,3 +37,10 @@ func NewFromConfig(ctx context.Context, typ string, other map[string]interface{}
 
 	return v, err
 }
+
+// ServiceID returns a stable identifier of the messenger configuration
+func ServiceID(typ string, other map[string]interface{}) string {
+	typ = strings.ToLower(typ)
+	h := sha256.Sum256(fmt.Appendf(nil, "%s:%v", typ, other))
+	return fmt.Sprintf("%s-%x", typ, h[:4])
+}
@@ -4,10 +4,14 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/evcc-io/evcc/core/vehicle"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	i18ntemplate "github.com/nicksnyder/go-i18n/v2/i18n/template"
 )
 
 // Event is a notification event
@@ -26,43 +30,133 @@ type Vehicles interface {
 	ByName(string) (vehicle.API, error)
 }
 
+// messenger is a sender with its service id and event template overrides
+type messenger struct {
+	Messenger
+	id     string
+	events map[string]EventTemplateConfig
+}
+
 // Hub subscribes to event notifications and sends them to client devices
 type Hub struct {
+	log         *util.Logger
 	definitions map[string]EventTemplateConfig
-	sender      []Messenger
+	defaults    bool
+	localizer   *i18n.Localizer
+	sender      []messenger
 	cache       *util.ParamCache
 	vehicles    Vehicles
+	queue       *Queue
 }
 
-// NewHub creates push hub with definitions and receiver
-func NewHub(cc map[string]EventTemplateConfig, vv Vehicles, cache *util.ParamCache) (*Hub, error) {
-	// instantiate all event templates
+// ValidateTemplates instantiates all event templates
+func ValidateTemplates(cc map[string]EventTemplateConfig) error {
 	for k, v := range cc {
 		if _, err := template.New("out").Funcs(sprig.FuncMap()).Parse(v.Title); err != nil {
-			return nil, fmt.Errorf("invalid event title: %s (%w)", k, err)
+			return fmt.Errorf("invalid event title: %s (%w)", k, err)
 		}
 		if _, err := template.New("out").Funcs(sprig.FuncMap()).Parse(v.Msg); err != nil {
-			return nil, fmt.Errorf("invalid event message: %s (%w)", k, err)
+			return fmt.Errorf("invalid event message: %s (%w)", k, err)
 		}
 	}
+	return nil
+}
+
+// NewHub creates push hub with definitions and receiver.
+// If defaults are enabled, events without definition use the default templates of the given language, defaulting to the system language.
+func NewHub(cc map[string]EventTemplateConfig, defaults bool, lang string, vv Vehicles, cache *util.ParamCache) (*Hub, error) {
+	if err := ValidateTemplates(cc); err != nil {
+		return nil, err
+	}
+
+	log := util.NewLogger("push")
 
 	h := &Hub{
+		log:         log,
+		queue:       NewQueue(log, queueTTL),
 		definitions: cc,
+		defaults:    defaults,
 		cache:       cache,
 		vehicles:    vv,
 	}
 
+	if locale.Bundle != nil {
+		h.localizer = i18n.NewLocalizer(locale.Bundle, lang, locale.Language)
+	}
+
 	return h, nil
 }
 
-// Add adds a sender to the list of senders
-func (h *Hub) Add(sender Messenger) {
-	h.sender = append(h.sender, sender)
+// Add adds a sender identified by its service id to the list of senders.
+// The sender's event templates take precedence over the hub's definitions.
+func (h *Hub) Add(id string, sender Messenger, events map[string]EventTemplateConfig) error {
+	if err := ValidateTemplates(events); err != nil {
+		return err
+	}
+
+	h.sender = append(h.sender, messenger{sender, id, events})
+
+	return nil
+}
+
+// localized returns the default template for the given message id
+func (h *Hub) localized(id string) string {
+	res, err := h.localizer.Localize(&locale.Config{
+		MessageID:      id,
+		TemplateParser: i18ntemplate.IdentityParser{},
+	})
+	if err != nil {
+		return ""
+	}
+
+	return res
+}
+
+// translate returns the localized message for the given message id or the fallback if not available
+func (h *Hub) translate(id string, data map[string]any, fallback string) string {
+	if h.localizer == nil {
+		return fallback
+	}
+
+	res, err := h.localizer.Localize(&locale.Config{
+		MessageID:    id,
+		TemplateData: data,
+	})
+	if err != nil || res == "" {
+		return fallback
+	}
+
+	return res
+}
+
+// definition returns the event template for the given sender's overrides.
+// If default templates are enabled, events without definition and missing title or
+// message of configured events are completed from the localized defaults.
+func (h *Hub) definition(events map[string]EventTemplateConfig, event string) (EventTemplateConfig, bool) {
+	res, ok := events[event]
+	if !ok {
+		res, ok = h.definitions[event]
+	}
+
+	if !h.defaults || h.localizer == nil {
+		return res, ok
+	}
+
+	if res.Title == "" {
+		res.Title = h.localized("push.events." + event + ".title")
+	}
+	if res.Msg == "" {
+		res.Msg = h.localized("push.events." + event + ".msg")
+	}
+
+	return res, res.Msg != ""
 }
 
 // apply applies the event template to the content to produce the actual message
 func (h *Hub) apply(ev Event, tmpl string) (string, error) {
-	attr := make(map[string]interface{})
+	attr := map[string]interface{}{
+		"event": ev.Event,
+	}
 
 	// loadpoint id
 	if ev.Loadpoint != nil {
@@ -93,34 +187,122 @@ func (h *Hub) apply(ev Event, tmpl string) (string, error) {
 	return util.ReplaceFormatted(tmpl, attr)
 }
 
+// sendTo sends the message to the sender's recipient, or all recipients if empty
+func (h *Hub) sendTo(sender messenger, recipient, title, msg string) error {
+	if rm, ok := sender.Messenger.(RecipientMessenger); ok && recipient != "" {
+		return rm.SendTo(recipient, title, msg)
+	}
+	return sender.Send(title, msg)
+}
+
+// send sends the message and queues it if delivery fails.
+// Messages of RecipientMessengers are queued only for the failed recipients.
+func (h *Hub) send(sender messenger, title, msg string) {
+	recipients := []string{""}
+	if rm, ok := sender.Messenger.(RecipientMessenger); ok {
+		recipients = rm.Recipients()
+	}
+
+	for _, recipient := range recipients {
+		if err := h.sendTo(sender, recipient, title, msg); err != nil {
+			h.log.ERROR.Printf("send: %v", err)
+			h.queue.Add(sender.id, recipient, title, msg)
+		}
+	}
+}
+
+// deliverQueued delivers previously undelivered messages as digest per sender and recipient
+func (h *Hub) deliverQueued() {
+	for _, sender := range h.sender {
+		pending := h.queue.Pending(sender.id)
+
+		byRecipient := make(map[string][]queuedMessage)
+		for _, m := range pending {
+			byRecipient[m.Recipient] = append(byRecipient[m.Recipient], m)
+		}
+
+		for recipient, mm := range byRecipient {
+			title, msg := h.digest(mm)
+			if err := h.sendTo(sender, recipient, title, msg); err != nil {
+				h.log.DEBUG.Printf("deliver %d queued messages: %v", len(mm), err)
+				continue
+			}
+
+			h.log.DEBUG.Printf("delivered %d queued messages", len(mm))
+			h.queue.Remove(sender.id, mm)
+		}
+	}
+}
+
+// digest summarizes multiple undelivered messages into a single localized message
+func (h *Hub) digest(mm []queuedMessage) (string, string) {
+	if len(mm) == 1 {
+		return mm[0].Title, mm[0].Msg
+	}
+
+	layout := h.translate("push.delayed.time", nil, "Jan 2 15:04")
+
+	var b strings.Builder
+	for _, m := range mm {
+		b.WriteString(m.Created.Local().Format(layout))
+		if m.Title != "" {
+			fmt.Fprintf(&b, " %s:", m.Title)
+		}
+		fmt.Fprintf(&b, " %s\n", m.Msg)
+	}
+
+	title := h.translate("push.delayed.title", map[string]any{"count": len(mm)}, fmt.Sprintf("%d delayed notifications", len(mm)))
+
+	return title, strings.TrimSpace(b.String())
+}
+
 // Run is the Hub's main publishing loop
 func (h *Hub) Run(events <-chan Event, valueChan chan<- util.Param) {
-	log := util.NewLogger("push")
+	ticker := time.NewTicker(queueRetryInterval)
+	defer ticker.Stop()
 
-	for ev := range events {
-		if len(h.sender) == 0 {
-			continue
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			h.publish(ev, valueChan)
+
+		case <-ticker.C:
+			h.deliverQueued()
 		}
+	}
+}
 
-		definition, ok := h.definitions[ev.Event]
-		if !ok {
-			continue
+// publish renders the event and sends it to all senders
+func (h *Hub) publish(ev Event, valueChan chan<- util.Param) {
+	definitions := make(map[int]EventTemplateConfig)
+	for id, sender := range h.sender {
+		if definition, ok := h.definition(sender.events, ev.Event); ok {
+			definitions[id] = definition
 		}
+	}
+
+	if len(definitions) == 0 {
+		return
+	}
 
-		// let cache catch up, refs https://github.com/evcc-io/evcc/pull/445
-		flushC := util.Flusher()
-		valueChan <- util.Param{Val: flushC}
-		<-flushC
+	// let cache catch up, refs https://github.com/evcc-io/evcc/pull/445
+	flushC := util.Flusher()
+	valueChan <- util.Param{Val: flushC}
+	<-flushC
 
+	for id, definition := range definitions {
 		title, err := h.apply(ev, definition.Title)
 		if err != nil {
-			log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
+			h.log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
 			continue
 		}
 
 		msg, err := h.apply(ev, definition.Msg)
 		if err != nil {
-			log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
+			h.log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
 			continue
 		}
 
@@ -128,8 +310,6 @@ func (h *Hub) Run(events <-chan Event, valueChan chan<- util.Param) {
 			continue
 		}
 
-		for _, sender := range h.sender {
-			go sender.Send(title, msg)
-		}
+		go h.send(h.sender[id], title, msg)
 	}
 }
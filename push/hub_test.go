@@ -0,0 +1,86 @@
+package push
+
+import (
+	"os"
+	"testing"
+
+	"github.com/evcc-io/evcc/server/assets"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubDefinition(t *testing.T) {
+	assets.I18n = os.DirFS("../i18n")
+	require.NoError(t, locale.Init())
+
+	// no events without configuration
+	h, err := NewHub(nil, false, "de", nil, util.NewParamCache())
+	require.NoError(t, err)
+
+	res, ok := h.definition(nil, "guest")
+	assert.False(t, ok)
+	assert.Empty(t, res)
+
+	// localized defaults
+	h, err = NewHub(nil, true, "de", nil, util.NewParamCache())
+	require.NoError(t, err)
+
+	res, ok = h.definition(nil, "guest")
+	require.True(t, ok)
+	assert.Equal(t, EventTemplateConfig{Title: "Unbekanntes Fahrzeug", Msg: "Unbekanntes Fahrzeug, Gast verbunden?"}, res)
+
+	// templates are not evaluated by the localizer
+	res, ok = h.definition(nil, "asleep")
+	require.True(t, ok)
+	assert.Contains(t, res.Msg, "{{ .vehicleTitle }}")
+
+	_, ok = h.definition(nil, "unknown")
+	assert.False(t, ok)
+
+	// configured events
+	h, err = NewHub(map[string]EventTemplateConfig{
+		"start": {Msg: "go"},
+	}, false, "en", nil, util.NewParamCache())
+	require.NoError(t, err)
+
+	res, ok = h.definition(nil, "start")
+	require.True(t, ok)
+	assert.Equal(t, EventTemplateConfig{Msg: "go"}, res)
+
+	_, ok = h.definition(nil, "stop")
+	assert.False(t, ok)
+
+	// configured events completed from defaults
+	h, err = NewHub(map[string]EventTemplateConfig{
+		"start": {Msg: "go"},
+	}, true, "en", nil, util.NewParamCache())
+	require.NoError(t, err)
+
+	res, ok = h.definition(nil, "start")
+	require.True(t, ok)
+	assert.Equal(t, EventTemplateConfig{Title: "Charge started", Msg: "go"}, res)
+
+	_, ok = h.definition(nil, "stop")
+	assert.True(t, ok)
+
+	// sender overrides
+	res, ok = h.definition(map[string]EventTemplateConfig{
+		"stop": {Title: "done", Msg: "${chargedEnergy}"},
+	}, "stop")
+	require.True(t, ok)
+	assert.Equal(t, EventTemplateConfig{Title: "done", Msg: "${chargedEnergy}"}, res)
+
+	// invalid template
+	require.Error(t, h.Add("test", new(testMessenger), map[string]EventTemplateConfig{
+		"stop": {Msg: "{{ .foo"},
+	}))
+}
+
+func TestServiceID(t *testing.T) {
+	id := ServiceID("Telegram", map[string]any{"token": "foo", "chats": []int{1}})
+	assert.Equal(t, id, ServiceID("telegram", map[string]any{"chats": []int{1}, "token": "foo"}))
+	assert.Regexp(t, "^telegram-[0-9a-f]{8}$", id)
+	assert.NotEqual(t, id, ServiceID("telegram", map[string]any{"token": "bar", "chats": []int{1}}))
+}
@@ -2,6 +2,7 @@ package push
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -53,17 +54,25 @@ func NewNtfyFromConfig(other map[string]interface{}) (Messenger, error) {
 }
 
 // Send sends to all receivers
-func (m *Ntfy) Send(title, msg string) {
+func (m *Ntfy) Send(title, msg string) error {
 	req, err := request.New("POST", m.uri, strings.NewReader(msg), map[string]string{
 		"Priority": m.priority,
 		"Title":    title,
 		"Tags":     m.tags,
 	})
 	if err != nil {
-		m.log.ERROR.Printf("ntfy: %v", err)
+		return err
 	}
 
-	if _, err := http.DefaultClient.Do(req); err != nil {
-		m.log.ERROR.Printf("ntfy: %v", err)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
 	}
+
+	return nil
 }
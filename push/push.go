@@ -38,7 +38,6 @@ func NewConfigurableFromConfig(ctx context.Context, other map[string]interface{}
 // NewConfigurable creates a new Messenger
 func NewConfigurable(send func(string) error, encoding string) (*Push, error) {
 	m := &Push{
-		log:      util.NewLogger("push"),
 		send:     send,
 		encoding: strings.ToLower(encoding),
 	}
@@ -47,7 +46,6 @@ func NewConfigurable(send func(string) error, encoding string) (*Push, error) {
 
 // Push is a configurable Messenger implementation
 type Push struct {
-	log      *util.Logger
 	send     func(string) error
 	encoding string
 }
@@ -62,7 +60,7 @@ func (m *Push) csv(separator rune, title, msg string) string {
 }
 
 // Send implements the Messenger interface
-func (m *Push) Send(title, msg string) {
+func (m *Push) Send(title, msg string) error {
 	var res string
 
 	switch m.encoding {
@@ -85,7 +83,5 @@ func (m *Push) Send(title, msg string) {
 		res = msg
 	}
 
-	if err := m.send(res); err != nil {
-		m.log.ERROR.Printf("send: %v", err)
-	}
+	return m.send(res)
 }
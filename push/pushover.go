@@ -3,6 +3,7 @@ package push
 import (
 	"errors"
 	"strings"
+	"sync"
 
 	"github.com/evcc-io/evcc/util"
 	"github.com/gregdel/pushover"
@@ -48,19 +49,40 @@ func NewPushOverFromConfig(other map[string]interface{}) (Messenger, error) {
 	return m, nil
 }
 
-// Send sends to all receivers
-func (m *PushOver) Send(title, msg string) {
+// Recipients implements the RecipientMessenger interface
+func (m *PushOver) Recipients() []string {
+	return m.recipients
+}
+
+// SendTo implements the RecipientMessenger interface
+func (m *PushOver) SendTo(id, title, msg string) error {
+	m.log.DEBUG.Printf("sending to %s", id)
+
 	message := pushover.NewMessageWithTitle(msg, title)
 	message.DeviceName = m.device
 
-	for _, id := range m.recipients {
-		go func(id string) {
-			m.log.DEBUG.Printf("sending to %s", id)
+	_, err := m.app.SendMessage(message, pushover.NewRecipient(id))
+	return err
+}
+
+// Send sends to all receivers
+func (m *PushOver) Send(title, msg string) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
 
-			recipient := pushover.NewRecipient(id)
-			if _, err := m.app.SendMessage(message, recipient); err != nil {
-				m.log.ERROR.Print(err)
+	for _, id := range m.recipients {
+		wg.Go(func() {
+			if err := m.SendTo(id, title, msg); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
 			}
-		}(id)
+		})
 	}
+	wg.Wait()
+
+	return errors.Join(errs...)
 }
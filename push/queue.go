@@ -0,0 +1,145 @@
+package push
+
+import (
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+)
+
+const (
+	queueTTL           = 24 * time.Hour
+	queueRetryInterval = time.Minute
+	queueMaxMessages   = 50 // per sender
+)
+
+// queuedMessage is a message that could not be delivered
+type queuedMessage struct {
+	Sender    string    `json:"sender"`              // service id of the messenger
+	Recipient string    `json:"recipient,omitempty"` // recipient of a RecipientMessenger
+	Title     string    `json:"title"`
+	Msg       string    `json:"msg"`
+	Created   time.Time `json:"created"`
+}
+
+// Queue persists undeliverable messages per sender for delivery on recovery
+type Queue struct {
+	mu       sync.Mutex
+	log      *util.Logger
+	ttl      time.Duration
+	messages []queuedMessage
+}
+
+// NewQueue creates a queue and restores previously undelivered messages
+func NewQueue(log *util.Logger, ttl time.Duration) *Queue {
+	q := &Queue{
+		log: log,
+		ttl: ttl,
+	}
+
+	if err := settings.Json(keys.PushQueue, &q.messages); err == nil && len(q.messages) > 0 {
+		q.log.DEBUG.Printf("restored %d undelivered messages", len(q.messages))
+	}
+
+	return q
+}
+
+// Add queues an undelivered message for the sender's recipient, or all recipients if empty
+func (q *Queue) Add(sender, recipient, title, msg string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.messages = append(q.messages, queuedMessage{
+		Sender:    sender,
+		Recipient: recipient,
+		Title:     title,
+		Msg:       msg,
+		Created:   time.Now(),
+	})
+
+	// drop oldest messages exceeding the limit
+	var count int
+	for i := len(q.messages) - 1; i >= 0; i-- {
+		if q.messages[i].Sender != sender {
+			continue
+		}
+		if count++; count > queueMaxMessages {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+		}
+	}
+
+	q.persist()
+}
+
+// Pending returns the non-expired messages for given sender
+func (q *Queue) Pending(sender string) []queuedMessage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.expire()
+
+	var res []queuedMessage
+	for _, m := range q.messages {
+		if m.Sender == sender {
+			res = append(res, m)
+		}
+	}
+
+	return res
+}
+
+// Remove removes the delivered messages for given sender
+func (q *Queue) Remove(sender string, delivered []queuedMessage) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	res := q.messages[:0]
+	for _, m := range q.messages {
+		if m.Sender != sender || !containsMessage(delivered, m) {
+			res = append(res, m)
+		}
+	}
+	q.messages = res
+
+	q.persist()
+}
+
+func containsMessage(mm []queuedMessage, m queuedMessage) bool {
+	for _, v := range mm {
+		if v.Recipient == m.Recipient && v.Title == m.Title && v.Msg == m.Msg && v.Created.Equal(m.Created) {
+			return true
+		}
+	}
+	return false
+}
+
+// expire removes messages exceeding the ttl. Caller must hold the lock.
+func (q *Queue) expire() {
+	res := q.messages[:0]
+	for _, m := range q.messages {
+		if time.Since(m.Created) < q.ttl {
+			res = append(res, m)
+		} else {
+			q.log.DEBUG.Printf("dropping expired message: %s", m.Title)
+		}
+	}
+
+	if len(res) != len(q.messages) {
+		q.messages = res
+		q.persist()
+	}
+}
+
+// persist stores the queue. Caller must hold the lock.
+func (q *Queue) persist() {
+	if len(q.messages) == 0 {
+		settings.SetString(keys.PushQueue, "")
+		return
+	}
+
+	if err := settings.SetJson(keys.PushQueue, q.messages); err != nil {
+		q.log.ERROR.Println("persist queue:", err)
+	}
+}
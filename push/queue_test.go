@@ -0,0 +1,154 @@
+package push
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/assets"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMessenger struct {
+	err  error
+	sent []string
+}
+
+func (m *testMessenger) Send(title, msg string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, title+": "+msg)
+	return nil
+}
+
+func newTestQueue() *Queue {
+	settings.SetString(keys.PushQueue, "")
+	return NewQueue(util.NewLogger("foo"), time.Hour)
+}
+
+type testRecipientMessenger struct {
+	testMessenger
+	failed map[string]bool
+	sentTo map[string][]string
+}
+
+func (m *testRecipientMessenger) Recipients() []string {
+	return []string{"a", "b"}
+}
+
+func (m *testRecipientMessenger) SendTo(recipient, title, msg string) error {
+	if m.failed[recipient] {
+		return errors.New("offline")
+	}
+	m.sentTo[recipient] = append(m.sentTo[recipient], title+": "+msg)
+	return nil
+}
+
+func TestQueue(t *testing.T) {
+	q := newTestQueue()
+
+	q.Add("foo-1", "", "foo", "bar")
+	q.Add("bar-2", "", "baz", "qux")
+	require.Len(t, q.Pending("foo-1"), 1)
+
+	// expired
+	q.messages[0].Created = time.Now().Add(-2 * time.Hour)
+	assert.Empty(t, q.Pending("foo-1"))
+	assert.Len(t, q.Pending("bar-2"), 1)
+
+	q.Remove("bar-2", q.Pending("bar-2"))
+	assert.Empty(t, q.messages)
+}
+
+func TestQueueLimit(t *testing.T) {
+	q := newTestQueue()
+
+	for range queueMaxMessages + 5 {
+		q.Add("foo-1", "", "foo", "bar")
+	}
+	q.Add("bar-2", "", "baz", "qux")
+
+	assert.Len(t, q.Pending("foo-1"), queueMaxMessages)
+	assert.Len(t, q.Pending("bar-2"), 1)
+}
+
+func TestHubDeliverQueued(t *testing.T) {
+	m := &testMessenger{err: errors.New("offline")}
+
+	h := &Hub{
+		log:   util.NewLogger("foo"),
+		queue: newTestQueue(),
+	}
+	require.NoError(t, h.Add("test-1", m, nil))
+
+	h.send(h.sender[0], "foo", "bar")
+	h.send(h.sender[0], "baz", "qux")
+	require.Len(t, h.queue.Pending("test-1"), 2)
+
+	// still offline
+	h.deliverQueued()
+	require.Len(t, h.queue.Pending("test-1"), 2)
+
+	// messages belong to the service, not its position
+	h.sender = nil
+	require.NoError(t, h.Add("other-2", new(testMessenger), nil))
+	require.NoError(t, h.Add("test-1", m, nil))
+
+	// recovered
+	m.err = nil
+	h.deliverQueued()
+	assert.Empty(t, h.queue.Pending("test-1"))
+	require.Len(t, m.sent, 1)
+	assert.Contains(t, m.sent[0], "2 delayed notifications")
+	assert.Contains(t, m.sent[0], "foo: bar")
+	assert.Empty(t, h.sender[0].Messenger.(*testMessenger).sent)
+}
+
+func TestHubDeliverQueuedRecipients(t *testing.T) {
+	m := &testRecipientMessenger{
+		failed: map[string]bool{"b": true},
+		sentTo: make(map[string][]string),
+	}
+
+	h := &Hub{
+		log:   util.NewLogger("foo"),
+		queue: newTestQueue(),
+	}
+	require.NoError(t, h.Add("test-1", m, nil))
+
+	// only failed recipient queued
+	h.send(h.sender[0], "foo", "bar")
+	assert.Equal(t, []string{"foo: bar"}, m.sentTo["a"])
+	require.Len(t, h.queue.Pending("test-1"), 1)
+
+	// recovered
+	m.failed = nil
+	h.deliverQueued()
+	assert.Empty(t, h.queue.Pending("test-1"))
+	assert.Equal(t, []string{"foo: bar"}, m.sentTo["a"])
+	assert.Equal(t, []string{"foo: bar"}, m.sentTo["b"])
+}
+
+func TestHubDigest(t *testing.T) {
+	assets.I18n = os.DirFS("../i18n")
+	require.NoError(t, locale.Init())
+
+	h, err := NewHub(nil, false, "de", nil, util.NewParamCache())
+	require.NoError(t, err)
+
+	ts := time.Date(2026, 3, 4, 5, 6, 0, 0, time.Local)
+	title, msg := h.digest([]queuedMessage{
+		{Title: "foo", Msg: "bar", Created: ts},
+		{Msg: "baz", Created: ts},
+	})
+
+	assert.Equal(t, "2 verzögerte Benachrichtigungen", title)
+	assert.Equal(t, "04.03. 05:06 foo: bar\n04.03. 05:06 baz", msg)
+}
@@ -1,6 +1,8 @@
 package push
 
 import (
+	"errors"
+
 	"github.com/containrrr/shoutrrr"
 	"github.com/containrrr/shoutrrr/pkg/router"
 	"github.com/containrrr/shoutrrr/pkg/types"
@@ -42,14 +44,10 @@ func NewShoutrrrFromConfig(other map[string]interface{}) (Messenger, error) {
 }
 
 // Send sends to all receivers
-func (m *Shoutrrr) Send(title, msg string) {
+func (m *Shoutrrr) Send(title, msg string) error {
 	params := &types.Params{
 		"title": title,
 	}
 
-	for _, err := range m.app.Send(msg, params) {
-		if err != nil {
-			m.log.ERROR.Println("send:", err)
-		}
-	}
+	return errors.Join(m.app.Send(msg, params)...)
 }
@@ -77,10 +77,12 @@ func (m *Telegram) handler(ctx context.Context, b *bot.Bot, update *models.Updat
 }
 
 // Send sends to all receivers
-func (m *Telegram) Send(title, msg string) {
+func (m *Telegram) Send(title, msg string) error {
 	m.Lock()
 	defer m.Unlock()
 
+	var errs []error
+
 	for chat := range m.chats {
 		m.log.DEBUG.Printf("sending to %d", chat)
 
@@ -88,7 +90,9 @@ func (m *Telegram) Send(title, msg string) {
 			ChatID: chat,
 			Text:   msg,
 		}); err != nil {
-			m.log.ERROR.Println("send:", err)
+			errs = append(errs, err)
 		}
 	}
+
+	return errors.Join(errs...)
 }
@@ -0,0 +1,91 @@
+package push
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+func init() {
+	registry.Add("webhook", NewWebhookFromConfig)
+}
+
+const webhookDefaultBody = `{"title":{{ .Title | toJson }},"msg":{{ .Msg | toJson }}}`
+
+// Webhook implements a generic http messenger with templated payload
+type Webhook struct {
+	*request.Helper
+	uri     string
+	method  string
+	headers map[string]string
+	body    *template.Template
+}
+
+// NewWebhookFromConfig creates new Webhook messenger
+func NewWebhookFromConfig(other map[string]interface{}) (Messenger, error) {
+	cc := struct {
+		URI     string
+		Method  string
+		Headers map[string]string
+		Body    string
+	}{
+		Method: "POST",
+		Body:   webhookDefaultBody,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.URI == "" {
+		return nil, errors.New("missing uri")
+	}
+
+	// config keys may be lowercased
+	headers := map[string]string{
+		"Content-Type": request.JSONContent,
+	}
+	for k, v := range cc.Headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+
+	body, err := template.New("body").Funcs(sprig.FuncMap()).Parse(cc.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Webhook{
+		Helper:  request.NewHelper(util.NewLogger("webhook")),
+		uri:     cc.URI,
+		method:  strings.ToUpper(cc.Method),
+		headers: headers,
+		body:    body,
+	}
+
+	return m, nil
+}
+
+// Send sends the rendered payload
+func (m *Webhook) Send(title, msg string) error {
+	var b bytes.Buffer
+	if err := m.body.Execute(&b, map[string]string{
+		"Title": title,
+		"Msg":   msg,
+	}); err != nil {
+		return err
+	}
+
+	req, err := request.New(m.method, m.uri, &b, m.headers)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.DoBody(req)
+	return err
+}
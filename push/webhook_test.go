@@ -0,0 +1,52 @@
+package push
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	var (
+		method, body string
+		header       http.Header
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		method, body, header = r.Method, string(b), r.Header
+	}))
+	defer srv.Close()
+
+	// default payload
+	m, err := NewWebhookFromConfig(map[string]any{"uri": srv.URL})
+	require.NoError(t, err)
+	require.NoError(t, m.Send("title", `say "hi"`))
+
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, `{"title":"title","msg":"say \"hi\""}`, body)
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+
+	// templated payload and headers
+	m, err = NewWebhookFromConfig(map[string]any{
+		"uri":     srv.URL,
+		"method":  "put",
+		"headers": map[string]string{"content-type": "text/plain", "x-token": "secret"},
+		"body":    "{{ .Title | upper }}: {{ .Msg }}",
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Send("title", "msg"))
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "TITLE: msg", body)
+	assert.Equal(t, "text/plain", header.Get("Content-Type"))
+	assert.Equal(t, "secret", header.Get("X-Token"))
+
+	// invalid template
+	_, err = NewWebhookFromConfig(map[string]any{"uri": srv.URL, "body": "{{ .Title"})
+	assert.Error(t, err)
+}
@@ -8,6 +8,9 @@ var (
 
 	// I18n is the embedded i18n file system
 	I18n fs.FS
+
+	// Schema is the embedded file system containing the configuration schema.json
+	Schema fs.FS
 )
 
 // Live indicates assets are passed-through from filesystem
@@ -9,4 +9,5 @@ import (
 func init() {
 	Web = os.DirFS("dist")
 	I18n = os.DirFS("i18n")
+	Schema = os.DirFS(".")
 }
@@ -0,0 +1,250 @@
+package backup
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/util"
+)
+
+const (
+	manifestEntry = "manifest.json"
+	configEntry   = "evcc.yaml"
+	databaseEntry = "evcc.db"
+)
+
+// Manifest describes the archive content
+type Manifest struct {
+	Version string    `json:"version"`
+	Created time.Time `json:"created"`
+	Config  bool      `json:"config"`
+}
+
+// Filename returns the default archive file name
+func Filename() string {
+	return "evcc-backup-" + time.Now().Format("2006-01-02--15-04") + ".zip"
+}
+
+// Write writes an archive containing the config file, if any, and a snapshot of the database.
+// The database contains device configurations, settings including plans and charging sessions.
+func Write(w io.Writer, configFile string) error {
+	if db.FilePath == "" {
+		return errors.New("database not available")
+	}
+
+	tmp, err := os.MkdirTemp("", "evcc-backup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	snapshot := filepath.Join(tmp, databaseEntry)
+	if err := db.Snapshot(snapshot); err != nil {
+		return fmt.Errorf("database snapshot: %w", err)
+	}
+
+	zw := zip.NewWriter(w)
+
+	m := Manifest{
+		Version: util.Version,
+		Created: time.Now(),
+		Config:  configFile != "",
+	}
+
+	mw, err := create(zw, manifestEntry, m.Created)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(mw).Encode(m); err != nil {
+		return err
+	}
+
+	if configFile != "" {
+		if err := addFile(zw, configEntry, configFile, m.Created); err != nil {
+			return err
+		}
+	}
+
+	if err := addFile(zw, databaseEntry, snapshot, m.Created); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func create(zw *zip.Writer, name string, modified time.Time) (io.Writer, error) {
+	return zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+}
+
+func addFile(zw *zip.Writer, name, file string, modified time.Time) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, err := create(zw, name, modified)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// Archive is a backup archive opened for restore
+type Archive struct {
+	Manifest
+	zr *zip.Reader
+}
+
+// Open opens and validates an archive
+func Open(r io.ReaderAt, size int64) (*Archive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+
+	a := &Archive{zr: zr}
+
+	f, err := zr.Open(manifestEntry)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(&a.Manifest); err != nil {
+		return nil, fmt.Errorf("invalid archive manifest: %w", err)
+	}
+
+	if _, err := fs.Stat(zr, databaseEntry); err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+
+	return a, nil
+}
+
+// ReadConfig returns the archived config file
+func (a *Archive) ReadConfig() ([]byte, error) {
+	if !a.Config {
+		return nil, errors.New("archive does not contain a config file")
+	}
+	return fs.ReadFile(a.zr, configEntry)
+}
+
+// Restore restores the config file, if contained and configFile is not empty, and the database.
+// All files are extracted before closeDB is called and the existing files are replaced.
+// Existing files are kept as .bak. If replacing fails, the existing files are restored.
+func (a *Archive) Restore(configFile, dbFile string, closeDB func() error) error {
+	var files []*replacement
+	defer func() {
+		for _, f := range files {
+			os.Remove(f.tmp)
+		}
+	}()
+
+	if a.Config && configFile != "" {
+		tmp, err := a.extract(configEntry, configFile)
+		if err != nil {
+			return fmt.Errorf("config file: %w", err)
+		}
+		files = append(files, &replacement{tmp: tmp, file: configFile})
+	}
+
+	tmp, err := a.extract(databaseEntry, dbFile)
+	if err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+	files = append(files, &replacement{tmp: tmp, file: dbFile})
+
+	// close database to avoid corruption
+	if err := closeDB(); err != nil {
+		return fmt.Errorf("close database: %w", err)
+	}
+
+	for i, f := range files {
+		if err := f.replace(); err != nil {
+			for _, f := range files[:i] {
+				_ = f.rollback()
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extract writes the archive entry to a temp file next to file and returns its name
+func (a *Archive) extract(entry, file string) (string, error) {
+	src, err := a.zr.Open(entry)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return "", err
+	}
+
+	dst, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return "", err
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(dst.Name())
+		return "", err
+	}
+
+	return dst.Name(), nil
+}
+
+// replacement is an extracted file replacing an existing file
+type replacement struct {
+	tmp, file string
+	existed   bool
+}
+
+// replace moves the extracted file in place, keeping an existing file as .bak
+func (r *replacement) replace() error {
+	if _, err := os.Stat(r.file); err == nil {
+		if err := os.Rename(r.file, r.file+".bak"); err != nil {
+			return err
+		}
+		r.existed = true
+	}
+
+	if err := os.Rename(r.tmp, r.file); err != nil {
+		_ = r.rollback()
+		return err
+	}
+
+	return nil
+}
+
+// rollback restores the replaced file
+func (r *replacement) rollback() error {
+	if !r.existed {
+		return os.Remove(r.file)
+	}
+	return os.Rename(r.file+".bak", r.file)
+}
@@ -0,0 +1,77 @@
+package backup
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, db.NewInstance("sqlite", filepath.Join(dir, "evcc.db")))
+	require.NoError(t, db.Instance.Exec("CREATE TABLE foo (bar TEXT)").Error)
+	require.NoError(t, db.Instance.Exec("INSERT INTO foo VALUES ('baz')").Error)
+
+	configFile := filepath.Join(dir, "evcc.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte("interval: 30s\n"), 0o600))
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, configFile))
+
+	a, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.True(t, a.Config)
+
+	target := t.TempDir()
+	targetConfig := filepath.Join(target, "evcc.yaml")
+	targetDB := filepath.Join(target, "db", "evcc.db")
+
+	// existing files are unchanged if the database cannot be closed
+	require.NoError(t, os.WriteFile(targetConfig, []byte("old"), 0o600))
+	require.Error(t, a.Restore(targetConfig, targetDB, func() error { return errors.New("busy") }))
+
+	b, err := os.ReadFile(targetConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(b))
+	assert.NoFileExists(t, targetDB)
+
+	// existing config is kept
+	var closed bool
+	require.NoError(t, a.Restore(targetConfig, targetDB, func() error {
+		closed = true
+		return nil
+	}))
+	assert.True(t, closed)
+
+	b, err = os.ReadFile(targetConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "interval: 30s\n", string(b))
+
+	b, err = os.ReadFile(targetConfig + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(b))
+
+	restored, err := db.New("sqlite", targetDB)
+	require.NoError(t, err)
+
+	var bar string
+	require.NoError(t, restored.Raw("SELECT bar FROM foo").Scan(&bar).Error)
+	assert.Equal(t, "baz", bar)
+
+	// no temp files are left
+	entries, err := os.ReadDir(target)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestOpenInvalid(t *testing.T) {
+	_, err := Open(bytes.NewReader([]byte("foo")), 3)
+	require.Error(t, err)
+}
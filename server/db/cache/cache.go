@@ -17,8 +17,16 @@ type Cache struct {
 	Value string `json:"value"`
 }
 
+// migrations of cache entries, append only
+var migrations = []db.Migration{
+	{Description: "initial schema"},
+}
+
 func Init() error {
-	return db.Instance.AutoMigrate(new(Cache))
+	if err := db.Instance.AutoMigrate(new(Cache)); err != nil {
+		return err
+	}
+	return db.Migrate("cache", migrations...)
 }
 
 func Put(key string, value interface{}) error {
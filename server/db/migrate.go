@@ -0,0 +1,115 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"gorm.io/gorm"
+)
+
+// Migration is a schema or data migration step of persisted state
+type Migration struct {
+	Description string
+	Up          func(tx *gorm.DB) error // nil for baseline versions
+}
+
+// schemaVersion is the migrated version of a domain of persisted state
+type schemaVersion struct {
+	Domain  string `gorm:"primarykey"`
+	Version int
+	App     string // evcc version that applied the last migration
+	Updated time.Time
+}
+
+var (
+	backupMu   sync.Mutex
+	backupFile string
+)
+
+// Migrate applies pending migrations of the given domain in order, where the version is the migration's position starting at 1.
+// The database is backed up before the first migration modifying data. If the database has been migrated by a newer
+// evcc version, the stored state is left untouched.
+func Migrate(domain string, migrations ...Migration) error {
+	log := util.NewLogger("db")
+
+	if err := Instance.AutoMigrate(new(schemaVersion)); err != nil {
+		return err
+	}
+
+	var current schemaVersion
+	if err := Instance.Where(&schemaVersion{Domain: domain}).First(&current).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if current.Version > len(migrations) {
+		log.ERROR.Printf("%s schema version %d written by evcc %s is newer than supported version %d, state may be incomplete", domain, current.Version, current.App, len(migrations))
+		return nil
+	}
+
+	pending := migrations[current.Version:]
+	if len(pending) == 0 {
+		return nil
+	}
+
+	for _, m := range pending {
+		if m.Up != nil {
+			if err := backup(); err != nil {
+				return fmt.Errorf("backup: %w", err)
+			}
+			break
+		}
+	}
+
+	for i, m := range pending {
+		version := current.Version + i + 1
+
+		if err := Instance.Transaction(func(tx *gorm.DB) error {
+			if m.Up != nil {
+				log.INFO.Printf("migrating %s to version %d: %s", domain, version, m.Description)
+				if err := m.Up(tx); err != nil {
+					return err
+				}
+			}
+
+			return tx.Save(&schemaVersion{
+				Domain:  domain,
+				Version: version,
+				App:     util.Version,
+				Updated: time.Now(),
+			}).Error
+		}); err != nil {
+			return fmt.Errorf("%s migration %d: %w", domain, version, err)
+		}
+	}
+
+	return nil
+}
+
+// Snapshot writes a consistent copy of the sqlite database to file
+func Snapshot(file string) error {
+	return Instance.Exec("VACUUM INTO ?", file).Error
+}
+
+// backup creates a copy of the sqlite database once per run
+func backup() error {
+	backupMu.Lock()
+	defer backupMu.Unlock()
+
+	if backupFile != "" || FilePath == "" || strings.HasPrefix(FilePath, ":memory:") {
+		return nil
+	}
+
+	file := fmt.Sprintf("%s.%s.bak", FilePath, time.Now().Format("20060102-150405"))
+	if err := Snapshot(file); err != nil {
+		return err
+	}
+
+	util.NewLogger("db").INFO.Println("created database backup:", file)
+	backupFile = file
+
+	return nil
+}
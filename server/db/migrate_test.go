@@ -0,0 +1,58 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMigrate(t *testing.T) {
+	require.NoError(t, NewInstance("sqlite", filepath.Join(t.TempDir(), "evcc.db")))
+	t.Cleanup(func() { _ = Close() })
+
+	var applied []int
+	step := func(i int) Migration {
+		return Migration{Description: "step", Up: func(tx *gorm.DB) error {
+			applied = append(applied, i)
+			return nil
+		}}
+	}
+
+	version := func() int {
+		var v schemaVersion
+		require.NoError(t, Instance.First(&v, "domain = ?", "test").Error)
+		return v.Version
+	}
+
+	// baseline does not modify data and needs no backup
+	require.NoError(t, Migrate("test", Migration{Description: "initial schema"}))
+	assert.Equal(t, 1, version())
+	assert.Empty(t, backupFile)
+
+	require.NoError(t, Migrate("test", Migration{}, step(2), step(3)))
+	assert.Equal(t, []int{2, 3}, applied)
+	assert.Equal(t, 3, version())
+	assert.FileExists(t, backupFile)
+
+	// already applied
+	require.NoError(t, Migrate("test", Migration{}, step(2), step(3)))
+	assert.Equal(t, []int{2, 3}, applied)
+
+	// downgrade keeps the newer version
+	require.NoError(t, Migrate("test", Migration{}))
+	assert.Equal(t, 3, version())
+
+	// failed migrations are rolled back
+	failed := Migration{Up: func(tx *gorm.DB) error {
+		return errors.New("failed")
+	}}
+	require.Error(t, Migrate("test", Migration{}, step(2), step(3), failed))
+	assert.Equal(t, 3, version())
+
+	require.NoError(t, os.Remove(backupFile))
+}
@@ -33,8 +33,16 @@ var (
 	dirty    int32
 )
 
+// migrations of settings keys, append only
+var migrations = []db.Migration{
+	{Description: "initial schema"},
+}
+
 func Init() error {
 	err := db.Instance.AutoMigrate(new(setting))
+	if err == nil {
+		err = db.Migrate("settings", migrations...)
+	}
 	if err == nil {
 		err = db.Instance.Find(&settings).Error
 	}
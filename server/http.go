@@ -18,6 +18,7 @@ import (
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/auth"
 	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/feature"
 	"github.com/evcc-io/evcc/util/telemetry"
 	"github.com/go-http-utils/etag"
 	"github.com/gorilla/handlers"
@@ -40,10 +41,12 @@ func (r route) Methods() []string {
 // HTTPd wraps an http.Server and adds the root router
 type HTTPd struct {
 	*http.Server
+	hub  *SocketHub
+	auth auth.Auth
 }
 
 // NewHTTPd creates HTTP server with configured routes for loadpoint
-func NewHTTPd(addr string, hub *SocketHub, customCssFile string) *HTTPd {
+func NewHTTPd(addr string, hub *SocketHub, authObject auth.Auth, customCssFile string) *HTTPd {
 	router := mux.NewRouter().StrictSlash(true)
 
 	log := util.NewLogger("httpd")
@@ -57,7 +60,7 @@ func NewHTTPd(addr string, hub *SocketHub, customCssFile string) *HTTPd {
 	})
 
 	// websocket
-	router.HandleFunc("/ws", socketHandler(hub))
+	router.Handle("/ws", ensureUserHandler(authObject)(socketHandler(hub)))
 
 	// static - individual handlers per root and folders
 	static := router.PathPrefix("/").Subrouter()
@@ -105,6 +108,8 @@ func NewHTTPd(addr string, hub *SocketHub, customCssFile string) *HTTPd {
 			IdleTimeout:  120 * time.Second,
 			ErrorLog:     log.ERROR,
 		},
+		hub:  hub,
+		auth: authObject,
 	}
 	srv.SetKeepAlivesEnabled(true)
 
@@ -127,6 +132,7 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, valueChan chan<- util.Param)
 	api.Use(handlers.CORS(
 		handlers.AllowedHeaders([]string{"Content-Type"}),
 	))
+	api.Use(ensureUserHandler(s.auth))
 
 	// site api
 	smartCostLimit := func(lp loadpoint.API, limit *float64) {
@@ -138,14 +144,26 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, valueChan chan<- util.Param)
 
 	routes := map[string]route{
 		"health":                  {"GET", "/health", healthHandler(site)},
+		"allocationpolicy":        {"POST", "/allocationpolicy/{value:[a-z]+}", handler(eapi.AllocationPolicyString, pass(site.SetAllocationPolicy), site.GetAllocationPolicy)},
 		"buffersoc":               {"POST", "/buffersoc/{value:[0-9.]+}", floatHandler(site.SetBufferSoc, site.GetBufferSoc)},
 		"bufferstartsoc":          {"POST", "/bufferstartsoc/{value:[0-9.]+}", floatHandler(site.SetBufferStartSoc, site.GetBufferStartSoc)},
 		"batterydischargecontrol": {"POST", "/batterydischargecontrol/{value:[01truefalse]+}", boolHandler(site.SetBatteryDischargeControl, site.GetBatteryDischargeControl)},
+		"batterypredischarge":     {"POST", "/batterypredischarge/{value:[01truefalse]+}", boolHandler(site.SetBatteryPreDischarge, site.GetBatteryPreDischarge)},
 		"batterygridcharge":       {"POST", "/batterygridchargelimit/{value:-?[0-9.]+}", floatPtrHandler(pass(site.SetBatteryGridChargeLimit), site.GetBatteryGridChargeLimit)},
 		"batterygridchargedelete": {"DELETE", "/batterygridchargelimit", floatPtrHandler(pass(site.SetBatteryGridChargeLimit), site.GetBatteryGridChargeLimit)},
 		"batterymode":             {"POST", "/batterymode/{value:[a-z]+}", updateBatteryMode(site)},
+		"budget":                  {"GET", "/budget", budgetHandler(site)},
+		"budgetupdate":            {"POST", "/budget", updateBudgetHandler(site)},
+		"budgetdelete":            {"DELETE", "/budget", updateBudgetHandler(site)},
 		"batterymodedelete":       {"DELETE", "/batterymode", updateBatteryMode(site)},
+		"exportlimit":             {"POST", "/exportlimit/{value:[0-9.]+}", floatPtrHandler(pass(site.SetExportLimit), site.GetExportLimit)},
+		"exportlimitdelete":       {"DELETE", "/exportlimit", floatPtrHandler(pass(site.SetExportLimit), site.GetExportLimit)},
+		"importlimit":             {"POST", "/importlimit/{value:[0-9.]+}", floatPtrHandler(pass(site.SetImportLimit), site.GetImportLimit)},
+		"importlimitdelete":       {"DELETE", "/importlimit", floatPtrHandler(pass(site.SetImportLimit), site.GetImportLimit)},
 		"prioritysoc":             {"POST", "/prioritysoc/{value:[0-9.]+}", floatHandler(site.SetPrioritySoc, site.GetPrioritySoc)},
+		"loadpointbatch":          {"POST", "/loadpoints/batch", loadpointBatchHandler(site)},
+		"rollout":                 {"POST", "/rollout", startRolloutHandler(site)},
+		"rolloutdelete":           {"DELETE", "/rollout", cancelRolloutHandler(site)},
 		"residualpower":           {"POST", "/residualpower/{value:-?[0-9.]+}", floatHandler(site.SetResidualPower, site.GetResidualPower)},
 		"smartcost":               {"POST", "/smartcostlimit/{value:-?[0-9.]+}", updateSmartCostLimit(site, smartCostLimit)},
 		"smartcostdelete":         {"DELETE", "/smartcostlimit", updateSmartCostLimit(site, smartCostLimit)},
@@ -153,22 +171,46 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, valueChan chan<- util.Param)
 		"smartfeedindelete":       {"DELETE", "/smartfeedinprioritylimit", updateSmartCostLimit(site, smartFeedInPriorityLimit)},
 		"tariff":                  {"GET", "/tariff/{tariff:[a-z]+}", tariffHandler(site)},
 		"sessions":                {"GET", "/sessions", sessionHandler},
+		"sessionconsumption":      {"GET", "/sessions/consumption", sessionConsumptionHandler},
+		"sessionreport":           {"GET", "/sessions/report", sessionReportHandler},
 		"updatesession":           {"PUT", "/session/{id:[0-9]+}", updateSessionHandler},
 		"deletesession":           {"DELETE", "/session/{id:[0-9]+}", deleteSessionHandler},
 		"telemetry2":              {"POST", "/settings/telemetry/{value:[01truefalse]+}", boolHandler(telemetry.Enable, telemetry.Enabled)},
+		"donationpreview":         {"GET", "/donation/preview", donationPreviewHandler},
+		"features":                {"GET", "/features", getHandler(feature.All)},
+		"feature":                 {"POST", "/features/{name:[a-z]+}/{value:[01truefalse]+}", featureHandler},
 	}
 
 	for _, r := range routes {
 		api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
 	}
 
+	// versioned control api for external energy managers, complementing the /api/v1/stream state stream
+	// reading requires the viewer, writing the operator role (see ensureUserHandler)
+	{
+		api := api.PathPrefix("/v1").Subrouter()
+
+		routes := map[string]route{
+			"loadpointbatch": {"POST", "/loadpoints/batch", loadpointBatchHandler(site)},
+			"loadpoint":      {"POST", "/loadpoints/{id:[0-9]+}", loadpointCommandHandler(site)},
+		}
+
+		for _, r := range routes {
+			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
+		}
+	}
+
 	// vehicle api
 	vehicles := map[string]route{
-		"minsoc":         {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/minsoc/{value:[0-9]+}", minSocHandler(site)},
-		"limitsoc":       {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/limitsoc/{value:[0-9]+}", limitSocHandler(site)},
-		"plan":           {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/soc/{value:[0-9]+}/{time:[0-9TZ:.+-]+}", planSocHandler(site)},
-		"plan2":          {"DELETE", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/soc", planSocRemoveHandler(site)},
-		"repeatingPlans": {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/repeating", addRepeatingPlansHandler(site)},
+		"minsoc":          {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/minsoc/{value:[0-9]+}", minSocHandler(site)},
+		"limitsoc":        {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/limitsoc/{value:[0-9]+}", limitSocHandler(site)},
+		"plan":            {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/soc/{value:[0-9]+}/{time:[0-9TZ:.+-]+}", planSocHandler(site)},
+		"plan2":           {"DELETE", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/soc", planSocRemoveHandler(site)},
+		"repeatingPlans":  {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/repeating", addRepeatingPlansHandler(site)},
+		"repeatingPlans2": {"GET", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/plan/repeating", repeatingPlansHandler(site)},
+		"maintenance":     {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/maintenance", maintenanceHandler(site)},
+		"departure":       {"POST", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/departure", departureHandler(site)},
+		"identifiers":     {"DELETE", "/vehicles/{name:[a-zA-Z0-9_.:-]+}/identifiers", identifiersRemoveHandler(site)},
 
 		// config ui
 		// "mode":       {"POST", "/mode/{value:[a-z]+}", chargeModeHandler(v)},
@@ -200,7 +242,11 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, valueChan chan<- util.Param)
 			"planenergy2":               {"DELETE", "/plan/energy", planRemoveHandler(lp)},
 			"vehicle":                   {"POST", "/vehicle/{name:[a-zA-Z0-9_.:-]+}", vehicleSelectHandler(site, lp)},
 			"vehicle2":                  {"DELETE", "/vehicle", vehicleRemoveHandler(lp)},
+			"vehicleIdentify":           {"POST", "/vehicle/{name:[a-zA-Z0-9_.:-]+}/identify", vehicleIdentifyHandler(site, lp)},
 			"vehicleDetect":             {"PATCH", "/vehicle", vehicleDetectHandler(lp)},
+			"journal":                   {"GET", "/journal", journalHandler(lp)},
+			"certificate":               {"POST", "/certificate/{type:(?:V2GRootCertificate|MORootCertificate)}", certificateHandler(lp)},
+			"phaseSwitches":             {"GET", "/phaseswitches", getHandler(lp.GetPhaseSwitchStats)},
 			"enableThreshold":           {"POST", "/enable/threshold/{value:-?[0-9.]+}", floatHandler(pass(lp.SetEnableThreshold), lp.GetEnableThreshold)},
 			"enableDelay":               {"POST", "/enable/delay/{value:[0-9]+}", durationHandler(pass(lp.SetEnableDelay), lp.GetEnableDelay)},
 			"disableThreshold":          {"POST", "/disable/threshold/{value:-?[0-9.]+}", floatHandler(pass(lp.SetDisableThreshold), lp.GetDisableThreshold)},
@@ -220,9 +266,12 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, valueChan chan<- util.Param)
 }
 
 // RegisterSystemHandler provides system level handlers
-func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Param, cache *util.ParamCache, auth auth.Auth, shutdown func(), configFile string) {
+func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Param, cache *util.ParamCache, authObject auth.Auth, shutdown func(), reload func() (ReloadResult, error), configFile string, observer bool) {
 	router := s.Server.Handler.(*mux.Router)
 
+	// event stream, registered outside the api middlewares to allow unbuffered long-lived responses
+	router.Methods("GET").Path("/api/v1/stream").Handler(ensureRoleHandler(authObject, auth.RoleViewer)(http.HandlerFunc(s.hub.ServeEvents)))
+
 	// api
 	api := router.PathPrefix("/api").Subrouter()
 	api.Use(jsonHandler)
@@ -231,6 +280,16 @@ func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Par
 		handlers.AllowedHeaders([]string{"Content-Type"}),
 	))
 
+	// register api routes, in observer mode the configuration is read-only
+	register := func(api *mux.Router, routes map[string]route) {
+		for _, r := range routes {
+			if observer && r.Method != http.MethodGet {
+				continue
+			}
+			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
+		}
+	}
+
 	if site == nil {
 		// If site is nil, create a new empty site. Settings will be loaded during this process and
 		// site meter references and title can be updated using APIs.
@@ -244,7 +303,7 @@ func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Par
 
 	{ // /api
 		routes := map[string]route{
-			"state": {"GET", "/state", stateHandler(cache)},
+			"state": {"GET", "/state", ensureUserHandler(authObject)(stateHandler(cache)).ServeHTTP},
 		}
 
 		for _, r := range routes {
@@ -257,10 +316,26 @@ func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Par
 		api := api.PathPrefix("/auth").Subrouter()
 
 		routes := map[string]route{
-			"password": {"PUT", "/password", updatePasswordHandler(auth)},
-			"auth":     {"GET", "/status", authStatusHandler(auth)},
-			"login":    {"POST", "/login", loginHandler(auth)},
+			"password": {"PUT", "/password", updatePasswordHandler(authObject)},
+			"auth":     {"GET", "/status", authStatusHandler(authObject)},
+			"login":    {"POST", "/login", loginHandler(authObject)},
 			"logout":   {"POST", "/logout", logoutHandler},
+			"role":     {"GET", "/role", authRoleHandler(authObject)},
+		}
+
+		for _, r := range routes {
+			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
+		}
+	}
+
+	{ // api/auth/users
+		api := api.PathPrefix("/auth/users").Subrouter()
+		api.Use(ensureAuthHandler(authObject))
+
+		routes := map[string]route{
+			"users":      {"GET", "", usersHandler(authObject)},
+			"updateuser": {"PUT", "/{name:[a-zA-Z0-9_.@-]+}", updateUserHandler(authObject)},
+			"deleteuser": {"DELETE", "/{name:[a-zA-Z0-9_.@-]+}", deleteUserHandler(authObject)},
 		}
 
 		for _, r := range routes {
@@ -270,24 +345,30 @@ func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Par
 
 	{ // api/config
 		api := api.PathPrefix("/config").Subrouter()
-		api.Use(ensureAuthHandler(auth))
+		api.Use(ensureAuthHandler(authObject))
 
 		routes := map[string]route{
-			"templates":          {"GET", "/templates/{class:[a-z]+}", templatesHandler},
-			"products":           {"GET", "/products/{class:[a-z]+}", productsHandler},
-			"devices":            {"GET", "/devices/{class:[a-z]+}", devicesConfigHandler},
-			"device":             {"GET", "/devices/{class:[a-z]+}/{id:[0-9.]+}", deviceConfigHandler},
-			"devicestatus":       {"GET", "/devices/{class:[a-z]+}/{name:[a-zA-Z0-9_.:-]+}/status", deviceStatusHandler},
-			"dirty":              {"GET", "/dirty", getHandler(ConfigDirty)},
-			"evccyaml":           {"GET", "/evcc.yaml", configYamlHandler(configFile)},
-			"newdevice":          {"POST", "/devices/{class:[a-z]+}", newDeviceHandler},
-			"updatedevice":       {"PUT", "/devices/{class:[a-z]+}/{id:[0-9.]+}", updateDeviceHandler},
-			"deletedevice":       {"DELETE", "/devices/{class:[a-z]+}/{id:[0-9.]+}", deleteDeviceHandler(site)},
-			"testconfig":         {"POST", "/test/{class:[a-z]+}", testConfigHandler},
-			"testmerged":         {"POST", "/test/{class:[a-z]+}/merge/{id:[0-9.]+}", testConfigHandler},
-			"interval":           {"POST", "/interval/{value:[0-9.]+}", settingsSetDurationHandler(keys.Interval)},
-			"updatesponsortoken": {"POST", "/sponsortoken", updateSponsortokenHandler},
-			"deletesponsortoken": {"DELETE", "/sponsortoken", deleteSponsorTokenHandler},
+			"templates":             {"GET", "/templates/{class:[a-z]+}", templatesHandler},
+			"products":              {"GET", "/products/{class:[a-z]+}", productsHandler},
+			"devices":               {"GET", "/devices/{class:[a-z]+}", devicesConfigHandler},
+			"device":                {"GET", "/devices/{class:[a-z]+}/{id:[0-9.]+}", deviceConfigHandler},
+			"devicestatus":          {"GET", "/devices/{class:[a-z]+}/{name:[a-zA-Z0-9_.:-]+}/status", deviceStatusHandler},
+			"chargerbackup":         {"GET", "/devices/charger/{name:[a-zA-Z0-9_.:-]+}/backup", chargerBackupHandler},
+			"updatechargerbackup":   {"POST", "/devices/charger/{name:[a-zA-Z0-9_.:-]+}/backup", chargerBackupUpdateHandler},
+			"restorecharger":        {"POST", "/devices/charger/{name:[a-zA-Z0-9_.:-]+}/restore", chargerRestoreHandler},
+			"dirty":                 {"GET", "/dirty", getHandler(ConfigDirty)},
+			"evccyaml":              {"GET", "/evcc.yaml", configYamlHandler(configFile)},
+			"schema":                {"GET", "/schema", configSchemaHandler},
+			"newdevice":             {"POST", "/devices/{class:[a-z]+}", newDeviceHandler},
+			"updatedevice":          {"PUT", "/devices/{class:[a-z]+}/{id:[0-9.]+}", updateDeviceHandler},
+			"deletedevice":          {"DELETE", "/devices/{class:[a-z]+}/{id:[0-9.]+}", deleteDeviceHandler(site)},
+			"testconfig":            {"POST", "/test/{class:[a-z]+}", testConfigHandler},
+			"testmerged":            {"POST", "/test/{class:[a-z]+}/merge/{id:[0-9.]+}", testConfigHandler},
+			"interval":              {"POST", "/interval/{value:[0-9.]+}", settingsSetDurationHandler(keys.Interval)},
+			"updatesponsortoken":    {"POST", "/sponsortoken", updateSponsortokenHandler},
+			"updatemessagingevents": {"PUT", "/messaging/events/{id:[a-z0-9-]+}", settingsMessagingEventsHandler(valueChan)},
+			"deletemessagingevents": {"DELETE", "/messaging/events/{id:[a-z0-9-]+}", settingsMessagingEventsHandler(valueChan)},
+			"deletesponsortoken":    {"DELETE", "/sponsortoken", deleteSponsorTokenHandler},
 		}
 
 		// yaml handlers
@@ -316,50 +397,62 @@ func (s *HTTPd) RegisterSystemHandler(site *core.Site, valueChan chan<- util.Par
 			routes["delete"+key] = route{Method: "DELETE", Pattern: "/" + key, HandlerFunc: settingsDeleteJsonHandler(key, valueChan, fun())}
 		}
 
-		for _, r := range routes {
-			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
-		}
+		register(api, routes)
 
 		// site
-		for _, r := range map[string]route{
+		register(api, map[string]route{
 			"site":       {"GET", "/site", siteHandler(site)},
 			"updatesite": {"PUT", "/site", updateSiteHandler(site)},
-		} {
-			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
-		}
+		})
 
 		// loadpoints
-		for _, r := range map[string]route{
+		register(api, map[string]route{
 			"loadpoints":      {"GET", "/loadpoints", loadpointsConfigHandler()},
 			"loadpoint":       {"GET", "/loadpoints/{id:[0-9.]+}", loadpointConfigHandler()},
 			"updateloadpoint": {"PUT", "/loadpoints/{id:[0-9.]+}", updateLoadpointHandler()},
 			"deleteloadpoint": {"DELETE", "/loadpoints/{id:[0-9.]+}", deleteLoadpointHandler()},
 			"newloadpoint":    {"POST", "/loadpoints", newLoadpointHandler()},
-		} {
-			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
+		})
+	}
+
+	{ // api/override
+		api := api.PathPrefix("/override").Subrouter()
+		api.Use(ensureAuthHandler(authObject))
+
+		routes := map[string]route{
+			"grid":       {"POST", "/grid/{value:-?[0-9.]+}", overrideHandler(site.SetGridPowerOverride)},
+			"griddelete": {"DELETE", "/grid", overrideHandler(site.SetGridPowerOverride)},
 		}
+
+		for id, lp := range site.Loadpoints() {
+			routes[fmt.Sprintf("current%d", id)] = route{"POST", fmt.Sprintf("/loadpoints/%d/current/{value:[0-9.]+}", id+1), overrideHandler(lp.SetCurrentOverride)}
+			routes[fmt.Sprintf("currentdelete%d", id)] = route{"DELETE", fmt.Sprintf("/loadpoints/%d/current", id+1), overrideHandler(lp.SetCurrentOverride)}
+		}
+
+		register(api, routes)
 	}
 
 	{ // api/system
 		api := api.PathPrefix("/system").Subrouter()
-		api.Use(ensureAuthHandler(auth))
+		api.Use(ensureAuthHandler(authObject))
 
 		// system api
 		routes := map[string]route{
-			"log":        {"GET", "/log", logHandler},
-			"logareas":   {"GET", "/log/areas", logAreasHandler},
-			"clearcache": {"DELETE", "/cache", clearCacheHandler},
-			"backup":     {"POST", "/backup", getBackup(auth)},
-			"restore":    {"POST", "/restore", restoreDatabase(auth, shutdown)},
-			"reset":      {"POST", "/reset", resetDatabase(auth, shutdown)},
+			"log":            {"GET", "/log", logHandler},
+			"logareas":       {"GET", "/log/areas", logAreasHandler},
+			"clearcache":     {"DELETE", "/cache", clearCacheHandler},
+			"backup":         {"POST", "/backup", getBackup(authObject)},
+			"restore":        {"POST", "/restore", restoreDatabase(authObject, shutdown)},
+			"archive":        {"POST", "/backup/archive", getBackupArchive(authObject, configFile)},
+			"restorearchive": {"POST", "/restore/archive", restoreBackupArchive(authObject, configFile, shutdown)},
+			"reset":          {"POST", "/reset", resetDatabase(authObject, shutdown)},
+			"reload":         {"POST", "/reload", reloadHandler(reload)},
 			"shutdown": {"POST", "/shutdown", func(w http.ResponseWriter, r *http.Request) {
 				shutdown()
 				w.WriteHeader(http.StatusNoContent)
 			}},
 		}
 
-		for _, r := range routes {
-			api.Methods(r.Methods()...).Path(r.Pattern).Handler(r.HandlerFunc)
-		}
+		register(api, routes)
 	}
 }
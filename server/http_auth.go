@@ -18,9 +18,15 @@ type updatePasswordRequest struct {
 }
 
 type loginRequest struct {
+	Username string `json:"username"`
 	Password string `json:"password"`
 }
 
+type userRequest struct {
+	Password string    `json:"password"`
+	Role     auth.Role `json:"role"`
+}
+
 func updatePasswordHandler(authObject auth.Auth) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if authObject.GetAuthMode() == auth.Locked {
@@ -75,11 +81,14 @@ func jwtFromRequest(r *http.Request) string {
 	return ""
 }
 
-// authStatusHandler login status (true/false) based on jwt token. Error if admin password is not configured
+// authStatusHandler returns the role of the logged in user based on the jwt token, empty if not logged in.
+// Error if admin password is not configured
 func authStatusHandler(authObject auth.Auth) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
 		if authObject.GetAuthMode() == auth.Disabled {
-			w.Write([]byte("true"))
+			jsonWrite(w, auth.RoleAdmin)
 			return
 		}
 
@@ -93,13 +102,9 @@ func authStatusHandler(authObject auth.Auth) http.HandlerFunc {
 			return
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-		ok, err := authObject.ValidateJwtToken(jwtFromRequest(r))
-		if err != nil || !ok {
-			w.Write([]byte("false"))
-			return
-		}
-		w.Write([]byte("true"))
+		// empty role if not logged in
+		role, _ := authObject.JwtRole(jwtFromRequest(r))
+		jsonWrite(w, role)
 	}
 }
 
@@ -116,13 +121,13 @@ func loginHandler(authObject auth.Auth) http.HandlerFunc {
 			return
 		}
 
-		if !authObject.IsAdminPasswordValid(req.Password) {
+		if !authObject.IsUserPasswordValid(req.Username, req.Password) {
 			http.Error(w, "Invalid password", http.StatusUnauthorized)
 			return
 		}
 
 		lifetime := time.Hour * 24 * 90 // 90 day valid
-		tokenString, err := authObject.GenerateJwtToken(lifetime)
+		tokenString, err := authObject.GenerateUserJwtToken(req.Username, lifetime)
 		if err != nil {
 			http.Error(w, "Failed to generate JWT token.", http.StatusInternalServerError)
 			return
@@ -147,7 +152,13 @@ func logoutHandler(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ensureAuthHandler requires admin access
 func ensureAuthHandler(authObject auth.Auth) mux.MiddlewareFunc {
+	return ensureRoleHandler(authObject, auth.RoleAdmin)
+}
+
+// ensureRoleHandler requires a token granting the given role
+func ensureRoleHandler(authObject auth.Auth, role auth.Role) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if authObject.GetAuthMode() == auth.Disabled {
@@ -161,8 +172,8 @@ func ensureAuthHandler(authObject auth.Auth) mux.MiddlewareFunc {
 			}
 
 			// check jwt token
-			ok, err := authObject.ValidateJwtToken(jwtFromRequest(r))
-			if !ok || err != nil {
+			res, err := authObject.JwtRole(jwtFromRequest(r))
+			if err != nil || !res.Includes(role) {
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
@@ -172,3 +183,86 @@ func ensureAuthHandler(authObject auth.Auth) mux.MiddlewareFunc {
 		})
 	}
 }
+
+// ensureUserHandler requires viewer access for reading and operator access for
+// modifying requests once user accounts are configured. Without user accounts,
+// access is unrestricted. If the user accounts cannot be read, the request fails.
+func ensureUserHandler(authObject auth.Auth) mux.MiddlewareFunc {
+	viewer := ensureRoleHandler(authObject, auth.RoleViewer)
+	operator := ensureRoleHandler(authObject, auth.RoleOperator)
+
+	return func(next http.Handler) http.Handler {
+		viewer, operator := viewer(next), operator(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hasUsers, err := authObject.HasUsers()
+
+			switch {
+			case err != nil:
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			case !hasUsers:
+				next.ServeHTTP(w, r)
+			case r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions:
+				viewer.ServeHTTP(w, r)
+			default:
+				operator.ServeHTTP(w, r)
+			}
+		})
+	}
+}
+
+// authRoleHandler returns the role of the logged in user, empty if not logged in
+func authRoleHandler(authObject auth.Auth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if authObject.GetAuthMode() == auth.Disabled {
+			jsonWrite(w, auth.RoleAdmin)
+			return
+		}
+
+		role, _ := authObject.JwtRole(jwtFromRequest(r))
+		jsonWrite(w, role)
+	}
+}
+
+// usersHandler returns the user accounts
+func usersHandler(authObject auth.Auth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := authObject.Users()
+		if err != nil {
+			jsonError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		jsonWrite(w, res)
+	}
+}
+
+// updateUserHandler creates or updates a user account
+func updateUserHandler(authObject auth.Auth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req userRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := authObject.SetUser(mux.Vars(r)["name"], req.Password, req.Role); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, true)
+	}
+}
+
+// deleteUserHandler removes a user account
+func deleteUserHandler(authObject auth.Auth) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := authObject.RemoveUser(mux.Vars(r)["name"]); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, true)
+	}
+}
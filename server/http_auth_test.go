@@ -0,0 +1,103 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEnsureUserHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := map[string]string{keys.JwtSecret: "somesecret"}
+	mock := settings.NewMockAPI(ctrl)
+	mock.EXPECT().String(gomock.Any()).DoAndReturn(func(key string) (string, error) { return store[key], nil }).AnyTimes()
+	mock.EXPECT().SetString(gomock.Any(), gomock.Any()).Do(func(key, val string) { store[key] = val }).AnyTimes()
+
+	authObject := auth.NewMock(mock)
+	handler := ensureUserHandler(authObject)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	status := func(method, token string) int {
+		req := httptest.NewRequest(method, "/", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// unrestricted without user accounts
+	assert.Equal(t, http.StatusOK, status(http.MethodPost, ""))
+
+	require.NoError(t, authObject.SetUser("viewer", "secret", auth.RoleViewer))
+	require.NoError(t, authObject.SetUser("operator", "secret", auth.RoleOperator))
+
+	viewer, err := authObject.GenerateUserJwtToken("viewer", time.Hour)
+	require.NoError(t, err)
+	operator, err := authObject.GenerateUserJwtToken("operator", time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, status(http.MethodGet, ""))
+	assert.Equal(t, http.StatusOK, status(http.MethodGet, viewer))
+	assert.Equal(t, http.StatusUnauthorized, status(http.MethodPost, viewer))
+	assert.Equal(t, http.StatusOK, status(http.MethodPost, operator))
+
+	// admin is not affected by user accounts
+	admin, err := authObject.GenerateJwtToken(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status(http.MethodDelete, admin))
+
+	// invalid user accounts fail the request
+	store[keys.Users] = "{"
+	assert.Equal(t, http.StatusInternalServerError, status(http.MethodGet, ""))
+	assert.Equal(t, http.StatusInternalServerError, status(http.MethodGet, viewer))
+	assert.Equal(t, http.StatusInternalServerError, status(http.MethodPost, admin))
+}
+
+func TestAuthStatusHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	store := map[string]string{keys.JwtSecret: "somesecret"}
+	mock := settings.NewMockAPI(ctrl)
+	mock.EXPECT().String(gomock.Any()).DoAndReturn(func(key string) (string, error) { return store[key], nil }).AnyTimes()
+	mock.EXPECT().SetString(gomock.Any(), gomock.Any()).Do(func(key, val string) { store[key] = val }).AnyTimes()
+
+	authObject := auth.NewMock(mock)
+	require.NoError(t, authObject.SetAdminPassword("secret"))
+	require.NoError(t, authObject.SetUser("viewer", "secret", auth.RoleViewer))
+
+	handler := authStatusHandler(authObject)
+
+	status := func(token string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		return strings.TrimSpace(rec.Body.String())
+	}
+
+	assert.Equal(t, `""`, status(""))
+
+	viewer, err := authObject.GenerateUserJwtToken("viewer", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, `"viewer"`, status(viewer))
+
+	admin, err := authObject.GenerateJwtToken(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, `"admin"`, status(admin))
+}
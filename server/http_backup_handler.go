@@ -0,0 +1,85 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/evcc-io/evcc/server/backup"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util/auth"
+)
+
+// getBackupArchive returns an archive of config file and database
+func getBackupArchive(authObject auth.Auth, configFile string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !adminPasswordValid(authObject, req.Password) {
+			http.Error(w, "Invalid password", http.StatusUnauthorized)
+			return
+		}
+
+		if err := settings.Persist(); err != nil {
+			http.Error(w, "Could not persist settings: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+backup.Filename()+`"`)
+
+		if err := backup.Write(w, configFile); err != nil {
+			http.Error(w, "Could not create archive: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// restoreBackupArchive restores config file and database from an archive and restarts.
+// Without config file, e.g. in database-only mode, only the database is restored.
+// Existing files remain unchanged if the archive cannot be extracted.
+func restoreBackupArchive(authObject auth.Auth, configFile string, shutdown func()) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			http.Error(w, "Failed to parse form: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !adminPasswordValid(authObject, r.FormValue("password")) {
+			http.Error(w, "Invalid password", http.StatusUnauthorized)
+			return
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "Failed to get uploaded file: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		archive, err := backup.Open(file, header.Size)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// without database evcc cannot continue once it has been closed
+		var closed bool
+		if err := archive.Restore(configFile, db.FilePath, func() error {
+			closed = true
+			return db.Close()
+		}); err != nil {
+			http.Error(w, "Failed to restore backup: "+err.Error(), http.StatusInternalServerError)
+			if closed {
+				shutdown()
+			}
+			return
+		}
+
+		shutdown()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
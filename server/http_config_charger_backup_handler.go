@@ -0,0 +1,83 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evcc-io/evcc/core/chargerconfig"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/gorilla/mux"
+)
+
+// chargerBackupHandler returns the charger's configuration snapshot and its differences to the current configuration
+func chargerBackupHandler(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	instance, err := deviceStatus(name, config.Chargers())
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	snapshot, err := chargerconfig.Load(name)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, settings.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		jsonError(w, status, err)
+		return
+	}
+
+	res := struct {
+		chargerconfig.Snapshot
+		Changes []chargerconfig.Change `json:"changes"`
+	}{
+		Snapshot: snapshot,
+	}
+
+	if current, err := chargerconfig.Current(instance); err == nil {
+		res.Changes = chargerconfig.Diff(snapshot.Config, current)
+	}
+
+	jsonWrite(w, res)
+}
+
+// chargerBackupUpdateHandler stores a new configuration snapshot of the charger
+func chargerBackupUpdateHandler(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	instance, err := deviceStatus(name, config.Chargers())
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	changes, err := chargerconfig.Backup(name, instance)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonWrite(w, changes)
+}
+
+// chargerRestoreHandler restores the charger's configuration from the snapshot
+func chargerRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	instance, err := deviceStatus(name, config.Chargers())
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	changes, err := chargerconfig.Restore(name, instance)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonWrite(w, changes)
+}
@@ -314,7 +314,7 @@ func newDeviceHandler(w http.ResponseWriter, r *http.Request) {
 	// prevent context from being cancelled
 	close(done)
 
-	setConfigDirty()
+	SetConfigDirty()
 
 	res := struct {
 		ID   int    `json:"id"`
@@ -386,7 +386,7 @@ func updateDeviceHandler(w http.ResponseWriter, r *http.Request) {
 		}, config.Circuits(), force)
 	}
 
-	setConfigDirty()
+	SetConfigDirty()
 
 	if err != nil {
 		cancel()
@@ -507,6 +507,9 @@ func deleteDeviceHandler(site site.API) func(w http.ResponseWriter, r *http.Requ
 				if lp.GetMeterRef() == name {
 					lp.SetMeterRef("")
 				}
+				if lp.GetBillingMeterRef() == name {
+					lp.SetBillingMeterRef("")
+				}
 			}
 
 		case templates.Vehicle:
@@ -532,7 +535,7 @@ func deleteDeviceHandler(site site.API) func(w http.ResponseWriter, r *http.Requ
 			}
 		}
 
-		setConfigDirty()
+		SetConfigDirty()
 
 		if err != nil {
 			jsonError(w, http.StatusBadRequest, err)
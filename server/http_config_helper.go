@@ -15,6 +15,7 @@ import (
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/diagnostics"
 	"github.com/evcc-io/evcc/util/templates"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/samber/lo"
@@ -88,8 +89,8 @@ func ConfigDirty() bool {
 	return dirty
 }
 
-// setConfigDirty sets the dirty flag indicating that a restart is required
-func setConfigDirty() {
+// SetConfigDirty sets the dirty flag indicating that a restart is required
+func SetConfigDirty() {
 	mu.Lock()
 	defer mu.Unlock()
 
@@ -314,6 +315,12 @@ func testInstance(instance any) map[string]testResult {
 		makeResult("identifier", val, err)
 	}
 
+	if vals, err := diagnostics.Values(instance); err == nil {
+		for k, v := range vals {
+			makeResult(k, v, nil)
+		}
+	}
+
 	return res
 }
 
@@ -19,10 +19,11 @@ import (
 
 func getLoadpointStaticConfig(lp loadpoint.API) loadpoint.StaticConfig {
 	return loadpoint.StaticConfig{
-		Charger: lp.GetChargerRef(),
-		Meter:   lp.GetMeterRef(),
-		Circuit: lp.GetCircuitRef(),
-		Vehicle: lp.GetDefaultVehicleRef(),
+		Charger:      lp.GetChargerRef(),
+		Meter:        lp.GetMeterRef(),
+		BillingMeter: lp.GetBillingMeterRef(),
+		Circuit:      lp.GetCircuitRef(),
+		Vehicle:      lp.GetDefaultVehicleRef(),
 	}
 }
 
@@ -184,7 +185,7 @@ func newLoadpointHandler() http.HandlerFunc {
 			return
 		}
 
-		setConfigDirty()
+		SetConfigDirty()
 
 		w.WriteHeader(http.StatusOK)
 	}
@@ -243,7 +244,7 @@ func updateLoadpointHandler() http.HandlerFunc {
 			return
 		}
 
-		setConfigDirty()
+		SetConfigDirty()
 
 		w.WriteHeader(http.StatusOK)
 	}
@@ -277,7 +278,7 @@ func deleteLoadpointHandler() http.HandlerFunc {
 				return
 			}
 
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		if dev, err := configurableDevice(instance.GetMeterRef(), config.Meters()); err == nil {
@@ -286,10 +287,10 @@ func deleteLoadpointHandler() http.HandlerFunc {
 				return
 			}
 
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
-		setConfigDirty()
+		SetConfigDirty()
 
 		if err := deleteDevice(id, h); err != nil {
 			jsonError(w, http.StatusBadRequest, err)
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+
+	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/meter"
+	"github.com/evcc-io/evcc/server/assets"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/evcc-io/evcc/util/templates"
+	"github.com/evcc-io/evcc/vehicle"
+)
+
+// schemaObject returns the nested schema object at the given path
+func schemaObject(schema map[string]any, path ...string) (map[string]any, error) {
+	res := schema
+	for _, key := range path {
+		v, ok := res[key].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid schema: missing %s", key)
+		}
+		res = v
+	}
+	return res, nil
+}
+
+// configSchema returns the configuration schema extended by all plugin types and device templates
+func configSchema(lang string) (map[string]any, error) {
+	b, err := fs.ReadFile(assets.Schema, "schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]any
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+
+	definitions, err := schemaObject(res, "definitions")
+	if err != nil {
+		return nil, err
+	}
+
+	for class, types := range map[templates.Class][]string{
+		templates.Charger: charger.Types(),
+		templates.Meter:   meter.Types(),
+		templates.Vehicle: vehicle.Types(),
+		templates.Tariff:  tariff.Types(),
+	} {
+		definitions[class.String()] = templates.Schema(class, types, lang)
+	}
+
+	ref := func(base, class string) []any {
+		return []any{
+			map[string]any{"$ref": "#/definitions/" + base},
+			map[string]any{"$ref": "#/definitions/" + class},
+		}
+	}
+
+	// named devices
+	for key, class := range map[string]templates.Class{
+		"chargers": templates.Charger,
+		"meters":   templates.Meter,
+		"vehicles": templates.Vehicle,
+	} {
+		items, err := schemaObject(res, "properties", key, "items")
+		if err != nil {
+			return nil, err
+		}
+
+		delete(items, "$ref")
+		items["allOf"] = ref("namedObject", class.String())
+	}
+
+	// tariffs
+	tariffs, err := schemaObject(res, "properties", "tariffs", "properties")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"grid", "feedin", "co2", "planner"} {
+		t, ok := tariffs[key].(map[string]any)
+		if !ok {
+			t = make(map[string]any)
+			tariffs[key] = t
+		}
+
+		delete(t, "$ref")
+		t["allOf"] = ref("typedObject", templates.Tariff.String())
+	}
+
+	tariffs["solar"] = map[string]any{
+		"type": "array",
+		"items": map[string]any{
+			"allOf": ref("typedObject", templates.Tariff.String()),
+		},
+	}
+
+	return res, nil
+}
+
+// configSchemaHandler returns the configuration JSON schema
+func configSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := configSchema(getLang(r))
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonWrite(w, res)
+}
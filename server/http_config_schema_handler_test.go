@@ -0,0 +1,33 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/evcc-io/evcc/server/assets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigSchema(t *testing.T) {
+	assets.Schema = os.DirFS("..")
+
+	res, err := configSchema("en")
+	require.NoError(t, err)
+
+	charger, err := schemaObject(res, "definitions", "charger")
+	require.NoError(t, err)
+
+	oneOf, ok := charger["oneOf"].([]any)
+	require.True(t, ok)
+	assert.Greater(t, len(oneOf), 1, "templates")
+
+	items, err := schemaObject(res, "properties", "chargers", "items")
+	require.NoError(t, err)
+	assert.NotContains(t, items, "$ref")
+	assert.Contains(t, items, "allOf")
+
+	grid, err := schemaObject(res, "properties", "tariffs", "properties", "grid")
+	require.NoError(t, err)
+	assert.Equal(t, "Grid tariff", grid["description"])
+}
@@ -67,7 +67,7 @@ func updateSiteHandler(site site.API) http.HandlerFunc {
 			}
 
 			site.SetGridMeterRef(*payload.Grid)
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		if payload.PV != nil {
@@ -76,7 +76,7 @@ func updateSiteHandler(site site.API) http.HandlerFunc {
 			}
 
 			site.SetPVMeterRefs(*payload.PV)
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		if payload.Battery != nil {
@@ -85,7 +85,7 @@ func updateSiteHandler(site site.API) http.HandlerFunc {
 			}
 
 			site.SetBatteryMeterRefs(*payload.Battery)
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		if payload.Aux != nil {
@@ -94,7 +94,7 @@ func updateSiteHandler(site site.API) http.HandlerFunc {
 			}
 
 			site.SetAuxMeterRefs(*payload.Aux)
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		if payload.Ext != nil {
@@ -103,7 +103,7 @@ func updateSiteHandler(site site.API) http.HandlerFunc {
 			}
 
 			site.SetExtMeterRefs(*payload.Ext)
-			setConfigDirty()
+			SetConfigDirty()
 		}
 
 		status := map[bool]int{false: http.StatusOK, true: http.StatusAccepted}
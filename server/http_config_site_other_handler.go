@@ -29,13 +29,13 @@ func updateSponsortokenHandler(w http.ResponseWriter, r *http.Request) {
 
 	// TODO find better place
 	settings.SetString(keys.SponsorToken, req.Token)
-	setConfigDirty()
+	SetConfigDirty()
 
 	jsonWrite(w, sponsor.Status())
 }
 
 func deleteSponsorTokenHandler(w http.ResponseWriter, r *http.Request) {
 	settings.SetString(keys.SponsorToken, "")
-	setConfigDirty()
+	SetConfigDirty()
 	jsonWrite(w, true)
 }
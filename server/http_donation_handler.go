@@ -0,0 +1,18 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/evcc-io/evcc/core/donation"
+)
+
+// donationPreviewHandler returns the anonymized report exactly as it would be shared
+func donationPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := donation.Preview()
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonWrite(w, res)
+}
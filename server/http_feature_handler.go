@@ -0,0 +1,27 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/evcc-io/evcc/util/feature"
+	"github.com/gorilla/mux"
+)
+
+// featureHandler enables or disables an experimental feature
+func featureHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	enable, err := strconv.ParseBool(vars["value"])
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := feature.Enable(feature.Flag(vars["name"]), enable); err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonWrite(w, feature.All())
+}
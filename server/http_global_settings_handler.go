@@ -8,6 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/evcc-io/evcc/api/globalconfig"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server/db/settings"
 	"github.com/evcc-io/evcc/util"
 	"github.com/gorilla/mux"
@@ -39,7 +42,7 @@ func settingsSetDurationHandler(key string) http.HandlerFunc {
 		}
 
 		settings.SetInt(key, int64(time.Second*time.Duration(val)))
-		setConfigDirty()
+		SetConfigDirty()
 
 		jsonWrite(w, val)
 	}
@@ -65,7 +68,7 @@ func settingsSetYamlHandler(key string, other, struc any) http.HandlerFunc {
 
 		val := strings.TrimSpace(string(b))
 		settings.SetString(key, val)
-		setConfigDirty()
+		SetConfigDirty()
 
 		jsonWrite(w, val)
 	}
@@ -90,7 +93,7 @@ func settingsSetJsonHandler(key string, valueChan chan<- util.Param, newStruc fu
 		}
 
 		settings.SetJson(key, struc)
-		setConfigDirty()
+		SetConfigDirty()
 
 		valueChan <- util.Param{Key: key, Val: struc}
 
@@ -101,10 +104,54 @@ func settingsSetJsonHandler(key string, valueChan chan<- util.Param, newStruc fu
 func settingsDeleteJsonHandler(key string, valueChan chan<- util.Param, struc any) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		settings.SetString(key, "")
-		setConfigDirty()
+		SetConfigDirty()
 
 		valueChan <- util.Param{Key: key, Val: struc}
 
 		jsonWrite(w, true)
 	}
 }
+
+// settingsMessagingEventsHandler updates or deletes the event templates of a single messaging service
+func settingsMessagingEventsHandler(valueChan chan<- util.Param) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		var events map[string]push.EventTemplateConfig
+		if r.Method != http.MethodDelete {
+			if err := json.NewDecoder(r.Body).Decode(&events); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+
+			if err := push.ValidateTemplates(events); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		res := make(globalconfig.MessagingEvents)
+		if settings.Exists(keys.MessagingEvents) {
+			if err := settings.Json(keys.MessagingEvents, &res); err != nil {
+				jsonError(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
+		if len(events) == 0 {
+			delete(res, id)
+		} else {
+			res[id] = events
+		}
+
+		if err := settings.SetJson(keys.MessagingEvents, res); err != nil {
+			jsonError(w, http.StatusInternalServerError, err)
+			return
+		}
+		SetConfigDirty()
+
+		valueChan <- util.Param{Key: keys.MessagingEvents, Val: res}
+
+		jsonWrite(w, true)
+	}
+}
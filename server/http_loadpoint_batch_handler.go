@@ -0,0 +1,334 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/core/vehicle"
+	"github.com/gorilla/mux"
+)
+
+// batchPlanEnergy is an energy plan applied to multiple loadpoints
+type batchPlanEnergy struct {
+	Energy       float64   `json:"energy"`
+	Time         time.Time `json:"time"`
+	Precondition int64     `json:"precondition"`
+}
+
+// batchPlanSoc is a soc plan applied to the vehicles of multiple loadpoints
+type batchPlanSoc struct {
+	Soc          int       `json:"soc"`
+	Time         time.Time `json:"time"`
+	Precondition int64     `json:"precondition"`
+}
+
+// batchRequest is a set of loadpoint settings applied to multiple loadpoints
+type batchRequest struct {
+	Loadpoints  []int            `json:"loadpoints"` // loadpoint ids, empty for all
+	Mode        *string          `json:"mode"`
+	LimitSoc    *int             `json:"limitSoc"`
+	LimitEnergy *float64         `json:"limitEnergy"`
+	MinCurrent  *float64         `json:"minCurrent"`
+	MaxCurrent  *float64         `json:"maxCurrent"`
+	Phases      *int             `json:"phases"`
+	Priority    *int             `json:"priority"`
+	PlanEnergy  *batchPlanEnergy `json:"planEnergy"`
+	PlanSoc     *batchPlanSoc    `json:"planSoc"`
+}
+
+// batchVehicle returns the vehicle settings used for soc plans
+var batchVehicle = func(v api.Vehicle) vehicle.API {
+	return vehicle.Settings(log, v)
+}
+
+// validate checks the request for the given number of loadpoints and returns the selected loadpoint ids
+func (req *batchRequest) validate(count int, now time.Time) ([]int, error) {
+	var errs []error
+
+	ids := req.Loadpoints
+	if len(ids) == 0 {
+		for id := range count {
+			ids = append(ids, id+1)
+		}
+	}
+
+	for _, id := range ids {
+		if id < 1 || id > count {
+			errs = append(errs, fmt.Errorf("invalid loadpoint: %d", id))
+		}
+	}
+
+	if len(ids) != len(slices.Compact(slices.Sorted(slices.Values(ids)))) {
+		errs = append(errs, errors.New("duplicate loadpoint"))
+	}
+
+	if req.Mode != nil {
+		if _, err := api.ChargeModeString(*req.Mode); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if req.LimitSoc != nil && (*req.LimitSoc < 0 || *req.LimitSoc > 100) {
+		errs = append(errs, fmt.Errorf("invalid limit soc: %d", *req.LimitSoc))
+	}
+
+	if req.LimitEnergy != nil && *req.LimitEnergy < 0 {
+		errs = append(errs, fmt.Errorf("invalid limit energy: %.3g", *req.LimitEnergy))
+	}
+
+	if req.MinCurrent != nil && req.MaxCurrent != nil && *req.MinCurrent > *req.MaxCurrent {
+		errs = append(errs, errors.New("min current must be smaller or equal than max current"))
+	}
+
+	if req.Phases != nil && *req.Phases != 0 && *req.Phases != 1 && *req.Phases != 3 {
+		errs = append(errs, fmt.Errorf("invalid number of phases: %d", *req.Phases))
+	}
+
+	if req.Priority != nil && *req.Priority < 0 {
+		errs = append(errs, fmt.Errorf("invalid priority: %d", *req.Priority))
+	}
+
+	if p := req.PlanEnergy; p != nil {
+		if p.Energy < 0 || p.Precondition < 0 {
+			errs = append(errs, errors.New("invalid energy plan"))
+		}
+		if p.Energy > 0 && p.Time.Before(now) {
+			errs = append(errs, errors.New("timestamp is in the past"))
+		}
+	}
+
+	if p := req.PlanSoc; p != nil {
+		if p.Soc < 0 || p.Soc > 100 || p.Precondition < 0 {
+			errs = append(errs, errors.New("invalid soc plan"))
+		}
+		if p.Soc > 0 && p.Time.Before(now) {
+			errs = append(errs, errors.New("timestamp is in the past"))
+		}
+	}
+
+	if req.PlanEnergy != nil && req.PlanSoc != nil {
+		errs = append(errs, errors.New("energy and soc plan are mutually exclusive"))
+	}
+
+	return ids, errors.Join(errs...)
+}
+
+// validateLoadpoint checks the request against the loadpoint's current settings
+func (req *batchRequest) validateLoadpoint(lp loadpoint.API) error {
+	var errs []error
+
+	if req.MinCurrent != nil || req.MaxCurrent != nil {
+		minCurrent, maxCurrent := lp.GetMinCurrent(), lp.GetMaxCurrent()
+		if req.MinCurrent != nil {
+			minCurrent = *req.MinCurrent
+		}
+		if req.MaxCurrent != nil {
+			maxCurrent = *req.MaxCurrent
+		}
+
+		if minCurrent > maxCurrent {
+			errs = append(errs, errors.New("min current must be smaller or equal than max current"))
+		}
+	}
+
+	if req.PlanSoc != nil && lp.GetVehicle() == nil {
+		errs = append(errs, errors.New("soc plan requires a vehicle"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// setCurrents sets min and max current in an order that keeps min <= max
+func setCurrents(lp loadpoint.API, minCurrent, maxCurrent float64) error {
+	if minCurrent > lp.GetMaxCurrent() {
+		if err := lp.SetMaxCurrent(maxCurrent); err != nil {
+			return err
+		}
+		return lp.SetMinCurrent(minCurrent)
+	}
+
+	if err := lp.SetMinCurrent(minCurrent); err != nil {
+		return err
+	}
+	return lp.SetMaxCurrent(maxCurrent)
+}
+
+// undo restores modified settings in reverse order
+type undo []func()
+
+func (u undo) restore() {
+	for _, fn := range slices.Backward(u) {
+		fn()
+	}
+}
+
+// apply applies the request to the loadpoint and returns the undo of all modified settings.
+// On failure, the modified settings are restored.
+func (req *batchRequest) apply(lp loadpoint.API) (undo, error) {
+	var res undo
+
+	err := func() error {
+		if req.Mode != nil {
+			prev := lp.GetMode()
+			mode, _ := api.ChargeModeString(*req.Mode)
+			lp.SetMode(mode)
+			res = append(res, func() { lp.SetMode(prev) })
+		}
+
+		if req.LimitSoc != nil {
+			prev := lp.GetLimitSoc()
+			lp.SetLimitSoc(*req.LimitSoc)
+			res = append(res, func() { lp.SetLimitSoc(prev) })
+		}
+
+		if req.LimitEnergy != nil {
+			prev := lp.GetLimitEnergy()
+			lp.SetLimitEnergy(*req.LimitEnergy)
+			res = append(res, func() { lp.SetLimitEnergy(prev) })
+		}
+
+		if req.Priority != nil {
+			prev := lp.GetPriority()
+			lp.SetPriority(*req.Priority)
+			res = append(res, func() { lp.SetPriority(prev) })
+		}
+
+		if req.MinCurrent != nil || req.MaxCurrent != nil {
+			prevMin, prevMax := lp.GetMinCurrent(), lp.GetMaxCurrent()
+
+			minCurrent, maxCurrent := prevMin, prevMax
+			if req.MinCurrent != nil {
+				minCurrent = *req.MinCurrent
+			}
+			if req.MaxCurrent != nil {
+				maxCurrent = *req.MaxCurrent
+			}
+
+			// partially applied currents are restored, too
+			res = append(res, func() { _ = setCurrents(lp, prevMin, prevMax) })
+
+			if err := setCurrents(lp, minCurrent, maxCurrent); err != nil {
+				return err
+			}
+		}
+
+		if req.Phases != nil {
+			prev := lp.GetPhasesConfigured()
+			if err := lp.SetPhasesConfigured(*req.Phases); err != nil {
+				return err
+			}
+			res = append(res, func() { _ = lp.SetPhasesConfigured(prev) })
+		}
+
+		if p := req.PlanEnergy; p != nil {
+			ts, precondition, energy := lp.GetPlanEnergy()
+			if err := lp.SetPlanEnergy(p.Time, time.Duration(p.Precondition)*time.Second, p.Energy); err != nil {
+				return err
+			}
+			res = append(res, func() { _ = lp.SetPlanEnergy(ts, precondition, energy) })
+		}
+
+		if p := req.PlanSoc; p != nil {
+			v := batchVehicle(lp.GetVehicle())
+			ts, precondition, soc := v.GetPlanSoc()
+			if err := v.SetPlanSoc(p.Time, time.Duration(p.Precondition)*time.Second, p.Soc); err != nil {
+				return err
+			}
+			res = append(res, func() { _ = v.SetPlanSoc(ts, precondition, soc) })
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		res.restore()
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// loadpointBatchHandler applies settings to multiple loadpoints and returns the updated loadpoint ids.
+// The request is validated as a whole before any loadpoint is modified. If applying fails for any
+// loadpoint, the settings modified on all loadpoints are restored.
+func loadpointBatchHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req batchRequest
+		if err := jsonDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		applyBatchRequest(w, site, req)
+	}
+}
+
+// loadpointCommandHandler applies settings to the loadpoint given by id, see loadpointBatchHandler
+func loadpointCommandHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(mux.Vars(r)["id"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var req batchRequest
+		if err := jsonDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if len(req.Loadpoints) > 0 {
+			jsonError(w, http.StatusBadRequest, errors.New("loadpoints not allowed"))
+			return
+		}
+
+		req.Loadpoints = []int{id}
+
+		applyBatchRequest(w, site, req)
+	}
+}
+
+// applyBatchRequest validates and applies the request to the selected loadpoints
+func applyBatchRequest(w http.ResponseWriter, site site.API, req batchRequest) {
+	loadpoints := site.Loadpoints()
+
+	ids, err := req.validate(len(loadpoints), time.Now())
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var errs []error
+	for _, id := range ids {
+		if err := req.validateLoadpoint(loadpoints[id-1]); err != nil {
+			errs = append(errs, fmt.Errorf("loadpoint %d: %w", id, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var applied undo
+	for _, id := range ids {
+		res, err := req.apply(loadpoints[id-1])
+		if err != nil {
+			applied.restore()
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("loadpoint %d: %w", id, err))
+			return
+		}
+
+		applied = append(applied, res...)
+	}
+
+	jsonWrite(w, ids)
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/vehicle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchRequestValidate(t *testing.T) {
+	now := time.Now()
+	ptr := func(i int) *int { return &i }
+	fptr := func(f float64) *float64 { return &f }
+	sptr := func(s string) *string { return &s }
+
+	for _, tc := range []struct {
+		req batchRequest
+		ids []int
+		err bool
+	}{
+		{batchRequest{}, []int{1, 2, 3}, false},
+		{batchRequest{Loadpoints: []int{3, 1}}, []int{3, 1}, false},
+		{batchRequest{Loadpoints: []int{4}}, nil, true},
+		{batchRequest{Loadpoints: []int{0}}, nil, true},
+		{batchRequest{Loadpoints: []int{1, 1}}, nil, true},
+		{batchRequest{Mode: sptr("pv")}, []int{1, 2, 3}, false},
+		{batchRequest{Mode: sptr("foo")}, nil, true},
+		{batchRequest{LimitSoc: ptr(101)}, nil, true},
+		{batchRequest{MinCurrent: fptr(16), MaxCurrent: fptr(6)}, nil, true},
+		{batchRequest{Phases: ptr(2)}, nil, true},
+		{batchRequest{PlanEnergy: &batchPlanEnergy{Energy: 10, Time: now.Add(-time.Hour)}}, nil, true},
+		{batchRequest{PlanEnergy: &batchPlanEnergy{Energy: 10, Time: now.Add(time.Hour)}}, []int{1, 2, 3}, false},
+		{batchRequest{PlanSoc: &batchPlanSoc{Soc: 101, Time: now.Add(time.Hour)}}, nil, true},
+		{batchRequest{PlanSoc: &batchPlanSoc{Soc: 80, Time: now.Add(-time.Hour)}}, nil, true},
+		{batchRequest{PlanSoc: &batchPlanSoc{Soc: 80, Time: now.Add(time.Hour)}}, []int{1, 2, 3}, false},
+		{batchRequest{
+			PlanEnergy: &batchPlanEnergy{Energy: 10, Time: now.Add(time.Hour)},
+			PlanSoc:    &batchPlanSoc{Soc: 80, Time: now.Add(time.Hour)},
+		}, nil, true},
+	} {
+		ids, err := tc.req.validate(3, now)
+		if tc.err {
+			assert.Error(t, err, tc.req)
+			continue
+		}
+
+		require.NoError(t, err, tc.req)
+		assert.Equal(t, tc.ids, ids, tc.req)
+	}
+}
+
+func TestBatchRequestApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().GetMode().Return(api.ModeOff)
+	lp.EXPECT().GetPhasesConfigured().Return(3)
+
+	mode, phases := "pv", 1
+
+	// apply
+	lp.EXPECT().SetMode(api.ModePV)
+	lp.EXPECT().SetPhasesConfigured(1).Return(errors.New("phase switching not supported"))
+
+	// restore touched settings only
+	lp.EXPECT().SetMode(api.ModeOff)
+
+	req := batchRequest{Mode: &mode, Phases: &phases}
+	_, err := req.apply(lp)
+	require.Error(t, err)
+}
+
+func TestBatchRequestApplyPlanSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := vehicle.NewMockAPI(ctrl)
+	batchVehicle = func(api.Vehicle) vehicle.API { return v }
+	t.Cleanup(func() {
+		batchVehicle = func(v api.Vehicle) vehicle.API { return vehicle.Settings(log, v) }
+	})
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().GetVehicle().Return(api.NewMockVehicle(ctrl)).AnyTimes()
+
+	ts := time.Now().Add(time.Hour)
+	req := batchRequest{PlanSoc: &batchPlanSoc{Soc: 80, Time: ts, Precondition: 600}}
+	require.NoError(t, req.validateLoadpoint(lp))
+
+	v.EXPECT().GetPlanSoc().Return(time.Time{}, time.Duration(0), 0)
+	v.EXPECT().SetPlanSoc(ts, 10*time.Minute, 80)
+
+	res, err := req.apply(lp)
+	require.NoError(t, err)
+
+	// undo
+	v.EXPECT().SetPlanSoc(time.Time{}, time.Duration(0), 0)
+	res.restore()
+}
+
+func TestBatchRequestValidateLoadpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().GetMinCurrent().Return(6.0).AnyTimes()
+	lp.EXPECT().GetMaxCurrent().Return(16.0).AnyTimes()
+	lp.EXPECT().GetVehicle().Return(nil).AnyTimes()
+
+	// resulting min current exceeds current max current
+	minCurrent, maxCurrent := 20.0, 10.0
+	assert.Error(t, (&batchRequest{MinCurrent: &minCurrent}).validateLoadpoint(lp))
+	assert.NoError(t, (&batchRequest{MaxCurrent: &maxCurrent}).validateLoadpoint(lp))
+	assert.NoError(t, (&batchRequest{MinCurrent: &maxCurrent, MaxCurrent: &minCurrent}).validateLoadpoint(lp))
+
+	// soc plan requires vehicle
+	assert.Error(t, (&batchRequest{PlanSoc: &batchPlanSoc{Soc: 80}}).validateLoadpoint(lp))
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -247,6 +249,61 @@ func vehicleSelectHandler(site site.API, lp loadpoint.API) http.HandlerFunc {
 	}
 }
 
+// vehicleIdentifyHandler assigns the current vehicle id to the vehicle and selects it
+func vehicleIdentifyHandler(site site.API, lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		vv, err := site.Vehicles().ByName(vars["name"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		id := lp.GetVehicleIdentifier()
+		if id == "" {
+			jsonError(w, http.StatusBadRequest, errors.New("no vehicle id available"))
+			return
+		}
+
+		if ids := vv.GetIdentifiers(); !slices.Contains(ids, id) {
+			if err := vv.SetIdentifiers(append(ids, id)); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		lp.SetVehicle(vv.Instance())
+
+		jsonWrite(w, vv.GetIdentifiers())
+	}
+}
+
+// certificateHandler installs the PEM encoded certificate from the request body on the charger
+func certificateHandler(lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		pem, err := io.ReadAll(io.LimitReader(r.Body, 64<<10))
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := lp.InstallCertificate(vars["type"], string(pem)); err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, api.ErrNotAvailable) {
+				status = http.StatusNotImplemented
+			}
+
+			jsonError(w, status, err)
+			return
+		}
+
+		jsonWrite(w, true)
+	}
+}
+
 // vehicleRemoveHandler removes vehicle
 func vehicleRemoveHandler(lp loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -264,3 +321,10 @@ func vehicleDetectHandler(lp loadpoint.API) http.HandlerFunc {
 		jsonWrite(w, res)
 	}
 }
+
+// journalHandler returns the journal of charger commands
+func journalHandler(lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonWrite(w, lp.GetJournal())
+	}
+}
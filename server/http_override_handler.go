@@ -0,0 +1,57 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// defaultOverrideTimeout applies if no timeout is requested. Overrides expire to avoid
+// stale values if the external source stops updating.
+const defaultOverrideTimeout = time.Minute
+
+// maxOverrideTimeout limits how long an override may outlive its source
+const maxOverrideTimeout = 15 * time.Minute
+
+// overrideHandler sets or removes a temporary override. The optional timeout query
+// parameter is given in seconds and must not exceed maxOverrideTimeout.
+//
+// Precedence: a grid power override replaces the measured grid power. A charge current override
+// takes precedence over the loadpoint's charge mode including min soc and plans, but not over
+// mode off. It is still capped by the loadpoint's max current and circuit limits.
+func overrideHandler(set func(*float64, time.Duration)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var val *float64
+
+		timeout, err := parseDuration(r.URL.Query().Get("timeout"))
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if timeout > maxOverrideTimeout {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("timeout exceeds %v", maxOverrideTimeout))
+			return
+		}
+
+		if timeout == 0 {
+			timeout = defaultOverrideTimeout
+		}
+
+		if r.Method != http.MethodDelete {
+			f, err := parseFloat(mux.Vars(r)["value"])
+			if err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+
+			val = &f
+		}
+
+		set(val, timeout)
+
+		jsonWrite(w, val)
+	}
+}
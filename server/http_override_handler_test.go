@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverrideHandlerTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		query   string
+		status  int
+		timeout time.Duration
+	}{
+		{"", http.StatusOK, defaultOverrideTimeout},
+		{"?timeout=300", http.StatusOK, 5 * time.Minute},
+		{"?timeout=900", http.StatusOK, maxOverrideTimeout},
+		{"?timeout=901", http.StatusBadRequest, 0},
+		{"?timeout=-1", http.StatusBadRequest, 0},
+	} {
+		var timeout time.Duration
+		h := overrideHandler(func(_ *float64, d time.Duration) { timeout = d })
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/override/1000"+tc.query, nil), map[string]string{"value": "1000"})
+		w := httptest.NewRecorder()
+		h(w, req)
+
+		assert.Equal(t, tc.status, w.Code, tc.query)
+		assert.Equal(t, tc.timeout, timeout, tc.query)
+	}
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+)
+
+// ReloadResult reports the configuration changes detected by a reload. Devices are
+// identified as class/name, sections requiring a restart by their configuration key.
+type ReloadResult struct {
+	Added   []string `json:"added"`
+	Updated []string `json:"updated"`
+	Removed []string `json:"removed"`
+	Failed  []string `json:"failed"`
+	Restart []string `json:"restart"`
+}
+
+// RestartRequired returns true if changes could not be applied at runtime
+func (r ReloadResult) RestartRequired() bool {
+	return len(r.Restart) > 0
+}
+
+// reloadHandler applies configuration changes at runtime and reports the result
+func reloadHandler(reload func() (ReloadResult, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := reload()
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, struct {
+			ReloadResult
+			RestartRequired bool `json:"restartRequired"`
+		}{
+			ReloadResult:    res,
+			RestartRequired: ConfigDirty(),
+		})
+	}
+}
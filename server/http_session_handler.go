@@ -28,13 +28,8 @@ func csvResult(ctx context.Context, w http.ResponseWriter, res any, filename str
 	}
 }
 
-// sessionHandler returns the list of charging sessions
-func sessionHandler(w http.ResponseWriter, r *http.Request) {
-	if db.Instance == nil {
-		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
-		return
-	}
-
+// querySessions returns the charging sessions filtered by the year, month and vehicle query parameters
+func querySessions(r *http.Request) (session.Sessions, string, error) {
 	var (
 		res  session.Sessions
 		cond []string
@@ -57,10 +52,43 @@ func sessionHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if vehicle := r.URL.Query().Get("vehicle"); vehicle != "" {
+		push("vehicle = ?", vehicle)
+	}
+
 	// TODO support other databases than Sqlite
 	query := strings.Join(append([]string{"charged_kwh>=0.05"}, cond...), " AND ")
 	if txn := db.Instance.Where(query, args...).Order("created DESC").Find(&res); txn.Error != nil {
-		jsonError(w, http.StatusInternalServerError, txn.Error)
+		return nil, "", txn.Error
+	}
+
+	return res, filename, nil
+}
+
+// requestLanguage returns the requested or preferred language
+func requestLanguage(r *http.Request) string {
+	lang := r.URL.Query().Get("lang")
+	if lang == "" {
+		// get request language
+		lang = r.Header.Get("Accept-Language")
+		if tags, _, err := language.ParseAcceptLanguage(lang); err == nil && len(tags) > 0 {
+			lang = tags[0].String()
+		}
+	}
+
+	return lang
+}
+
+// sessionHandler returns the list of charging sessions
+func sessionHandler(w http.ResponseWriter, r *http.Request) {
+	if db.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
+		return
+	}
+
+	res, filename, err := querySessions(r)
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
 		return
 	}
 
@@ -73,21 +101,72 @@ func sessionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if r.URL.Query().Get("format") == "csv" {
-		lang := r.URL.Query().Get("lang")
-		if lang == "" {
-			// get request language
-			lang = r.Header.Get("Accept-Language")
-			if tags, _, err := language.ParseAcceptLanguage(lang); err == nil && len(tags) > 0 {
-				lang = tags[0].String()
-			}
+		ctx := context.WithValue(context.Background(), locale.Locale, requestLanguage(r))
+		csvResult(ctx, w, &res, filename)
+		return
+	}
+
+	jsonWrite(w, res)
+}
+
+// sessionReportHandler returns the reimbursement reports per vehicle
+func sessionReportHandler(w http.ResponseWriter, r *http.Request) {
+	if db.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
+		return
+	}
+
+	var pricePerKWh *float64
+	if val := r.URL.Query().Get("price"); val != "" {
+		f, err := parseFloat(val)
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
 		}
+		pricePerKWh = &f
+	}
 
-		ctx := context.WithValue(context.Background(), locale.Locale, lang)
+	sessions, filename, err := querySessions(r)
+	if err != nil {
+		jsonError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := sessions.Report(pricePerKWh)
+	filename = strings.Replace(filename, "session", "report", 1)
+	ctx := context.WithValue(context.Background(), locale.Locale, requestLanguage(r))
+
+	switch r.URL.Query().Get("format") {
+	case "csv":
 		csvResult(ctx, w, &res, filename)
+
+	case "pdf":
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.pdf"`)
+
+		if err := res.WritePdf(ctx, w, filename); err != nil {
+			log.ERROR.Printf("httpd: failed to write pdf: %v", err)
+		}
+
+	default:
+		jsonWrite(w, res)
+	}
+}
+
+// sessionConsumptionHandler returns the driving consumption per vehicle
+func sessionConsumptionHandler(w http.ResponseWriter, r *http.Request) {
+	if db.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("database offline"))
 		return
 	}
 
-	jsonWrite(w, res)
+	var res session.Sessions
+	if txn := db.Instance.Where("distance > 0").Find(&res); txn.Error != nil {
+		jsonError(w, http.StatusInternalServerError, txn.Error)
+		return
+	}
+
+	jsonWrite(w, res.Consumption())
 }
 
 // deleteSessionHandler removes session in sessions table with given id
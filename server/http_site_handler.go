@@ -223,6 +223,71 @@ func updateBatteryMode(site site.API) http.HandlerFunc {
 	}
 }
 
+// budgetResult is the monthly budget and its progress
+type budgetResult struct {
+	Budget site.Budget        `json:"budget"`
+	Status *site.BudgetStatus `json:"status"`
+}
+
+// budgetHandler returns the monthly charging budget and its progress
+func budgetHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonWrite(w, budgetResult{site.GetBudget(), site.GetBudgetStatus()})
+	}
+}
+
+// updateBudgetHandler sets or removes the monthly charging budget
+func updateBudgetHandler(s site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req site.Budget
+
+		if r.Method != http.MethodDelete {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		if err := s.SetBudget(req); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, budgetResult{s.GetBudget(), s.GetBudgetStatus()})
+	}
+}
+
+// startRolloutHandler starts a staged settings rollout on a canary loadpoint
+func startRolloutHandler(s site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Loadpoint int                  `json:"loadpoint"`
+			Settings  site.RolloutSettings `json:"settings"`
+			Duration  int64                `json:"duration"` // seconds
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := s.StartRollout(req.Loadpoint, req.Settings, time.Duration(req.Duration)*time.Second); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, s.GetRollout())
+	}
+}
+
+// cancelRolloutHandler reverts the canary loadpoint
+func cancelRolloutHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		site.CancelRollout()
+		jsonWrite(w, site.GetRollout())
+	}
+}
+
 // stateHandler returns the combined state
 func stateHandler(cache *util.ParamCache) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -260,10 +325,15 @@ func stateHandler(cache *util.ParamCache) http.HandlerFunc {
 	}
 }
 
-// healthHandler returns current charge mode
-func healthHandler(site site.API) http.HandlerFunc {
+// HealthChecker reports if the instance is operating normally
+type HealthChecker interface {
+	Healthy() bool
+}
+
+// healthHandler returns current health status
+func healthHandler(health HealthChecker) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if site == nil || !site.Healthy() {
+		if health == nil || !health.Healthy() {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
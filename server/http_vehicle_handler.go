@@ -150,6 +150,99 @@ func addRepeatingPlansHandler(site site.API) http.HandlerFunc {
 	}
 }
 
+// repeatingPlansHandler returns the repeating plans
+func repeatingPlansHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		v, err := site.Vehicles().ByName(vars["name"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		res := struct {
+			RepeatingPlans []api.RepeatingPlanStruct `json:"plans"`
+		}{
+			RepeatingPlans: v.GetRepeatingPlans(),
+		}
+
+		jsonWrite(w, res)
+	}
+}
+
+// maintenanceHandler updates the battery maintenance policy
+func maintenanceHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		v, err := site.Vehicles().ByName(vars["name"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var policy api.MaintenanceStruct
+		if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := v.SetMaintenance(policy); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, v.GetMaintenance())
+	}
+}
+
+// departureHandler updates the departure detection settings
+func departureHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		v, err := site.Vehicles().ByName(vars["name"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var departure api.DepartureStruct
+		if err := json.NewDecoder(r.Body).Decode(&departure); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := v.SetDeparture(departure); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, v.GetDeparture())
+	}
+}
+
+// identifiersRemoveHandler removes the learned vehicle identifiers
+func identifiersRemoveHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		v, err := site.Vehicles().ByName(vars["name"])
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := v.SetIdentifiers(nil); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonWrite(w, v.GetIdentifiers())
+	}
+}
+
 // planSocRemoveHandler removes plan soc and time
 func planSocRemoveHandler(site site.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -3,9 +3,8 @@ package server
 import (
 	"crypto/tls"
 	"fmt"
-	"reflect"
-	"strconv"
-	"strings"
+	"maps"
+	"math"
 	"sync"
 	"time"
 
@@ -20,15 +19,28 @@ import (
 // Influx is a influx publisher
 type Influx struct {
 	sync.Mutex
-	log      *util.Logger
-	clock    clock.Clock
-	client   influxdb2.Client
-	org      string
-	database string
+	log        *util.Logger
+	clock      clock.Clock
+	client     influxdb2.Client
+	org        string
+	database   string
+	resolution time.Duration
+	series     map[string]*influxSeries
 }
 
-// NewInfluxClient creates new publisher for influx
-func NewInfluxClient(url, token, org, user, password, database string, insecure bool) *Influx {
+// influxSeries aggregates the fields of a single series for downsampling
+type influxSeries struct {
+	key    string
+	tags   map[string]string
+	sum    map[string]float64
+	count  map[string]int
+	fields map[string]any
+}
+
+// NewInfluxClient creates new publisher for influx.
+// Failed writes are buffered up to bufferSize points and retried with backoff for bufferTime.
+// If resolution is set, values are averaged and written once per resolution interval.
+func NewInfluxClient(url, token, org, user, password, database string, insecure bool, bufferSize int, bufferTime, resolution time.Duration) *Influx {
 	log := util.NewLogger("influx")
 
 	// InfluxDB v1 compatibility
@@ -41,17 +53,28 @@ func NewInfluxClient(url, token, org, user, password, database string, insecure
 	options.SetTLSConfig(&tls.Config{InsecureSkipVerify: insecure})
 	options.SetPrecision(time.Second)
 
+	if bufferSize > 0 {
+		options.SetRetryBufferLimit(uint(bufferSize))
+	}
+	if bufferTime > 0 {
+		// retry count is bounded by max retry time
+		options.SetMaxRetries(math.MaxInt32)
+		options.SetMaxRetryTime(uint(bufferTime.Milliseconds()))
+	}
+
 	client := influxdb2.NewClientWithOptions(url, token, options)
 
 	// handle error logging in writer
 	influxlog.Log = nil
 
 	return &Influx{
-		log:      log,
-		clock:    clock.New(),
-		client:   client,
-		org:      org,
-		database: database,
+		log:        log,
+		clock:      clock.New(),
+		client:     client,
+		org:        org,
+		database:   database,
+		resolution: resolution,
+		series:     make(map[string]*influxSeries),
 	}
 }
 
@@ -62,89 +85,63 @@ type pointWriter interface {
 
 // writePoint asynchronously writes a point to influx
 func (m *Influx) writePoint(writer pointWriter, key string, fields map[string]any, tags map[string]string) {
+	if m.resolution > 0 {
+		m.aggregate(key, fields, tags)
+		return
+	}
+
 	m.log.TRACE.Printf("write %s=%v (%v)", key, fields, tags)
 	writer.WritePoint(influxdb2.NewPoint(key, tags, fields, m.clock.Now()))
 }
 
-// writeComplexPoint asynchronously writes a point to influx
-func (m *Influx) writeComplexPoint(writer pointWriter, key string, val any, tags map[string]string) {
-	fields := make(map[string]any)
-
-	// loop struct
-	writeStruct := func(sv any) {
-		typ := reflect.TypeOf(sv)
-		val := reflect.ValueOf(sv)
-
-		for i := range typ.NumField() {
-			if f := typ.Field(i); f.IsExported() {
-				if val.Field(i).IsZero() && omitEmpty(f) {
-					continue
-				}
-
-				key := key + strings.ToUpper(f.Name[:1]) + f.Name[1:]
-				val := val.Field(i).Interface()
-
-				m.writeComplexPoint(writer, key, val, tags)
-			}
+// aggregate adds the fields to the series' aggregate. Float fields are averaged,
+// other fields retain their last value to keep the field types stable.
+func (m *Influx) aggregate(key string, fields map[string]any, tags map[string]string) {
+	id := key + fmt.Sprint(tags)
+
+	s, ok := m.series[id]
+	if !ok {
+		s = &influxSeries{
+			key:    key,
+			tags:   maps.Clone(tags),
+			sum:    make(map[string]float64),
+			count:  make(map[string]int),
+			fields: make(map[string]any),
 		}
+		m.series[id] = s
 	}
 
-	switch valueType := val.(type) {
-	case string:
-		return
-
-	case int, int64, float64:
-		fields["value"] = val
-
-	case []float64:
-		if len(valueType) != 3 {
-			return
-		}
-
-		// add array as phase values
-		for i, v := range valueType {
-			fields[fmt.Sprintf("l%d", i+1)] = v
+	for k, v := range fields {
+		if f, ok := v.(float64); ok {
+			s.sum[k] += f
+			s.count[k]++
+			continue
 		}
 
-	case [3]float64:
-		// add array as phase values
-		for i, v := range valueType {
-			fields[fmt.Sprintf("l%d", i+1)] = v
-		}
+		s.fields[k] = v
+	}
+}
 
-	default:
-		// allow writing nil values
-		if val == nil {
-			fields["value"] = nil
-			break
+// flush writes the aggregated series
+func (m *Influx) flush(writer pointWriter) {
+	for _, s := range m.series {
+		fields := maps.Clone(s.fields)
+		for k, sum := range s.sum {
+			fields[k] = sum / float64(s.count[k])
 		}
 
-		switch typ := reflect.TypeOf(val); {
-		// pointer
-		case typ.Kind() == reflect.Ptr:
-			if val := reflect.ValueOf(val); !val.IsNil() {
-				m.writeComplexPoint(writer, key, reflect.Indirect(val).Interface(), tags)
-			}
-
-		// struct
-		case typ.Kind() == reflect.Struct:
-			writeStruct(val)
-
-		// slice of structs
-		case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Struct:
-			val := reflect.ValueOf(val)
-
-			// loop slice
-			for i := range val.Len() {
-				tags["id"] = strconv.Itoa(i + 1)
-				writeStruct(val.Index(i).Interface())
-			}
-		}
-
-		return
+		m.log.TRACE.Printf("write %s=%v (%v)", s.key, fields, s.tags)
+		writer.WritePoint(influxdb2.NewPoint(s.key, s.tags, fields, m.clock.Now()))
 	}
 
-	m.writePoint(writer, key, fields, tags)
+	clear(m.series)
+}
+
+// writeComplexPoint asynchronously writes a point to influx
+func (m *Influx) writeComplexPoint(writer pointWriter, key string, val any, tags map[string]string) {
+	writeComplexValue(key, val, tags, func(key string, fields map[string]any, tags map[string]string) {
+		m.writePoint(writer, key, fields, tags)
+	})
 }
 
 // Run Influx publisher
@@ -159,20 +156,32 @@ func (m *Influx) Run(site site.API, in <-chan util.Param) {
 		}
 	}()
 
+	// downsampling
+	var tick <-chan time.Time
+	if m.resolution > 0 {
+		ticker := m.clock.Ticker(m.resolution)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
 	// add points to batch for async writing
-	for param := range in {
-		tags := make(map[string]string)
-		if param.Loadpoint != nil {
-			lp := site.Loadpoints()[*param.Loadpoint]
-
-			tags["loadpoint"] = lp.GetTitle()
-			if v := lp.GetVehicle(); v != nil {
-				tags["vehicle"] = v.GetTitle()
+	for {
+		var param util.Param
+
+		select {
+		case <-tick:
+			m.flush(writer)
+			continue
+
+		case p, ok := <-in:
+			if !ok {
+				m.flush(writer)
+				m.client.Close()
+				return
 			}
+			param = p
 		}
 
-		m.writeComplexPoint(writer, param.Key, param.Val, tags)
+		m.writeComplexPoint(writer, param.Key, param.Val, recordTags(site, param))
 	}
-
-	m.client.Close()
 }
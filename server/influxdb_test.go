@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/util"
@@ -107,3 +108,31 @@ func (w *influxSuite) TestSliceOfStruct() {
 		inf2.NewPoint("gridSoc", map[string]string{"id": "2"}, map[string]any{"value": 20.0}, w.clock.Now()),
 	}, w.p)
 }
+
+func TestInfluxDownsampling(t *testing.T) {
+	suite := new(influxSuite)
+	suite.SetT(t)
+
+	m := &Influx{
+		log:        util.NewLogger("foo"),
+		clock:      clock.NewMock(),
+		resolution: time.Minute,
+		series:     make(map[string]*influxSeries),
+	}
+
+	m.writeComplexPoint(suite, "foo", 1.0, nil)
+	m.writeComplexPoint(suite, "foo", 3.0, nil)
+	m.writeComplexPoint(suite, "bar", 1, nil)
+	m.writeComplexPoint(suite, "bar", 2, nil)
+	suite.Empty(suite.p)
+
+	m.flush(suite)
+	suite.ElementsMatch([]*write.Point{
+		inf2.NewPoint("foo", nil, map[string]any{"value": 2.0}, m.clock.Now()),
+		inf2.NewPoint("bar", nil, map[string]any{"value": 2}, m.clock.Now()),
+	}, suite.p)
+
+	suite.p = nil
+	m.flush(suite)
+	suite.Empty(suite.p)
+}
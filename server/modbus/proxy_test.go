@@ -3,6 +3,7 @@ package modbus
 import (
 	"context"
 	"encoding/binary"
+	"fmt"
 	"math/rand"
 	"net"
 	"sync"
@@ -139,3 +140,57 @@ func (h *echoHandler) HandleCoils(req *mbserver.CoilsRequest) (res []bool, err e
 
 	return res, err
 }
+
+func TestProxySharedSolarmanV5(t *testing.T) {
+	const loggerSerial = 2712345730
+
+	e, err := modbus.NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 0, 0, 1, 2, 3, 4, 5, 6, 7)
+
+	cc := modbus.Settings{URI: fmt.Sprintf("solarmanv5://%s?serial=%d", e.Addr(), loggerSerial), ID: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// evcc device and proxy share the physical logger connection
+	device, err := modbus.NewConnectionWithSettings(ctx, cc)
+	require.NoError(t, err)
+
+	upstream, err := modbus.NewConnectionWithSettings(ctx, cc)
+	require.NoError(t, err)
+
+	pl, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer pl.Close()
+
+	proxy, _ := mbserver.New(&handler{
+		log:  util.NewLogger("foo"),
+		conn: upstream,
+	})
+	require.NoError(t, proxy.Start(pl))
+	defer func() { _ = proxy.Stop() }()
+
+	client, err := modbus.NewConnection(ctx, pl.Addr().String(), "", "", 0, modbus.Tcp, 1)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for _, conn := range []*modbus.Connection{device, client} {
+		wg.Go(func() {
+			for i := range 20 {
+				addr := uint16(i % 8)
+				b, err := conn.ReadHoldingRegisters(addr, 1)
+				if assert.NoError(t, err) {
+					assert.Equal(t, addr, binary.BigEndian.Uint16(b))
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 1, e.Connections())
+}
@@ -204,6 +204,7 @@ func (m *MQTT) listenSiteSetters(topic string, site site.API) error {
 		{"bufferSoc", floatSetter(site.SetBufferSoc)},
 		{"bufferStartSoc", floatSetter(site.SetBufferStartSoc)},
 		{"batteryDischargeControl", boolSetter(site.SetBatteryDischargeControl)},
+		{"batteryPreDischarge", boolSetter(site.SetBatteryPreDischarge)},
 		{"prioritySoc", floatSetter(site.SetPrioritySoc)},
 		{"residualPower", floatSetter(site.SetResidualPower)},
 		{"smartCostLimit", floatPtrSetter(pass(func(limit *float64) {
@@ -217,6 +218,9 @@ func (m *MQTT) listenSiteSetters(topic string, site site.API) error {
 			}
 		}))},
 		{"batteryGridChargeLimit", floatPtrSetter(pass(site.SetBatteryGridChargeLimit))},
+		{"exportLimit", floatPtrSetter(pass(site.SetExportLimit))},
+		{"importLimit", floatPtrSetter(pass(site.SetImportLimit))},
+		{"allocationPolicy", setterFunc(api.AllocationPolicyString, pass(site.SetAllocationPolicy))},
 		{"batteryMode", ptrSetter(api.BatteryModeString, pass(func(m *api.BatteryMode) {
 			if m == nil {
 				m = lo.ToPtr(api.BatteryUnknown)
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+const observerRetryDelay = 10 * time.Second
+
+// observerIgnore are keys describing the local instance which must not be replaced by the primary's values
+var observerIgnore = []string{
+	keys.AuthDisabled,
+	keys.Config,
+	keys.Database,
+	keys.DemoMode,
+	keys.Fatal,
+	keys.Network,
+	keys.Startup,
+	keys.Version,
+	"auth",
+	"log",
+}
+
+// Observer mirrors the state of a primary evcc instance without controlling any devices
+type Observer struct {
+	log       *util.Logger
+	uri       string
+	connected atomic.Bool
+}
+
+// NewObserver creates an observer for the primary instance at given uri
+func NewObserver(uri string) (*Observer, error) {
+	u, err := url.Parse(strings.TrimSuffix(uri, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	u.Path += "/ws"
+
+	return &Observer{
+		log: util.NewLogger("observer"),
+		uri: u.String(),
+	}, nil
+}
+
+// Run replicates the primary's state into the local value channel, reconnecting on errors
+func (o *Observer) Run(valueChan chan<- util.Param) {
+	for {
+		if err := o.run(valueChan); err != nil {
+			o.log.ERROR.Println(err)
+		}
+
+		time.Sleep(observerRetryDelay)
+	}
+}
+
+func (o *Observer) run(valueChan chan<- util.Param) error {
+	ctx, cancel := context.WithTimeout(context.Background(), request.Timeout)
+	conn, _, err := websocket.Dial(ctx, o.uri, nil)
+	cancel()
+
+	if err != nil {
+		return err
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "done")
+
+	o.log.INFO.Printf("connected to %s", o.uri)
+
+	o.connected.Store(true)
+	defer o.connected.Store(false)
+
+	// initial message contains the entire state
+	conn.SetReadLimit(-1)
+
+	for {
+		_, b, err := conn.Read(context.Background())
+		if err != nil {
+			return err
+		}
+
+		params, err := observerParams(b)
+		if err != nil {
+			o.log.DEBUG.Println(err)
+			continue
+		}
+
+		for _, p := range params {
+			valueChan <- p
+		}
+	}
+}
+
+// Healthy returns true while connected to the primary instance
+func (o *Observer) Healthy() bool {
+	return o.connected.Load()
+}
+
+// observerParams converts a socket message into params
+func observerParams(b []byte) ([]util.Param, error) {
+	var msg map[string]any
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, err
+	}
+
+	res := make([]util.Param, 0, len(msg))
+
+	for key, val := range msg {
+		p := util.Param{Key: key, Val: val}
+
+		if rest, ok := strings.CutPrefix(key, "loadpoints."); ok {
+			id, key, ok := strings.Cut(rest, ".")
+			if !ok {
+				continue
+			}
+
+			lp, err := strconv.Atoi(id)
+			if err != nil {
+				continue
+			}
+
+			p.Loadpoint = &lp
+			p.Key = key
+		} else if slices.Contains(observerIgnore, key) {
+			continue
+		}
+
+		res = append(res, p)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	postgresFlushInterval = 10 * time.Second
+	postgresBufferSize    = 50000 // rows retained while the database is unreachable
+)
+
+var postgresColumns = []string{"time", "measurement", "field", "value", "tags"}
+
+// Postgres is a PostgreSQL/TimescaleDB publisher
+type Postgres struct {
+	log   *util.Logger
+	clock clock.Clock
+	pool  *pgxpool.Pool
+	table string
+	rows  [][]any
+}
+
+// NewPostgresClient creates new publisher for PostgreSQL. The table is created if it
+// does not exist and converted to a hypertable if the TimescaleDB extension is available.
+func NewPostgresClient(ctx context.Context, url, table string) (*Postgres, error) {
+	log := util.NewLogger("postgres")
+
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Postgres{
+		log:   log,
+		clock: clock.New(),
+		pool:  pool,
+		table: table,
+	}
+
+	if err := m.migrate(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// migrate creates the metrics table
+func (m *Postgres) migrate(ctx context.Context) error {
+	table := pgx.Identifier{m.table}.Sanitize()
+
+	if _, err := m.pool.Exec(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		time TIMESTAMPTZ NOT NULL,
+		measurement TEXT NOT NULL,
+		field TEXT NOT NULL,
+		value DOUBLE PRECISION,
+		tags JSONB
+	)`, table)); err != nil {
+		return fmt.Errorf("create table: %w", err)
+	}
+
+	var timescale bool
+	if err := m.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb')`).Scan(&timescale); err != nil {
+		return err
+	}
+
+	if timescale {
+		if _, err := m.pool.Exec(ctx, `SELECT create_hypertable($1, 'time', if_not_exists => TRUE, migrate_data => TRUE)`, m.table); err != nil {
+			return fmt.Errorf("create hypertable: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// record adds the numeric fields of a measurement to the write buffer
+func (m *Postgres) record(key string, fields map[string]any, tags map[string]string) {
+	var tagsJson []byte
+	if len(tags) > 0 {
+		tagsJson, _ = json.Marshal(tags)
+	}
+
+	ts := m.clock.Now()
+
+	for field, val := range fields {
+		var f float64
+
+		switch v := val.(type) {
+		case float64:
+			f = v
+		case int:
+			f = float64(v)
+		case int64:
+			f = float64(v)
+		default:
+			continue
+		}
+
+		m.log.TRACE.Printf("write %s.%s=%v (%v)", key, field, f, tags)
+		m.rows = append(m.rows, []any{ts, key, field, f, tagsJson})
+	}
+
+	// discard oldest rows if buffer is exceeded
+	if n := len(m.rows) - postgresBufferSize; n > 0 {
+		m.rows = m.rows[n:]
+	}
+}
+
+// flush writes the buffered rows. Rows are retained for retry if writing fails.
+func (m *Postgres) flush() {
+	if len(m.rows) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), postgresFlushInterval)
+	defer cancel()
+
+	if _, err := m.pool.CopyFrom(ctx, pgx.Identifier{m.table}, postgresColumns, pgx.CopyFromRows(m.rows)); err != nil {
+		m.log.ERROR.Printf("write %d rows: %v", len(m.rows), err)
+		return
+	}
+
+	m.rows = nil
+}
+
+// Run Postgres publisher
+func (m *Postgres) Run(site site.API, in <-chan util.Param) {
+	ticker := m.clock.Ticker(postgresFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.flush()
+
+		case param, ok := <-in:
+			if !ok {
+				m.flush()
+				m.pool.Close()
+				return
+			}
+
+			writeComplexValue(param.Key, param.Val, recordTags(site, param), m.record)
+		}
+	}
+}
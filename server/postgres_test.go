@@ -0,0 +1,28 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresRecord(t *testing.T) {
+	m := &Postgres{
+		log:   util.NewLogger("foo"),
+		clock: clock.NewMock(),
+	}
+
+	writeComplexValue("foo", 1.0, map[string]string{"loadpoint": "garage"}, m.record)
+	writeComplexValue("bar", "string", nil, m.record)
+	writeComplexValue("battery", []measurement{{Power: 1, Soc: lo.ToPtr(10.0)}}, map[string]string{}, m.record)
+
+	ts := m.clock.Now()
+	assert.Equal(t, [][]any{
+		{ts, "foo", "value", 1.0, []byte(`{"loadpoint":"garage"}`)},
+		{ts, "batteryPower", "value", 1.0, []byte(`{"id":"1"}`)},
+		{ts, "batterySoc", "value", 10.0, []byte(`{"id":"1"}`)},
+	}, m.rows)
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var snakeCaseRE = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// Prometheus is a prometheus publisher exposing published values as gauges
+type Prometheus struct {
+	log    *util.Logger
+	reg    prometheus.Registerer
+	gauges map[string]*prometheus.GaugeVec
+}
+
+// NewPrometheus creates new publisher for prometheus
+func NewPrometheus(reg prometheus.Registerer) *Prometheus {
+	return &Prometheus{
+		log:    util.NewLogger("prometheus"),
+		reg:    reg,
+		gauges: make(map[string]*prometheus.GaugeVec),
+	}
+}
+
+// metricName converts a published key to a prometheus metric name
+func metricName(key string) string {
+	return strings.ToLower(snakeCaseRE.ReplaceAllString(key, "${1}_${2}"))
+}
+
+// floatValue converts published values to gauge values
+func floatValue(val any) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case time.Duration:
+		return v.Seconds(), true
+	default:
+		if rv := reflect.ValueOf(val); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			return floatValue(rv.Elem().Interface())
+		}
+		return 0, false
+	}
+}
+
+// gauge returns the gauge for given subsystem and name, registering it on first use
+func (m *Prometheus) gauge(subsystem, name string, labels ...string) *prometheus.GaugeVec {
+	id := subsystem + "_" + name
+	if g, ok := m.gauges[id]; ok {
+		return g
+	}
+
+	g := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "evcc",
+		Subsystem: subsystem,
+		Name:      name,
+	}, labels)
+
+	if err := m.reg.Register(g); err != nil {
+		m.log.DEBUG.Printf("register %s: %v", id, err)
+		g = nil
+	}
+
+	m.gauges[id] = g
+
+	return g
+}
+
+// set updates the gauge if the value can be represented as float
+func (m *Prometheus) set(subsystem, name string, val any, labels prometheus.Labels) {
+	f, ok := floatValue(val)
+	if !ok {
+		return
+	}
+
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+
+	if g := m.gauge(subsystem, name, names...); g != nil {
+		if c, err := g.GetMetricWith(labels); err == nil {
+			c.Set(f)
+		}
+	}
+}
+
+// writeMeters exposes slices of device measurements with per-device labels
+func (m *Prometheus) writeMeters(typ string, val reflect.Value) {
+	for i := range val.Len() {
+		dev := val.Index(i)
+
+		labels := prometheus.Labels{"type": typ, "id": strconv.Itoa(i + 1), "title": ""}
+		if f := dev.FieldByName("Title"); f.IsValid() && f.Kind() == reflect.String {
+			labels["title"] = f.String()
+		}
+
+		for j := range dev.NumField() {
+			if f := dev.Type().Field(j); f.IsExported() {
+				m.set("meter", metricName(f.Name), dev.Field(j).Interface(), labels)
+			}
+		}
+	}
+}
+
+// Run Prometheus publisher
+func (m *Prometheus) Run(site site.API, in <-chan util.Param) {
+	for param := range in {
+		if param.Loadpoint != nil {
+			lp := site.Loadpoints()[*param.Loadpoint]
+
+			labels := prometheus.Labels{
+				"loadpoint": strconv.Itoa(*param.Loadpoint + 1),
+				"title":     lp.GetTitle(),
+			}
+
+			m.set("loadpoint", metricName(param.Key), param.Val, labels)
+			continue
+		}
+
+		if val := reflect.ValueOf(param.Val); val.Kind() == reflect.Slice && val.Type().Elem().Kind() == reflect.Struct {
+			m.writeMeters(param.Key, val)
+			continue
+		}
+
+		m.set("site", metricName(param.Key), param.Val, nil)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusMetricName(t *testing.T) {
+	assert.Equal(t, "grid_power", metricName("gridPower"))
+	assert.Equal(t, "vehicle_soc", metricName("vehicleSoc"))
+	assert.Equal(t, "power", metricName("Power"))
+}
+
+func TestPrometheusRun(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewPrometheus(reg)
+
+	type meter struct {
+		Title    string
+		Power    float64
+		Soc      *float64
+		Currents []float64
+	}
+
+	in := make(chan util.Param, 4)
+	in <- util.Param{Key: "gridPower", Val: 1000.0}
+	in <- util.Param{Key: "batteryGridChargeActive", Val: true}
+	in <- util.Param{Key: "siteTitle", Val: "home"}
+	in <- util.Param{Key: "battery", Val: []meter{{Title: "bat", Power: -500, Soc: lo.ToPtr(50.0)}}}
+	close(in)
+
+	m.Run(nil, in)
+
+	expected := `
+# HELP evcc_site_grid_power 
+# TYPE evcc_site_grid_power gauge
+evcc_site_grid_power 1000
+# HELP evcc_site_battery_grid_charge_active 
+# TYPE evcc_site_battery_grid_charge_active gauge
+evcc_site_battery_grid_charge_active 1
+# HELP evcc_meter_power 
+# TYPE evcc_meter_power gauge
+evcc_meter_power{id="1",title="bat",type="battery"} -500
+# HELP evcc_meter_soc 
+# TYPE evcc_meter_soc gauge
+evcc_meter_soc{id="1",title="bat",type="battery"} 50
+`
+
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected)))
+}
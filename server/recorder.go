@@ -0,0 +1,121 @@
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Recorder is a time-series database publisher
+type Recorder interface {
+	Run(site site.API, in <-chan util.Param)
+}
+
+var (
+	_ Recorder = (*Influx)(nil)
+	_ Recorder = (*Postgres)(nil)
+)
+
+// recordWriter writes a single measurement
+type recordWriter func(key string, fields map[string]any, tags map[string]string)
+
+// recordTags returns the tags for the given parameter
+func recordTags(site site.API, param util.Param) map[string]string {
+	tags := make(map[string]string)
+	if param.Loadpoint != nil {
+		lp := site.Loadpoints()[*param.Loadpoint]
+
+		tags["loadpoint"] = lp.GetTitle()
+		if v := lp.GetVehicle(); v != nil {
+			tags["vehicle"] = v.GetTitle()
+		}
+	}
+
+	return tags
+}
+
+// writeComplexValue flattens complex values into fields and passes them to the write function.
+// Structs and slices of structs are split into separate measurements per field.
+func writeComplexValue(key string, val any, tags map[string]string, write recordWriter) {
+	fields := make(map[string]any)
+
+	// loop struct
+	writeStruct := func(sv any) {
+		typ := reflect.TypeOf(sv)
+		val := reflect.ValueOf(sv)
+
+		for i := range typ.NumField() {
+			if f := typ.Field(i); f.IsExported() {
+				if val.Field(i).IsZero() && omitEmpty(f) {
+					continue
+				}
+
+				key := key + strings.ToUpper(f.Name[:1]) + f.Name[1:]
+				val := val.Field(i).Interface()
+
+				writeComplexValue(key, val, tags, write)
+			}
+		}
+	}
+
+	switch valueType := val.(type) {
+	case string:
+		return
+
+	case int, int64, float64:
+		fields["value"] = val
+
+	case []float64:
+		if len(valueType) != 3 {
+			return
+		}
+
+		// add array as phase values
+		for i, v := range valueType {
+			fields[fmt.Sprintf("l%d", i+1)] = v
+		}
+
+	case [3]float64:
+		// add array as phase values
+		for i, v := range valueType {
+			fields[fmt.Sprintf("l%d", i+1)] = v
+		}
+
+	default:
+		// allow writing nil values
+		if val == nil {
+			fields["value"] = nil
+			break
+		}
+
+		switch typ := reflect.TypeOf(val); {
+		// pointer
+		case typ.Kind() == reflect.Ptr:
+			if val := reflect.ValueOf(val); !val.IsNil() {
+				writeComplexValue(key, reflect.Indirect(val).Interface(), tags, write)
+			}
+
+		// struct
+		case typ.Kind() == reflect.Struct:
+			writeStruct(val)
+
+		// slice of structs
+		case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Struct:
+			val := reflect.ValueOf(val)
+
+			// loop slice
+			for i := range val.Len() {
+				tags["id"] = strconv.Itoa(i + 1)
+				writeStruct(val.Index(i).Interface())
+			}
+		}
+
+		return
+	}
+
+	write(key, fields, tags)
+}
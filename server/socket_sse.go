@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// sseKeepAlive is the interval for sending comments to keep idle connections open
+const sseKeepAlive = 30 * time.Second
+
+// ServeEvents streams the system state as server-sent events. The first event contains
+// the complete state, subsequent events contain changed values only. Both use the
+// flattened key format of the websocket.
+func (h *SocketHub) ServeEvents(w http.ResponseWriter, r *http.Request) {
+	rc := http.NewResponseController(w)
+
+	// streaming connections must not be terminated by the server's write timeout
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	s := &socketSubscriber{
+		send:      make(chan []byte, 1024),
+		closeSlow: cancel,
+	}
+
+	h.addSubscriber(s)
+	defer h.deleteSubscriber(s)
+
+	// send welcome message
+	h.register <- s
+
+	ticker := time.NewTicker(sseKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		var err error
+
+		select {
+		case msg := <-s.send:
+			_, err = fmt.Fprintf(w, "data: %s\n\n", msg)
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": keepalive\n\n")
+		case <-ctx.Done():
+			return
+		}
+
+		if err == nil {
+			err = rc.Flush()
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeEvents(t *testing.T) {
+	cache := util.NewParamCache()
+	cache.Add("pvPower", util.Param{Key: "pvPower", Val: 1000})
+
+	in := make(chan util.Param)
+	hub := NewSocketHub()
+	go hub.Run(in, cache)
+
+	srv := httptest.NewServer(http.HandlerFunc(hub.ServeEvents))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	scanner := bufio.NewScanner(res.Body)
+	next := func() string {
+		require.True(t, scanner.Scan())
+		line := scanner.Text()
+		require.True(t, scanner.Scan())
+		require.Empty(t, scanner.Text())
+		return line
+	}
+
+	// complete state
+	assert.Equal(t, `data: {"pvPower":1000}`, next())
+
+	// delta
+	lp := 0
+	in <- util.Param{Loadpoint: &lp, Key: "mode", Val: "pv"}
+	assert.Equal(t, `data: {"loadpoints.0.mode":"pv"}`, next())
+}
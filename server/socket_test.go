@@ -49,3 +49,23 @@ func TestEncodeSlice(t *testing.T) {
 		assert.Equal(t, tc.out, out)
 	}
 }
+
+func TestObserverParams(t *testing.T) {
+	res, err := observerParams([]byte(`{"pvPower":1200,"loadpoints.1.mode":"pv","version":"0.1"}`))
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	for _, p := range res {
+		switch p.Key {
+		case "pvPower":
+			assert.Nil(t, p.Loadpoint)
+			assert.Equal(t, 1200.0, p.Val)
+		case "mode":
+			require.NotNil(t, p.Loadpoint)
+			assert.Equal(t, 1, *p.Loadpoint)
+			assert.Equal(t, "pv", p.Val)
+		default:
+			t.Errorf("unexpected key: %s", p.Key)
+		}
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"os"
 
 	"github.com/evcc-io/evcc/cmd/shutdown"
-	"github.com/evcc-io/evcc/core/site"
 )
 
 // SocketPath is the unix domain socket path
@@ -24,7 +23,7 @@ func removeIfExists(file string) {
 }
 
 // HealthListener attaches listener to unix domain socket and runs listener
-func HealthListener(site site.API) {
+func HealthListener(health HealthChecker) {
 	removeIfExists(SocketPath)
 
 	l, err := net.Listen("unix", SocketPath)
@@ -34,7 +33,7 @@ func HealthListener(site site.API) {
 
 	mux := http.NewServeMux()
 	httpd := http.Server{Handler: mux}
-	mux.HandleFunc("/health", healthHandler(site))
+	mux.HandleFunc("/health", healthHandler(health))
 
 	go func() { _ = httpd.Serve(l) }()
 
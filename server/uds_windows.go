@@ -2,9 +2,7 @@
 
 package server
 
-import "github.com/evcc-io/evcc/core/site"
-
 // HealthListener attaches listener to unix domain socket
-func HealthListener(_ site.API) {
+func HealthListener(_ HealthChecker) {
 	// nop
 }
@@ -0,0 +1,166 @@
+package tariff
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/tariff/openadr"
+	"github.com/evcc-io/evcc/util"
+)
+
+// openADRHorizon limits open-ended event intervals
+const openADRHorizon = 48 * time.Hour
+
+// OpenADR receives prices or GHG emissions from an OpenADR 3 VTN
+type OpenADR struct {
+	*embed
+	log      *util.Logger
+	client   *openadr.Client
+	program  string
+	payload  string
+	interval time.Duration
+	data     *util.Monitor[api.Rates]
+}
+
+var _ api.Tariff = (*OpenADR)(nil)
+
+func init() {
+	registry.Add("openadr", NewOpenADRFromConfig)
+}
+
+func NewOpenADRFromConfig(other map[string]interface{}) (api.Tariff, error) {
+	cc := struct {
+		embed        `mapstructure:",squash"`
+		URI          string
+		ClientID     string
+		ClientSecret string
+		TokenURL     string
+		Program      string
+		Payload      string
+		Interval     time.Duration
+	}{
+		Payload:  openadr.PayloadPrice,
+		Interval: 15 * time.Minute,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.URI == "" || cc.Program == "" {
+		return nil, errors.New("missing uri or program")
+	}
+
+	if cc.ClientID == "" || cc.ClientSecret == "" {
+		return nil, api.ErrMissingCredentials
+	}
+
+	cc.Payload = strings.ToUpper(cc.Payload)
+	if cc.Payload != openadr.PayloadPrice && cc.Payload != openadr.PayloadGHG {
+		return nil, fmt.Errorf("invalid payload type: %s", cc.Payload)
+	}
+
+	if err := cc.init(); err != nil {
+		return nil, err
+	}
+
+	log := util.NewLogger("openadr").Redact(cc.ClientID, cc.ClientSecret)
+
+	client, err := openadr.NewClient(log, cc.URI, cc.ClientID, cc.ClientSecret, cc.TokenURL)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &OpenADR{
+		embed:    &cc.embed,
+		log:      log,
+		client:   client,
+		program:  cc.Program,
+		payload:  cc.Payload,
+		interval: cc.Interval,
+		data:     util.NewMonitor[api.Rates](2 * cc.Interval),
+	}
+
+	return runOrError(t)
+}
+
+func (t *OpenADR) run(done chan error) {
+	var once sync.Once
+
+	var programID string
+
+	for tick := time.Tick(t.interval); ; <-tick {
+		var events []openadr.Event
+
+		if err := backoff.Retry(func() error {
+			if programID == "" {
+				id, err := t.client.ProgramID(t.program)
+				if err != nil {
+					return backoffPermanentError(err)
+				}
+				programID = id
+			}
+
+			var err error
+			events, err = t.client.Events(programID)
+			return backoffPermanentError(err)
+		}, bo()); err != nil {
+			once.Do(func() { done <- err })
+
+			t.log.ERROR.Println(err)
+			continue
+		}
+
+		horizon := time.Now().Add(openADRHorizon)
+
+		values := openadr.Values(events, t.payload)
+		data := make(api.Rates, 0, len(values))
+		for _, v := range values {
+			if !v.Start.Before(horizon) {
+				continue
+			}
+
+			value := v.Value
+			if t.payload == openadr.PayloadPrice {
+				value = t.totalPrice(value, v.Start)
+			}
+
+			end := v.End
+			if end.After(horizon) {
+				end = horizon
+			}
+
+			data = append(data, api.Rate{
+				Start: v.Start,
+				End:   end,
+				Value: value,
+			})
+		}
+
+		mergeRates(t.data, data)
+		once.Do(func() { close(done) })
+	}
+}
+
+// Rates implements the api.Tariff interface
+func (t *OpenADR) Rates() (api.Rates, error) {
+	var res api.Rates
+	err := t.data.GetFunc(func(val api.Rates) {
+		res = slices.Clone(val)
+	})
+	return res, err
+}
+
+// Type implements the api.Tariff interface
+func (t *OpenADR) Type() api.TariffType {
+	if t.payload == openadr.PayloadGHG {
+		return api.TariffTypeCo2
+	}
+	return api.TariffTypePriceForecast
+}
@@ -0,0 +1,77 @@
+package openadr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Client is an OpenADR 3 VEN client polling events from the VTN
+type Client struct {
+	*request.Helper
+	uri string
+}
+
+// NewClient creates an OpenADR 3 client authenticating using OAuth2 client credentials.
+// If tokenURL is empty, it is discovered from the VTN.
+func NewClient(log *util.Logger, uri, clientID, clientSecret, tokenURL string) (*Client, error) {
+	c := &Client{
+		Helper: request.NewHelper(log),
+		uri:    strings.TrimSuffix(uri, "/"),
+	}
+
+	if tokenURL == "" {
+		var res struct {
+			TokenURL string `json:"tokenURL"`
+		}
+		if err := c.GetJSON(c.uri+"/auth/server", &res); err != nil {
+			return nil, fmt.Errorf("token url: %w", err)
+		}
+		tokenURL = res.TokenURL
+	}
+
+	oc := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, request.NewClient(log))
+
+	c.Client.Transport = &oauth2.Transport{
+		Base:   c.Client.Transport,
+		Source: oauth2.ReuseTokenSource(nil, oc.TokenSource(ctx)),
+	}
+
+	return c, nil
+}
+
+// ProgramID returns the id of the program with given name
+func (c *Client) ProgramID(name string) (string, error) {
+	var res []Program
+	if err := c.GetJSON(c.uri+"/programs", &res); err != nil {
+		return "", err
+	}
+
+	for _, p := range res {
+		if p.ProgramName == name || p.ID == name {
+			return p.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("program not found: %s", name)
+}
+
+// Events returns the program's events
+func (c *Client) Events(programID string) ([]Event, error) {
+	var res []Event
+	uri := fmt.Sprintf("%s/events?programID=%s", c.uri, url.QueryEscape(programID))
+	err := c.GetJSON(uri, &res)
+	return res, err
+}
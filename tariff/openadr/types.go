@@ -0,0 +1,68 @@
+package openadr
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dylanmei/iso8601"
+)
+
+// payload types, see OpenADR 3 definitions
+const (
+	PayloadPrice               = "PRICE"
+	PayloadGHG                 = "GHG"
+	PayloadImportCapacityLimit = "IMPORT_CAPACITY_LIMIT"
+	PayloadExportCapacityLimit = "EXPORT_CAPACITY_LIMIT"
+)
+
+type Program struct {
+	ID          string `json:"id"`
+	ProgramName string `json:"programName"`
+}
+
+type Event struct {
+	ID             string          `json:"id"`
+	ProgramID      string          `json:"programID"`
+	EventName      string          `json:"eventName"`
+	Priority       *int            `json:"priority"`
+	IntervalPeriod *IntervalPeriod `json:"intervalPeriod"`
+	Intervals      []Interval      `json:"intervals"`
+}
+
+type Interval struct {
+	ID             int             `json:"id"`
+	IntervalPeriod *IntervalPeriod `json:"intervalPeriod"`
+	Payloads       []ValuesMap     `json:"payloads"`
+}
+
+type IntervalPeriod struct {
+	Start    time.Time `json:"start"`
+	Duration Duration  `json:"duration"`
+}
+
+type ValuesMap struct {
+	Type   string `json:"type"`
+	Values []any  `json:"values"`
+}
+
+// Duration is an ISO 8601 duration
+type Duration time.Duration
+
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d)
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	val, err := iso8601.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(val)
+	return nil
+}
@@ -0,0 +1,141 @@
+package openadr
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// Value is a numeric payload value valid for the given period
+type Value struct {
+	Start, End time.Time
+	Value      float64
+	Priority   int
+}
+
+// farFuture is used for open-ended interval periods
+var farFuture = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Values returns the payload values of given type from all events.
+// Intervals without own period are sequential within the event's period.
+// Where events overlap, values of higher priority (lower number) take precedence.
+func Values(events []Event, typ string) []Value {
+	var res []Value
+
+	for _, e := range events {
+		prio := math.MaxInt
+		if e.Priority != nil {
+			prio = *e.Priority
+		}
+
+		intervals := slices.Clone(e.Intervals)
+		slices.SortStableFunc(intervals, func(a, b Interval) int {
+			return a.ID - b.ID
+		})
+
+		for i, iv := range intervals {
+			period := iv.IntervalPeriod
+			if period == nil {
+				if e.IntervalPeriod == nil {
+					continue
+				}
+
+				d := e.IntervalPeriod.Duration.Duration()
+				period = &IntervalPeriod{
+					Start:    e.IntervalPeriod.Start.Add(time.Duration(i) * d),
+					Duration: e.IntervalPeriod.Duration,
+				}
+			}
+
+			val, ok := payloadValue(iv.Payloads, typ)
+			if !ok {
+				continue
+			}
+
+			end := farFuture
+			if d := period.Duration.Duration(); d > 0 {
+				end = period.Start.Add(d)
+			}
+
+			res = append(res, Value{
+				Start:    period.Start.Local(),
+				End:      end.Local(),
+				Value:    val,
+				Priority: prio,
+			})
+		}
+	}
+
+	// higher priority values first
+	slices.SortStableFunc(res, func(a, b Value) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	var merged []Value
+	for _, v := range res {
+		segments := []Value{v}
+		for _, o := range merged {
+			if o.Priority < v.Priority {
+				segments = subtract(segments, o)
+			}
+		}
+		merged = append(merged, segments...)
+	}
+
+	slices.SortStableFunc(merged, func(a, b Value) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	return merged
+}
+
+// subtract removes the period covered by o from the segments, splitting partially covered segments
+func subtract(segments []Value, o Value) []Value {
+	var res []Value
+
+	for _, s := range segments {
+		if !o.Start.Before(s.End) || !s.Start.Before(o.End) {
+			res = append(res, s)
+			continue
+		}
+
+		if s.Start.Before(o.Start) {
+			head := s
+			head.End = o.Start
+			res = append(res, head)
+		}
+
+		if o.End.Before(s.End) {
+			tail := s
+			tail.Start = o.End
+			res = append(res, tail)
+		}
+	}
+
+	return res
+}
+
+// At returns the value valid at the given time
+func At(values []Value, ts time.Time) (float64, bool) {
+	for _, v := range values {
+		if !ts.Before(v.Start) && ts.Before(v.End) {
+			return v.Value, true
+		}
+	}
+	return 0, false
+}
+
+func payloadValue(payloads []ValuesMap, typ string) (float64, bool) {
+	for _, p := range payloads {
+		if p.Type != typ || len(p.Values) == 0 {
+			continue
+		}
+
+		if f, ok := p.Values[0].(float64); ok {
+			return f, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,68 @@
+package openadr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEvents = `[{
+	"id": "1",
+	"programID": "p",
+	"priority": 2,
+	"intervalPeriod": {"start": "2025-01-01T10:00:00Z", "duration": "PT1H"},
+	"intervals": [
+		{"id": 0, "payloads": [{"type": "PRICE", "values": [0.2]}]},
+		{"id": 1, "payloads": [{"type": "PRICE", "values": [0.3]}, {"type": "GHG", "values": [400]}]}
+	]
+}, {
+	"id": "2",
+	"programID": "p",
+	"priority": 1,
+	"intervals": [
+		{"id": 0, "intervalPeriod": {"start": "2025-01-01T11:00:00Z", "duration": "PT30M"}, "payloads": [{"type": "PRICE", "values": [0.5]}]}
+	]
+}, {
+	"id": "3",
+	"programID": "p",
+	"intervalPeriod": {"start": "2025-01-01T12:00:00Z", "duration": "PT0S"},
+	"intervals": [
+		{"id": 0, "payloads": [{"type": "IMPORT_CAPACITY_LIMIT", "values": [5]}]}
+	]
+}]`
+
+func TestValues(t *testing.T) {
+	var events []Event
+	require.NoError(t, json.Unmarshal([]byte(testEvents), &events))
+
+	ts := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	res := Values(events, PayloadPrice)
+	require.Len(t, res, 3)
+	assert.True(t, res[0].Start.Equal(ts))
+	assert.True(t, res[0].End.Equal(ts.Add(time.Hour)))
+	assert.Equal(t, 0.2, res[0].Value)
+
+	// overlapping lower priority interval is split
+	assert.True(t, res[1].Start.Equal(ts.Add(time.Hour)))
+	assert.True(t, res[1].End.Equal(ts.Add(90*time.Minute)))
+	assert.Equal(t, 0.5, res[1].Value)
+
+	assert.True(t, res[2].Start.Equal(ts.Add(90*time.Minute)))
+	assert.True(t, res[2].End.Equal(ts.Add(2*time.Hour)))
+	assert.Equal(t, 0.3, res[2].Value)
+
+	assert.Equal(t, []Value{{Start: ts.Add(time.Hour).Local(), End: ts.Add(2 * time.Hour).Local(), Value: 400, Priority: 2}}, Values(events, PayloadGHG))
+
+	// open-ended
+	limit := Values(events, PayloadImportCapacityLimit)
+	v, ok := At(limit, ts.AddDate(1, 0, 0))
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, v)
+
+	_, ok = At(limit, ts)
+	assert.False(t, ok)
+}
@@ -45,6 +45,10 @@ func Rates(t api.Tariff) api.Rates {
 }
 
 func (t *Tariffs) Get(u api.TariffUsage) api.Tariff {
+	if t == nil {
+		return nil
+	}
+
 	switch u {
 	case api.TariffUsageCo2:
 		return t.Co2
@@ -88,7 +88,7 @@ test("http iframe hint", async ({ page }) => {
 
   // rewrite api call to simulate lost auth cookie
   await page.route("**/api/auth/status", (route) => {
-    route.fulfill({ status: 200, body: "false" });
+    route.fulfill({ status: 200, body: '""' });
   });
 
   // enter correct password
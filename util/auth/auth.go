@@ -31,6 +31,15 @@ type Auth interface {
 	GenerateJwtToken(time.Duration) (string, error)
 	ValidateJwtToken(string) (bool, error)
 	IsAdminPasswordConfigured() bool
+
+	HasUsers() (bool, error)
+	Users() ([]User, error)
+	SetUser(name, password string, role Role) error
+	RemoveUser(name string) error
+	IsUserPasswordValid(name, password string) bool
+	GenerateUserJwtToken(name string, lifetime time.Duration) (string, error)
+	JwtRole(string) (Role, error)
+
 	SetAuthMode(AuthMode)
 	GetAuthMode() AuthMode
 }
@@ -122,8 +131,17 @@ func (a *auth) getJwtSecret() ([]byte, error) {
 
 // GenerateJwtToken generates an admin user JWT token with the given lifetime
 func (a *auth) GenerateJwtToken(lifetime time.Duration) (string, error) {
+	return a.GenerateUserJwtToken(admin, lifetime)
+}
+
+// GenerateUserJwtToken generates a JWT token for the given user with the given lifetime
+func (a *auth) GenerateUserJwtToken(name string, lifetime time.Duration) (string, error) {
+	if name == "" {
+		name = admin
+	}
+
 	claims := &jwt.RegisteredClaims{
-		Subject:   admin,
+		Subject:   name,
 		ExpiresAt: jwt.NewNumericDate(time.Now().Add(lifetime)),
 	}
 
@@ -135,22 +153,42 @@ func (a *auth) GenerateJwtToken(lifetime time.Duration) (string, error) {
 	}
 }
 
-// ValidateJwtToken validates the given JWT token
-func (a *auth) ValidateJwtToken(tokenString string) (bool, error) {
+// JwtRole validates the given JWT token and returns the user's role.
+// The role is read from the user account to apply changes and removals immediately.
+func (a *auth) JwtRole(tokenString string) (Role, error) {
 	jwtSecret, err := a.getJwtSecret()
 	if err != nil {
-		return false, err
+		return "", err
 	}
 
 	// read token
 	var claims jwt.RegisteredClaims
 	if _, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
 		return jwtSecret, nil
-	}, jwt.WithSubject(admin)); err != nil {
+	}); err != nil {
+		return "", err
+	}
+
+	if claims.Subject == admin {
+		return RoleAdmin, nil
+	}
+
+	u, err := a.user(claims.Subject)
+	if err != nil {
+		return "", err
+	}
+
+	return u.Role, nil
+}
+
+// ValidateJwtToken validates the given JWT token for admin access
+func (a *auth) ValidateJwtToken(tokenString string) (bool, error) {
+	role, err := a.JwtRole(tokenString)
+	if err != nil {
 		return false, err
 	}
 
-	return true, nil
+	return role.Includes(RoleAdmin), nil
 }
 
 func (a *auth) SetAuthMode(authMode AuthMode) {
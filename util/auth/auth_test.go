@@ -76,3 +76,68 @@ func TestJwtToken(t *testing.T) {
 	ok, err := auth.ValidateJwtToken(tokenString)
 	assert.True(t, ok && err == nil, "token is invalid")
 }
+
+func TestUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := settings.NewMockAPI(ctrl)
+	auth := NewMock(mock)
+
+	store := map[string]string{keys.JwtSecret: "somesecret"}
+	mock.EXPECT().String(gomock.Any()).DoAndReturn(func(key string) (string, error) { return store[key], nil }).AnyTimes()
+	mock.EXPECT().SetString(gomock.Any(), gomock.Any()).Do(func(key, val string) { store[key] = val }).AnyTimes()
+
+	hasUsers, err := auth.HasUsers()
+	assert.NoError(t, err)
+	assert.False(t, hasUsers)
+	assert.Error(t, auth.SetUser("admin", "secret", RoleViewer))
+	assert.Error(t, auth.SetUser("guest", "secret", "foo"))
+	assert.Error(t, auth.SetUser("guest", "", RoleViewer))
+
+	assert.NoError(t, auth.SetUser("guest", "secret", RoleViewer))
+	hasUsers, err = auth.HasUsers()
+	assert.NoError(t, err)
+	assert.True(t, hasUsers)
+	assert.True(t, auth.IsUserPasswordValid("guest", "secret"))
+	assert.False(t, auth.IsUserPasswordValid("guest", "wrong"))
+	users, err := auth.Users()
+	assert.NoError(t, err)
+	assert.Equal(t, []User{{Name: "guest", Role: RoleViewer}}, users)
+
+	token, err := auth.GenerateUserJwtToken("guest", time.Hour)
+	assert.NoError(t, err)
+
+	role, err := auth.JwtRole(token)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleViewer, role)
+
+	ok, _ := auth.ValidateJwtToken(token)
+	assert.False(t, ok, "viewer must not have admin access")
+
+	// role change applies to existing tokens, password is retained
+	assert.NoError(t, auth.SetUser("guest", "", RoleOperator))
+	role, _ = auth.JwtRole(token)
+	assert.Equal(t, RoleOperator, role)
+	assert.True(t, auth.IsUserPasswordValid("guest", "secret"))
+
+	// removed users are rejected
+	assert.NoError(t, auth.RemoveUser("guest"))
+	_, err = auth.JwtRole(token)
+	assert.Error(t, err)
+
+	// invalid users are not ignored
+	store[keys.Users] = "{"
+	_, err = auth.HasUsers()
+	assert.Error(t, err)
+	assert.Error(t, auth.SetUser("guest", "secret", RoleViewer))
+	assert.Equal(t, "{", store[keys.Users])
+}
+
+func TestRoleIncludes(t *testing.T) {
+	assert.True(t, RoleAdmin.Includes(RoleOperator))
+	assert.True(t, RoleOperator.Includes(RoleViewer))
+	assert.True(t, RoleViewer.Includes(RoleViewer))
+	assert.False(t, RoleViewer.Includes(RoleOperator))
+	assert.False(t, Role("").Includes(RoleViewer))
+}
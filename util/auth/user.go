@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Role is the role of a user
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"   // read-only access
+	RoleOperator Role = "operator" // charging control
+	RoleAdmin    Role = "admin"    // configuration and system access
+)
+
+var roles = []Role{RoleViewer, RoleOperator, RoleAdmin}
+
+// RoleString parses a role
+func RoleString(s string) (Role, error) {
+	if r := Role(s); slices.Contains(roles, r) {
+		return r, nil
+	}
+	return "", fmt.Errorf("invalid role: %s", s)
+}
+
+// Includes returns true if the role grants the permissions of the other role
+func (r Role) Includes(other Role) bool {
+	return slices.Index(roles, r) >= max(slices.Index(roles, other), 0)
+}
+
+// User is a user account in addition to the admin
+type User struct {
+	Name string `json:"name"`
+	Role Role   `json:"role"`
+	Hash string `json:"hash,omitempty"`
+}
+
+// getUsers returns the user accounts. Invalid settings are returned as error to deny access.
+func (a *auth) getUsers() ([]User, error) {
+	s, err := a.settings.String(keys.Users)
+	if errors.Is(err, settings.ErrNotFound) || err == nil && s == "" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []User
+	if err := json.Unmarshal([]byte(s), &res); err != nil {
+		return nil, fmt.Errorf("invalid users: %w", err)
+	}
+
+	return res, nil
+}
+
+func (a *auth) setUsers(users []User) error {
+	b, err := json.Marshal(users)
+	if err == nil {
+		a.settings.SetString(keys.Users, string(b))
+	}
+	return err
+}
+
+// user returns the user with given name
+func (a *auth) user(name string) (User, error) {
+	users, err := a.getUsers()
+	if err != nil {
+		return User{}, err
+	}
+
+	idx := slices.IndexFunc(users, func(u User) bool { return u.Name == name })
+	if idx < 0 {
+		return User{}, errors.New("unknown user")
+	}
+
+	return users[idx], nil
+}
+
+// HasUsers checks if user accounts besides the admin are configured
+func (a *auth) HasUsers() (bool, error) {
+	users, err := a.getUsers()
+	return len(users) > 0, err
+}
+
+// Users returns the user accounts without password hashes
+func (a *auth) Users() ([]User, error) {
+	res, err := a.getUsers()
+	for i := range res {
+		res[i].Hash = ""
+	}
+	return res, err
+}
+
+// SetUser creates or updates a user account. The password of existing users is retained if empty.
+func (a *auth) SetUser(name, password string, role Role) error {
+	if name == "" || name == admin {
+		return errors.New("invalid user name")
+	}
+
+	if _, err := RoleString(string(role)); err != nil {
+		return err
+	}
+
+	users, err := a.getUsers()
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(users, func(u User) bool { return u.Name == name })
+
+	if idx < 0 {
+		if password == "" {
+			return errors.New("password cannot be empty")
+		}
+
+		users = append(users, User{Name: name})
+		idx = len(users) - 1
+	}
+
+	if password != "" {
+		hashed, err := a.hashPassword(password)
+		if err != nil {
+			return err
+		}
+		users[idx].Hash = hashed
+	}
+
+	users[idx].Role = role
+
+	return a.setUsers(users)
+}
+
+// RemoveUser removes a user account
+func (a *auth) RemoveUser(name string) error {
+	users, err := a.getUsers()
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(users, func(u User) bool { return u.Name == name })
+	if idx < 0 {
+		return fmt.Errorf("user not found: %s", name)
+	}
+
+	return a.setUsers(slices.Delete(users, idx, idx+1))
+}
+
+// IsUserPasswordValid checks if the given password matches the user's password.
+// An empty user name refers to the admin.
+func (a *auth) IsUserPasswordValid(name, password string) bool {
+	if name == "" || name == admin {
+		return a.IsAdminPasswordValid(password)
+	}
+
+	u, err := a.user(name)
+	return err == nil && bcrypt.CompareHashAndPassword([]byte(u.Hash), []byte(password)) == nil
+}
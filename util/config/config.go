@@ -76,8 +76,16 @@ func (d *Config) Delete() error {
 	return db.Instance.Delete(Config{ID: d.ID}).Error
 }
 
+// migrations of device configurations, append only
+var migrations = []db.Migration{
+	{Description: "initial schema"},
+}
+
 func Init() error {
-	return db.Instance.AutoMigrate(new(Config))
+	if err := db.Instance.AutoMigrate(new(Config)); err != nil {
+		return err
+	}
+	return db.Migrate("configs", migrations...)
 }
 
 // NameForID returns a unique config name for the given id
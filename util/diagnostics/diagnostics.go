@@ -0,0 +1,118 @@
+// Package diagnostics provides optional device status values like firmware version or signal strength.
+// Values are provided by devices implementing api.DiagnosticValues or by the plugins a device was created from.
+package diagnostics
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+type ctxCollector struct{}
+
+// Collector collects the diagnostics of the plugins of a device
+type Collector struct {
+	mu      sync.Mutex
+	sources []api.DiagnosticValues
+}
+
+// WithCollector returns a context collecting the diagnostics of plugins created using it
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	c := new(Collector)
+	return context.WithValue(ctx, ctxCollector{}, c), c
+}
+
+// FromContext returns the context's collector or nil
+func FromContext(ctx context.Context) *Collector {
+	c, _ := ctx.Value(ctxCollector{}).(*Collector)
+	return c
+}
+
+// Add adds a diagnostics source
+func (c *Collector) Add(source api.DiagnosticValues) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sources = append(c.sources, source)
+}
+
+// DiagnosticValues implements the api.DiagnosticValues interface
+func (c *Collector) DiagnosticValues() (map[string]any, error) {
+	c.mu.Lock()
+	sources := c.sources
+	c.mu.Unlock()
+
+	var res map[string]any
+	for _, s := range sources {
+		if vals, err := s.DiagnosticValues(); err == nil {
+			if res == nil {
+				res = make(map[string]any)
+			}
+			maps.Copy(res, vals)
+		}
+	}
+
+	if res == nil {
+		return nil, api.ErrNotAvailable
+	}
+
+	return res, nil
+}
+
+var (
+	mu      sync.Mutex
+	devices = make(map[any]*Collector)
+)
+
+// Register attaches the collected diagnostics to the device instance.
+// Nothing is registered if no plugin provides diagnostics.
+func (c *Collector) Register(dev any) {
+	c.mu.Lock()
+	empty := len(c.sources) == 0
+	c.mu.Unlock()
+
+	if empty || dev == nil || !reflect.TypeOf(dev).Comparable() {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	devices[dev] = c
+}
+
+// Unregister removes the diagnostics of the device instance, e.g. when the device is replaced
+func Unregister(dev any) {
+	if dev == nil || !reflect.TypeOf(dev).Comparable() {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(devices, dev)
+}
+
+// Values returns the diagnostic values of the device instance
+func Values(dev any) (map[string]any, error) {
+	if d, ok := dev.(api.DiagnosticValues); ok {
+		return d.DiagnosticValues()
+	}
+
+	if dev == nil || !reflect.TypeOf(dev).Comparable() {
+		return nil, api.ErrNotAvailable
+	}
+
+	mu.Lock()
+	c, ok := devices[dev]
+	mu.Unlock()
+
+	if !ok {
+		return nil, api.ErrNotAvailable
+	}
+
+	return c.DiagnosticValues()
+}
@@ -0,0 +1,50 @@
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type source map[string]any
+
+func (s source) DiagnosticValues() (map[string]any, error) {
+	if s == nil {
+		return nil, api.ErrNotAvailable
+	}
+	return s, nil
+}
+
+type device struct{}
+
+func TestDiagnostics(t *testing.T) {
+	ctx, c := WithCollector(context.Background())
+	require.Same(t, c, FromContext(ctx))
+
+	dev := new(device)
+
+	// no sources
+	c.Register(dev)
+	_, err := Values(dev)
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	c.Add(source(nil))
+	c.Add(source{"rssi": 70})
+	c.Register(dev)
+
+	vals, err := Values(dev)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"rssi": 70}, vals)
+
+	// devices implementing the interface take precedence
+	vals, err = Values(source{"firmware": "1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"firmware": "1.0"}, vals)
+
+	Unregister(dev)
+	_, err = Values(dev)
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
@@ -0,0 +1,156 @@
+package feature
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/evcc-io/evcc/core/keys"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/sponsor"
+)
+
+// Flag is an experimental feature that can be toggled at runtime
+type Flag string
+
+const (
+	Optimizer Flag = "optimizer" // battery and vehicle co-optimization
+)
+
+// Status is the state of a feature flag
+type Status struct {
+	Name        Flag   `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Available   bool   `json:"available"`
+	Reason      string `json:"reason,omitempty"` // why the feature is not available
+}
+
+type definition struct {
+	description string
+	enabled     bool // default if not persisted
+	available   func() error
+}
+
+var flags = map[Flag]definition{
+	Optimizer: {
+		description: "Battery and vehicle co-optimization using an external optimizer",
+		enabled:     true, // opt-out for existing sponsored setups using EVOPT_URI
+		available: func() error {
+			if !sponsor.IsAuthorized() {
+				return errors.New("requires sponsorship")
+			}
+			if os.Getenv("EVOPT_URI") == "" {
+				return errors.New("EVOPT_URI not set")
+			}
+			return nil
+		},
+	},
+}
+
+var (
+	mu        sync.Mutex
+	state     map[Flag]bool // persisted flag states, read once
+	publisher chan<- util.Param
+)
+
+// enabled returns the flag state, falling back to the default if not persisted. Caller must hold the lock.
+func enabled(f Flag, def definition) bool {
+	if state == nil {
+		state = make(map[Flag]bool)
+		_ = settings.Json(keys.Features, &state)
+	}
+
+	if v, ok := state[f]; ok {
+		return v
+	}
+
+	return def.enabled
+}
+
+// Enabled returns true if the feature is enabled and available
+func Enabled(f Flag) bool {
+	def, ok := flags[f]
+	if !ok {
+		return false
+	}
+
+	mu.Lock()
+	en := enabled(f, def)
+	mu.Unlock()
+
+	return en && def.available() == nil
+}
+
+// Enable enables or disables the feature
+func Enable(f Flag, enable bool) error {
+	def, ok := flags[f]
+	if !ok {
+		return fmt.Errorf("invalid feature: %s", f)
+	}
+
+	if err := def.available(); enable && err != nil {
+		return fmt.Errorf("%s: %w", f, err)
+	}
+
+	mu.Lock()
+
+	_ = enabled(f, def)
+	state[f] = enable
+	err := settings.SetJson(keys.Features, state)
+
+	mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	publish()
+
+	return nil
+}
+
+// All returns the status of all feature flags
+func All() []Status {
+	mu.Lock()
+	defer mu.Unlock()
+
+	res := make([]Status, 0, len(flags))
+	for f, def := range flags {
+		s := Status{
+			Name:        f,
+			Description: def.description,
+			Enabled:     enabled(f, def),
+			Available:   true,
+		}
+
+		if err := def.available(); err != nil {
+			s.Available = false
+			s.Reason = err.Error()
+		}
+
+		res = append(res, s)
+	}
+
+	slices.SortFunc(res, func(a, b Status) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return res
+}
+
+// publish publishes the current feature flag states
+func publish() {
+	if publisher != nil {
+		publisher <- util.Param{Key: keys.Features, Val: All()}
+	}
+}
+
+func Create(valueChan chan<- util.Param) {
+	publisher = valueChan
+	publish()
+}
@@ -0,0 +1,56 @@
+package feature
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeature(t *testing.T) {
+	const test Flag = "test"
+
+	var unavailable error
+	flags[test] = definition{
+		description: "test",
+		available:   func() error { return unavailable },
+	}
+	t.Cleanup(func() {
+		delete(flags, test)
+		delete(state, test)
+	})
+
+	assert.False(t, Enabled(test))
+	require.NoError(t, Enable(test, true))
+	assert.True(t, Enabled(test))
+
+	// persisted state is kept while unavailable
+	unavailable = errors.New("unavailable")
+	assert.False(t, Enabled(test))
+	assert.Contains(t, All(), Status{Name: test, Description: "test", Enabled: true, Reason: "unavailable"})
+
+	require.Error(t, Enable(test, true))
+	require.NoError(t, Enable(test, false))
+
+	require.Error(t, Enable("foo", true))
+}
+
+func TestFeatureDefault(t *testing.T) {
+	const test Flag = "default"
+
+	flags[test] = definition{
+		description: "default",
+		enabled:     true,
+		available:   func() error { return nil },
+	}
+	t.Cleanup(func() {
+		delete(flags, test)
+		delete(state, test)
+	})
+
+	// enabled unless opted out
+	assert.True(t, Enabled(test))
+	require.NoError(t, Enable(test, false))
+	assert.False(t, Enabled(test))
+}
@@ -49,21 +49,45 @@ func Init() error {
 			Sessions struct {
 				CSV map[string]string `json:"csv"`
 			} `json:"sessions"`
+			Push struct {
+				Events map[string]struct {
+					Title string `json:"title"`
+					Msg   string `json:"msg"`
+				} `json:"events"`
+				Delayed map[string]string `json:"delayed"`
+			} `json:"push"`
 		}
 
 		if err := json.Unmarshal(b, &s); err != nil {
 			return err
 		}
 
-		if len(s.Sessions.CSV) > 0 {
-			m := make([]*i18n.Message, 0, len(s.Sessions.CSV))
-			for k, v := range s.Sessions.CSV {
-				m = append(m, &i18n.Message{
-					ID:    "sessions.csv." + k,
-					Other: v,
-				})
-			}
+		m := make([]*i18n.Message, 0, len(s.Sessions.CSV)+2*len(s.Push.Events)+len(s.Push.Delayed))
+		for k, v := range s.Sessions.CSV {
+			m = append(m, &i18n.Message{
+				ID:    "sessions.csv." + k,
+				Other: v,
+			})
+		}
+
+		for k, v := range s.Push.Events {
+			m = append(m, &i18n.Message{
+				ID:    "push.events." + k + ".title",
+				Other: v.Title,
+			}, &i18n.Message{
+				ID:    "push.events." + k + ".msg",
+				Other: v.Msg,
+			})
+		}
+
+		for k, v := range s.Push.Delayed {
+			m = append(m, &i18n.Message{
+				ID:    "push.delayed." + k,
+				Other: v,
+			})
+		}
 
+		if len(m) > 0 {
 			languageTag := language.Make(strings.TrimSuffix(d.Name(), filepath.Ext(d.Name())))
 			if err := Bundle.AddMessages(languageTag, m...); err != nil {
 				return fmt.Errorf("loading locales failed: %w", err)
@@ -0,0 +1,330 @@
+package modbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// AA55 is the GoodWe udp protocol used by the Wi-Fi/LAN dongles of GoodWe ET/EH/BT/BH inverters.
+// Requests are plain Modbus RTU frames, responses are RTU frames prefixed by the AA55 header.
+const (
+	aa55Scheme         = "aa55://"
+	aa55DefaultPort    = 8899
+	aa55DefaultTimeout = 5 * time.Second
+	aa55Retransmit     = time.Second // retransmission interval of unanswered requests
+	aa55MaxFrame       = 512
+)
+
+var aa55Header = []byte{0xAA, 0x55}
+
+// AA55Connection implements meters.Connection for Modbus RTU over the GoodWe AA55 udp protocol
+type AA55Connection struct {
+	Client  modbus.Client
+	handler *aa55Handler
+}
+
+var _ meters.Connection = (*AA55Connection)(nil)
+
+// the dongles only answer a single client reliably, transports are shared per address
+var (
+	aa55Transports   = make(map[string]*aa55Transport)
+	aa55TransportsMu sync.Mutex
+)
+
+func sharedAA55Transport(address string) *aa55Transport {
+	aa55TransportsMu.Lock()
+	defer aa55TransportsMu.Unlock()
+
+	t, ok := aa55Transports[address]
+	if !ok {
+		t = &aa55Transport{
+			log:        util.NewLogger("aa55"),
+			address:    address,
+			timeout:    aa55DefaultTimeout,
+			retransmit: aa55Retransmit,
+		}
+		aa55Transports[address] = t
+	}
+
+	return t
+}
+
+// NewAA55 creates a GoodWe AA55 modbus client
+func NewAA55(address string) *AA55Connection {
+	handler := &aa55Handler{
+		transport: sharedAA55Transport(address),
+	}
+
+	return &AA55Connection{
+		Client:  modbus.NewClient(handler),
+		handler: handler,
+	}
+}
+
+// String returns the bus connection address
+func (b *AA55Connection) String() string {
+	return b.handler.transport.address
+}
+
+// ModbusClient returns the modbus client
+func (b *AA55Connection) ModbusClient() modbus.Client {
+	return b.Client
+}
+
+// Logger implements meters.Connection.
+// AA55 traffic is logged to the aa55 log area instead.
+func (b *AA55Connection) Logger(l meters.Logger) {}
+
+// Slave sets the modbus device id for the following operations
+func (b *AA55Connection) Slave(deviceID uint8) {
+	b.handler.SetSlave(deviceID)
+}
+
+// Timeout sets the modbus timeout
+func (b *AA55Connection) Timeout(timeout time.Duration) time.Duration {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	res := t.timeout
+	t.timeout = timeout
+	return res
+}
+
+// ConnectDelay sets the the initial delay after connecting before starting communication
+func (b *AA55Connection) ConnectDelay(delay time.Duration) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.connectDelay = delay
+}
+
+// Close closes the modbus connection.
+// This forces the modbus client to reopen the connection before the next bus operations.
+func (b *AA55Connection) Close() {
+	_ = b.handler.Close()
+}
+
+// Clone clones the modbus connection for the given slave id, keeping the underlying transport
+func (b *AA55Connection) Clone(deviceID byte) meters.Connection {
+	handler := &aa55Handler{
+		transport: b.handler.transport,
+	}
+	handler.SetSlave(deviceID)
+
+	return &AA55Connection{
+		Client:  modbus.NewClient(handler),
+		handler: handler,
+	}
+}
+
+// aa55Handler implements the grid-x modbus ClientHandler.
+// Packaging is Modbus RTU, transport strips the AA55 header from responses.
+type aa55Handler struct {
+	slaveID   byte
+	transport *aa55Transport
+}
+
+var _ modbus.ClientHandler = (*aa55Handler)(nil)
+
+func (h *aa55Handler) SetSlave(slaveID byte) {
+	h.slaveID = slaveID
+}
+
+// Encode encodes the PDU as Modbus RTU frame
+func (h *aa55Handler) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	adu := make([]byte, 0, len(pdu.Data)+4)
+	adu = append(adu, h.slaveID, pdu.FunctionCode)
+	adu = append(adu, pdu.Data...)
+	return binary.LittleEndian.AppendUint16(adu, crc16(adu)), nil
+}
+
+// Verify verifies the Modbus RTU response matches the request
+func (h *aa55Handler) Verify(aduRequest, aduResponse []byte) error {
+	if len(aduResponse) < rtuMinSize {
+		return fmt.Errorf("modbus: response length '%d' does not meet minimum '%d'", len(aduResponse), rtuMinSize)
+	}
+	if aduResponse[0] != aduRequest[0] {
+		return fmt.Errorf("modbus: response slave id '%v' does not match request '%v'", aduResponse[0], aduRequest[0])
+	}
+	return nil
+}
+
+// Decode extracts the PDU from the Modbus RTU frame
+func (h *aa55Handler) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	n := len(adu)
+	if crc, expected := binary.LittleEndian.Uint16(adu[n-2:]), crc16(adu[:n-2]); crc != expected {
+		return nil, fmt.Errorf("modbus: response crc '%04x' does not match expected '%04x'", crc, expected)
+	}
+
+	return &modbus.ProtocolDataUnit{
+		FunctionCode: adu[1],
+		Data:         adu[2 : n-2],
+	}, nil
+}
+
+func (h *aa55Handler) Send(aduRequest []byte) ([]byte, error) {
+	return h.transport.Send(aduRequest)
+}
+
+func (h *aa55Handler) Connect() error {
+	return h.transport.Connect()
+}
+
+func (h *aa55Handler) Close() error {
+	return h.transport.Close()
+}
+
+// aa55Transport is the physical dongle connection shared by all handlers
+type aa55Transport struct {
+	mu           sync.Mutex
+	log          *util.Logger
+	address      string
+	timeout      time.Duration
+	connectDelay time.Duration
+	retransmit   time.Duration
+	conn         net.Conn
+}
+
+// Send sends the request and returns the RTU frame of the matching response.
+// Datagrams may get lost, the request is retransmitted until the timeout.
+func (t *aa55Transport) Send(aduRequest []byte) ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.connect(); err != nil {
+		return nil, err
+	}
+
+	t.log.TRACE.Printf("%s: send % X", t.address, aduRequest)
+
+	deadline := time.Now().Add(t.timeout)
+
+	for {
+		if _, err := t.conn.Write(aduRequest); err != nil {
+			_ = t.close()
+			return nil, err
+		}
+
+		next := time.Now().Add(t.retransmit)
+		if deadline.Before(next) {
+			next = deadline
+		}
+		if err := t.conn.SetReadDeadline(next); err != nil {
+			return nil, err
+		}
+
+		adu, err := t.readResponse(aduRequest)
+		if err == nil {
+			return adu, nil
+		}
+
+		if !isTimeout(err) || !time.Now().Before(deadline) {
+			_ = t.close()
+			return nil, err
+		}
+
+		t.log.DEBUG.Printf("%s: retransmitting unanswered request", t.address)
+	}
+}
+
+// readResponse reads datagrams until the response matching the request is received.
+// Late responses to earlier requests are discarded.
+func (t *aa55Transport) readResponse(aduRequest []byte) ([]byte, error) {
+	buf := make([]byte, aa55MaxFrame)
+
+	for {
+		n, err := t.conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		t.log.TRACE.Printf("%s: recv % X", t.address, buf[:n])
+
+		adu, err := parseAA55Response(buf[:n], aduRequest)
+		if err != nil {
+			t.log.DEBUG.Printf("%s: %v", t.address, err)
+			continue
+		}
+
+		return adu, nil
+	}
+}
+
+// parseAA55Response returns the RTU frame of the response if it matches the request
+func parseAA55Response(frame, aduRequest []byte) ([]byte, error) {
+	if !bytes.HasPrefix(frame, aa55Header) || len(frame) < len(aa55Header)+rtuMinSize {
+		return nil, errors.New("aa55: invalid frame")
+	}
+
+	adu := frame[len(aa55Header):]
+	if adu[0] != aduRequest[0] || adu[1]&0x7F != aduRequest[1] {
+		return nil, fmt.Errorf("aa55: discarding response of slave %d function %d", adu[0], adu[1])
+	}
+
+	return bytes.Clone(adu), nil
+}
+
+// Connect establishes the dongle connection
+func (t *aa55Transport) Connect() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.connect()
+}
+
+func (t *aa55Transport) connect() error {
+	if t.conn != nil {
+		return nil
+	}
+
+	conn, err := net.DialTimeout("udp", t.address, t.timeout)
+	if err != nil {
+		return err
+	}
+
+	t.conn = conn
+
+	// silent period
+	time.Sleep(t.connectDelay)
+
+	return nil
+}
+
+// Close closes the dongle connection
+func (t *aa55Transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.close()
+}
+
+func (t *aa55Transport) close() error {
+	if t.conn == nil {
+		return nil
+	}
+
+	err := t.conn.Close()
+	t.conn = nil
+
+	return err
+}
+
+// aa55Address returns the address of aa55://host:port uris
+func aa55Address(uri string) string {
+	if strings.HasPrefix(strings.ToLower(uri), aa55Scheme) {
+		uri = uri[len(aa55Scheme):]
+	}
+	return util.DefaultPort(uri, aa55DefaultPort)
+}
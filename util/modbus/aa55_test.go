@@ -0,0 +1,83 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func aa55Frame(adu ...byte) []byte {
+	adu = binary.LittleEndian.AppendUint16(adu, crc16(adu))
+	return append([]byte{0xAA, 0x55}, adu...)
+}
+
+func TestAA55(t *testing.T) {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		b := make([]byte, 64)
+
+		// drop first request to force retransmission
+		if _, _, err := l.ReadFrom(b); err != nil {
+			return
+		}
+
+		_, addr, err := l.ReadFrom(b)
+		if err != nil {
+			return
+		}
+
+		// stale response of another request is discarded
+		_, _ = l.WriteTo(aa55Frame(0xF7, 0x04, 0x02, 0x00, 0x01), addr)
+		_, _ = l.WriteTo(aa55Frame(0xF7, 0x03, 0x02, 0x12, 0x34), addr)
+
+		if _, _, err := l.ReadFrom(b); err != nil {
+			return
+		}
+		_, _ = l.WriteTo(aa55Frame(0xF7, 0x83, 0x02), addr)
+	}()
+
+	conn := NewAA55(l.LocalAddr().String())
+	conn.handler.transport.retransmit = 50 * time.Millisecond
+	conn.Timeout(time.Second)
+	conn.Slave(0xF7)
+
+	b, err := conn.ModbusClient().ReadHoldingRegisters(35100, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x12, 0x34}, b)
+
+	_, err = conn.ModbusClient().ReadHoldingRegisters(35100, 1)
+	code, ok := ExceptionCode(err)
+	require.True(t, ok, err)
+	assert.Equal(t, ExceptionIllegalDataAddress, code)
+}
+
+func TestParseAA55Response(t *testing.T) {
+	req := []byte{0xF7, 0x03, 0x89, 0x1C, 0x00, 0x7D, 0x7A, 0xE7}
+
+	for _, tc := range []struct {
+		frame []byte
+		ok    bool
+	}{
+		{aa55Frame(0xF7, 0x03, 0x02, 0x00, 0x01), true},
+		{aa55Frame(0xF7, 0x83, 0x02), true},
+		{aa55Frame(0x01, 0x03, 0x02, 0x00, 0x01), false},
+		{aa55Frame(0xF7, 0x04, 0x02, 0x00, 0x01), false},
+		{[]byte{0xF7, 0x03, 0x02, 0x00, 0x01}, false},
+		{[]byte{0xAA, 0x55, 0xF7}, false},
+	} {
+		adu, err := parseAA55Response(tc.frame, req)
+		if !tc.ok {
+			assert.Error(t, err, tc.frame)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.frame[2:], adu)
+	}
+}
@@ -0,0 +1,132 @@
+package modbus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+const maxBlockQuantity = 125 // maximum register quantity of a single read
+
+// Block is a register range read at once. Plugins of the same device declaring the same block
+// share a snapshot of the block instead of reading their registers individually.
+type Block struct {
+	Type     string        // holding or input
+	Address  uint16        // first register
+	Quantity uint16        // number of registers
+	Cache    time.Duration // maximum age of the snapshot
+}
+
+func (b Block) FuncCode() (uint8, error) {
+	switch strings.ToLower(b.Type) {
+	case "holding":
+		return modbus.FuncCodeReadHoldingRegisters, nil
+	case "input":
+		return modbus.FuncCodeReadInputRegisters, nil
+	default:
+		return 0, fmt.Errorf("invalid block type: %s", b.Type)
+	}
+}
+
+func (b Block) Error() error {
+	if _, err := b.FuncCode(); err != nil {
+		return err
+	}
+	if b.Quantity == 0 || b.Quantity > maxBlockQuantity {
+		return fmt.Errorf("invalid block quantity: %d", b.Quantity)
+	}
+	if uint32(b.Address)+uint32(b.Quantity) > 1<<16 {
+		return errors.New("block exceeds address space")
+	}
+	return nil
+}
+
+// Contains checks if the operation reads registers of the block
+func (b Block) Contains(op RegisterOperation) bool {
+	fc, err := b.FuncCode()
+	return err == nil && op.FuncCode == fc && op.Addr >= b.Address && uint32(op.Addr)+uint32(op.Length) <= uint32(b.Address)+uint32(b.Quantity)
+}
+
+// blockSnapshot is the shared content of a block
+type blockSnapshot struct {
+	mu      sync.Mutex
+	data    []byte
+	updated time.Time
+}
+
+var (
+	blockMu        sync.Mutex
+	blockSnapshots = make(map[string]*blockSnapshot)
+)
+
+func (b Block) key(conn *Connection) string {
+	return fmt.Sprintf("%s:%s:%d:%d", conn.Addr(), strings.ToLower(b.Type), b.Address, b.Quantity)
+}
+
+func (b Block) snapshot(conn *Connection) *blockSnapshot {
+	blockMu.Lock()
+	defer blockMu.Unlock()
+
+	key := b.key(conn)
+	s, ok := blockSnapshots[key]
+	if !ok {
+		s = new(blockSnapshot)
+		blockSnapshots[key] = s
+	}
+
+	return s
+}
+
+// Read returns the registers of the operation from the block snapshot, reading the block if the snapshot has expired
+func (b Block) Read(conn *Connection, op RegisterOperation) ([]byte, error) {
+	if !b.Contains(op) {
+		return nil, fmt.Errorf("register %d outside block", op.Addr)
+	}
+
+	s := b.snapshot(conn)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data == nil || time.Since(s.updated) >= b.Cache {
+		var (
+			data []byte
+			err  error
+		)
+
+		if op.FuncCode == modbus.FuncCodeReadInputRegisters {
+			data, err = conn.ReadInputRegisters(b.Address, b.Quantity)
+		} else {
+			data, err = conn.ReadHoldingRegisters(b.Address, b.Quantity)
+		}
+		if err != nil {
+			s.data = nil
+			return nil, err
+		}
+
+		if len(data) != 2*int(b.Quantity) {
+			s.data = nil
+			return nil, fmt.Errorf("invalid block length: %d", len(data))
+		}
+
+		s.data = data
+		s.updated = time.Now()
+	}
+
+	offset := 2 * int(op.Addr-b.Address)
+	return bytes.Clone(s.data[offset : offset+2*int(op.Length)]), nil
+}
+
+// Invalidate discards the snapshot, e.g. after writing registers
+func (b Block) Invalidate(conn *Connection) {
+	s := b.snapshot(conn)
+
+	s.mu.Lock()
+	s.data = nil
+	s.mu.Unlock()
+}
@@ -0,0 +1,78 @@
+package modbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockTestClient struct {
+	failoverTestClient
+	reads int
+}
+
+func (c *blockTestClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	c.reads++
+	b := make([]byte, 2*quantity)
+	for i := range quantity {
+		b[2*i+1] = byte(address + i)
+	}
+	return b, nil
+}
+
+type blockTestConn struct {
+	failoverTestConn
+	client *blockTestClient
+}
+
+func (c *blockTestConn) String() string {
+	return "block"
+}
+
+func (c *blockTestConn) ModbusClient() modbus.Client {
+	return c.client
+}
+
+func TestBlock(t *testing.T) {
+	client := new(blockTestClient)
+	conn := &Connection{
+		logger:     new(logger),
+		Connection: &blockTestConn{client: client},
+		slaveID:    1,
+	}
+
+	b := Block{Type: "holding", Address: 10, Quantity: 10, Cache: time.Hour}
+	require.NoError(t, b.Error())
+
+	reg := func(addr, length uint16) RegisterOperation {
+		return RegisterOperation{FuncCode: 3, Addr: addr, Length: length}
+	}
+
+	assert.True(t, b.Contains(reg(10, 10)))
+	assert.False(t, b.Contains(reg(19, 2)))
+	assert.False(t, b.Contains(RegisterOperation{FuncCode: 4, Addr: 10, Length: 1}))
+
+	res, err := b.Read(conn, reg(12, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 12, 0, 13}, res)
+
+	res, err = b.Read(conn, reg(19, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 19}, res)
+	assert.Equal(t, 1, client.reads)
+
+	b.Invalidate(conn)
+	_, err = b.Read(conn, reg(10, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.reads)
+
+	_, err = b.Read(conn, reg(20, 1))
+	require.Error(t, err)
+
+	assert.Error(t, Block{Type: "coil", Quantity: 1}.Error())
+	assert.Error(t, Block{Type: "input", Quantity: 126}.Error())
+}
@@ -0,0 +1,152 @@
+package modbus
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// coalescer merges adjacent register reads issued within a short window into a single request.
+// It is shared by all users of the physical connection and runs before the bus is acquired, so that
+// concurrent reads of different devices or plugins can be combined.
+// Deye and similar inverters require many small reads per update cycle, each being a slow logger round trip.
+type coalescer struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending []*coalescedRead
+}
+
+// coalescedRead is a pending holding or input register read
+type coalescedRead struct {
+	conn      *Connection
+	fn        byte
+	addr, qty uint16
+	res       []byte
+	err       error
+	done      chan struct{}
+}
+
+func (r *coalescedRead) end() uint16 {
+	return r.addr + r.qty
+}
+
+func newCoalescer(window time.Duration) *coalescer {
+	return &coalescer{
+		window: window,
+	}
+}
+
+// read queues the register read for the coalescing window
+func (c *coalescer) read(conn *Connection, fn byte, addr, qty uint16) ([]byte, error) {
+	r := &coalescedRead{
+		conn: conn,
+		fn:   fn,
+		addr: addr,
+		qty:  qty,
+		done: make(chan struct{}),
+	}
+
+	c.mu.Lock()
+	c.pending = append(c.pending, r)
+	if len(c.pending) == 1 {
+		time.AfterFunc(c.window, c.flush)
+	}
+	c.mu.Unlock()
+
+	<-r.done
+
+	return r.res, r.err
+}
+
+// flush executes all pending reads
+func (c *coalescer) flush() {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	for _, group := range coalesceReads(pending) {
+		go execute(group)
+	}
+}
+
+// coalesceReads groups adjacent or overlapping reads of the same slave and function
+func coalesceReads(reads []*coalescedRead) [][]*coalescedRead {
+	sorted := slices.Clone(reads)
+	slices.SortStableFunc(sorted, func(a, b *coalescedRead) int {
+		return cmp.Or(
+			cmp.Compare(a.conn.slaveID, b.conn.slaveID),
+			cmp.Compare(a.fn, b.fn),
+			cmp.Compare(a.addr, b.addr),
+		)
+	})
+
+	var (
+		res      [][]*coalescedRead
+		start    uint16
+		end      uint16
+		previous *coalescedRead
+	)
+
+	for _, r := range sorted {
+		if previous != nil && r.conn.slaveID == previous.conn.slaveID && r.fn == previous.fn &&
+			r.addr <= end && max(end, r.end())-start <= maxBlockQuantity {
+			res[len(res)-1] = append(res[len(res)-1], r)
+			end = max(end, r.end())
+			previous = r
+			continue
+		}
+
+		res = append(res, []*coalescedRead{r})
+		start, end = r.addr, r.end()
+		previous = r
+	}
+
+	return res
+}
+
+// execute sends the group as single request and slices the response.
+// If the merged request fails with a Modbus exception, the reads are sent individually.
+func execute(group []*coalescedRead) {
+	first := group[0]
+
+	if len(group) == 1 {
+		first.res, first.err = first.conn.readRegisters(first.fn, first.addr, first.qty)
+		close(first.done)
+		return
+	}
+
+	start, end := first.addr, first.end()
+	for _, r := range group[1:] {
+		end = max(end, r.end())
+	}
+
+	res, err := first.conn.readRegisters(first.fn, start, end-start)
+
+	var me *modbus.Error
+	if errors.As(err, &me) {
+		for _, r := range group {
+			execute([]*coalescedRead{r})
+		}
+		return
+	}
+
+	if err == nil && len(res) != 2*int(end-start) {
+		err = fmt.Errorf("modbus: coalesced response length '%d' does not match quantity '%d'", len(res), end-start)
+	}
+
+	for _, r := range group {
+		if err != nil {
+			r.err = err
+		} else {
+			offset := 2 * int(r.addr-start)
+			r.res = slices.Clone(res[offset : offset+2*int(r.qty)])
+		}
+		close(r.done)
+	}
+}
@@ -0,0 +1,97 @@
+package modbus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesceReads(t *testing.T) {
+	conn1, conn2 := &Connection{slaveID: 1}, &Connection{slaveID: 2}
+
+	read := func(conn *Connection, fn byte, addr, qty uint16) *coalescedRead {
+		return &coalescedRead{conn: conn, fn: fn, addr: addr, qty: qty}
+	}
+
+	a := read(conn1, 3, 10, 2)
+	b := read(conn1, 3, 12, 1)   // adjacent
+	c := read(conn1, 3, 11, 4)   // overlapping
+	d := read(conn1, 3, 20, 1)   // gap
+	e := read(conn1, 4, 13, 1)   // other function
+	f := read(conn2, 3, 12, 1)   // other slave
+	g := read(conn1, 3, 21, 125) // too large
+
+	res := coalesceReads([]*coalescedRead{g, f, e, d, c, b, a})
+	assert.Equal(t, [][]*coalescedRead{{a, c, b}, {d}, {g}, {e}, {f}}, res)
+}
+
+func TestCoalesceConnection(t *testing.T) {
+	const loggerSerial = 2712345730
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 100, 1, 2, 3)
+	e.SetRegisters(2, 100, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := NewConnectionWithSettings(ctx, Settings{
+		URI: fmt.Sprintf("solarmanv5://%s?serial=%d&coalesce=50ms", e.Addr(), loggerSerial),
+		ID:  1,
+	})
+	require.NoError(t, err)
+
+	// devices sharing the logger
+	conns := []*Connection{conn, conn, conn, conn.Clone(2)}
+	addrs := []uint16{100, 102, 101, 100}
+	expected := [][]byte{{0, 1}, {0, 3}, {0, 2}, {0, 4}}
+
+	var wg sync.WaitGroup
+	for i, c := range conns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			b, err := c.ReadHoldingRegisters(addrs[i], 1)
+			assert.NoError(t, err)
+			assert.Equal(t, expected[i], b)
+		}()
+	}
+	wg.Wait()
+
+	// one merged read per slave
+	assert.Equal(t, 2, e.Requests())
+
+	// merged read failing with exception is retried individually
+	var wg2 sync.WaitGroup
+	for _, addr := range []uint16{102, 103} {
+		wg2.Add(1)
+		go func() {
+			defer wg2.Done()
+
+			_, err := conn.ReadHoldingRegisters(addr, 1)
+			if addr == 102 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		}()
+	}
+	wg2.Wait()
+
+	assert.Equal(t, 5, e.Requests())
+
+	// writes are not coalesced
+	start := time.Now()
+	_, err = conn.WriteSingleRegister(100, 5)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
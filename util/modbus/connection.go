@@ -2,8 +2,11 @@ package modbus
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/grid-x/modbus"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
@@ -11,9 +14,13 @@ import (
 type Connection struct {
 	*logger
 	meters.Connection
-	slaveID uint8 // duplicated from meters.Connection
-	logical meters.Logger
-	delay   time.Duration
+	slaveID  uint8 // duplicated from meters.Connection
+	logical  meters.Logger
+	delay    time.Duration
+	limit    *rateLimit // shared by all users of the physical connection
+	bus      *scheduler // shared by all users of the physical connection
+	coalesce *coalescer // shared by all users of the physical connection, optional
+	priority int
 }
 
 func (c *Connection) Addr() string {
@@ -33,6 +40,10 @@ func (c *Connection) Clone(slaveID uint8) *Connection {
 		slaveID:    slaveID,
 		Connection: c.Connection.Clone(slaveID),
 		logger:     c.logger,
+		limit:      c.limit,
+		bus:        c.bus,
+		coalesce:   c.coalesce,
+		priority:   c.priority,
 	}
 }
 
@@ -50,12 +61,21 @@ func (c *Connection) Timeout(timeout time.Duration) {
 	}
 }
 
-func (c *Connection) exec(fun func() ([]byte, error)) ([]byte, error) {
+// exec executes the transaction once the bus is assigned
+func (c *Connection) exec(write bool, fun func() ([]byte, error)) ([]byte, error) {
+	c.bus.acquire(c.slaveID, c.priority, write)
+	defer c.bus.release()
+
 	return c.WithLogger(c.logical, func() ([]byte, error) {
 		time.Sleep(c.delay)
+		c.limit.wait()
+
+		labels := prometheus.Labels{"connection": c.Connection.String(), "id": strconv.Itoa(int(c.slaveID))}
+		requestMetric.With(labels).Inc()
 
 		b, err := fun()
 		if err != nil {
+			errorMetric.With(labels).Inc()
 			c.Connection.Close()
 		}
 		return b, err
@@ -63,67 +83,79 @@ func (c *Connection) exec(fun func() ([]byte, error)) ([]byte, error) {
 }
 
 func (c *Connection) ReadCoils(address, quantity uint16) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(false, func() ([]byte, error) {
 		return c.ModbusClient().ReadCoils(address, quantity)
 	})
 }
 
 func (c *Connection) WriteSingleCoil(address, value uint16) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().WriteSingleCoil(address, value)
 	})
 }
 
-func (c *Connection) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
-		return c.ModbusClient().ReadInputRegisters(address, quantity)
+// readRegisters executes a holding or input register read without coalescing
+func (c *Connection) readRegisters(fn byte, address, quantity uint16) ([]byte, error) {
+	return c.exec(false, func() ([]byte, error) {
+		if fn == modbus.FuncCodeReadInputRegisters {
+			return c.ModbusClient().ReadInputRegisters(address, quantity)
+		}
+		return c.ModbusClient().ReadHoldingRegisters(address, quantity)
 	})
 }
 
+func (c *Connection) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	if c.coalesce != nil {
+		return c.coalesce.read(c, modbus.FuncCodeReadInputRegisters, address, quantity)
+	}
+	return c.readRegisters(modbus.FuncCodeReadInputRegisters, address, quantity)
+}
+
 func (c *Connection) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
-		return c.ModbusClient().ReadHoldingRegisters(address, quantity)
-	})
+	if c.coalesce != nil {
+		return c.coalesce.read(c, modbus.FuncCodeReadHoldingRegisters, address, quantity)
+	}
+	return c.readRegisters(modbus.FuncCodeReadHoldingRegisters, address, quantity)
 }
 
 func (c *Connection) WriteSingleRegister(address, value uint16) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().WriteSingleRegister(address, value)
 	})
 }
 
 func (c *Connection) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().WriteMultipleRegisters(address, quantity, value)
 	})
 }
 
 func (c *Connection) ReadDiscreteInputs(address, quantity uint16) (results []byte, err error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(false, func() ([]byte, error) {
 		return c.ModbusClient().ReadDiscreteInputs(address, quantity)
 	})
 }
 
 func (c *Connection) WriteMultipleCoils(address, quantity uint16, value []byte) (results []byte, err error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().WriteMultipleCoils(address, quantity, value)
 	})
 }
 
 func (c *Connection) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) (results []byte, err error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
 	})
 }
 
 func (c *Connection) MaskWriteRegister(address, andMask, orMask uint16) (results []byte, err error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(true, func() ([]byte, error) {
 		return c.ModbusClient().MaskWriteRegister(address, andMask, orMask)
 	})
 }
 
 func (c *Connection) ReadFIFOQueue(address uint16) (results []byte, err error) {
-	return c.exec(func() ([]byte, error) {
+	return c.exec(false, func() ([]byte, error) {
 		return c.ModbusClient().ReadFIFOQueue(address)
 	})
 }
@@ -0,0 +1,58 @@
+package modbus
+
+import (
+	"errors"
+
+	"github.com/grid-x/modbus"
+)
+
+// Exception is a Modbus exception code returned by the device
+type Exception byte
+
+// Modbus exceptions
+const (
+	ExceptionIllegalFunction        Exception = modbus.ExceptionCodeIllegalFunction
+	ExceptionIllegalDataAddress     Exception = modbus.ExceptionCodeIllegalDataAddress
+	ExceptionIllegalDataValue       Exception = modbus.ExceptionCodeIllegalDataValue
+	ExceptionServerDeviceFailure    Exception = modbus.ExceptionCodeServerDeviceFailure
+	ExceptionAcknowledge            Exception = modbus.ExceptionCodeAcknowledge
+	ExceptionServerDeviceBusy       Exception = modbus.ExceptionCodeServerDeviceBusy
+	ExceptionGatewayPathUnavailable Exception = modbus.ExceptionCodeGatewayPathUnavailable
+	ExceptionGatewayTargetFailed    Exception = modbus.ExceptionCodeGatewayTargetDeviceFailedToRespond
+)
+
+func (e Exception) Error() string {
+	return (&modbus.Error{ExceptionCode: byte(e)}).Error()
+}
+
+// ExceptionCode returns the Modbus exception contained in err
+func ExceptionCode(err error) (Exception, bool) {
+	var me *modbus.Error
+	if errors.As(err, &me) {
+		return Exception(me.ExceptionCode), true
+	}
+
+	var e Exception
+	if errors.As(err, &e) {
+		return e, true
+	}
+
+	return 0, false
+}
+
+// IsException checks if err is the given Modbus exception
+func IsException(err error, e Exception) bool {
+	code, ok := ExceptionCode(err)
+	return ok && code == e
+}
+
+// isWriteFunction checks if the function code modifies coils or registers
+func isWriteFunction(fn byte) bool {
+	switch fn {
+	case modbus.FuncCodeWriteSingleCoil, modbus.FuncCodeWriteMultipleCoils,
+		modbus.FuncCodeWriteSingleRegister, modbus.FuncCodeWriteMultipleRegisters,
+		modbus.FuncCodeMaskWriteRegister, modbus.FuncCodeReadWriteMultipleRegisters:
+		return true
+	}
+	return false
+}
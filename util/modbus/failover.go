@@ -0,0 +1,237 @@
+package modbus
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// failoverThreshold is the number of consecutive failed requests before switching to the next uri
+const failoverThreshold = 3
+
+// failoverState is the active endpoint shared by all clones
+type failoverState struct {
+	mu       sync.Mutex
+	log      *util.Logger
+	uris     []string
+	active   int
+	failures int
+}
+
+// failover is a connection switching to the next uri on repeated failures
+type failover struct {
+	*failoverState
+	conns []meters.Connection
+}
+
+var _ meters.Connection = (*failover)(nil)
+
+func newFailover(uris []string, conns []meters.Connection) *failover {
+	return &failover{
+		failoverState: &failoverState{
+			log:  util.NewLogger("modbus"),
+			uris: uris,
+		},
+		conns: conns,
+	}
+}
+
+// Endpoints returns the configured uris
+func (f *failover) Endpoints() []string {
+	return f.uris
+}
+
+// Active returns the active uri
+func (f *failover) Active() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.uris[f.active]
+}
+
+// String returns the active bus connection address
+func (f *failover) String() string {
+	return f.Active()
+}
+
+// ModbusClient returns the modbus client of the active connection
+func (f *failover) ModbusClient() modbus.Client {
+	return &failoverClient{f}
+}
+
+// Logger sets a logging instance for physical bus operations
+func (f *failover) Logger(l meters.Logger) {
+	for _, conn := range f.conns {
+		conn.Logger(l)
+	}
+}
+
+// Slave sets the modbus device id for the following operations
+func (f *failover) Slave(deviceID uint8) {
+	for _, conn := range f.conns {
+		conn.Slave(deviceID)
+	}
+}
+
+// Timeout sets the modbus timeout
+func (f *failover) Timeout(timeout time.Duration) time.Duration {
+	var res time.Duration
+	for i, conn := range f.conns {
+		if t := conn.Timeout(timeout); i == 0 {
+			res = t
+		}
+	}
+	return res
+}
+
+// ConnectDelay sets the the initial delay after connecting before starting communication
+func (f *failover) ConnectDelay(delay time.Duration) {
+	for _, conn := range f.conns {
+		conn.ConnectDelay(delay)
+	}
+}
+
+// Close closes the active connection
+func (f *failover) Close() {
+	f.mu.Lock()
+	active := f.active
+	f.mu.Unlock()
+
+	f.conns[active].Close()
+}
+
+// Clone clones the modbus connections, keeping the underlying transports and active endpoint
+func (f *failover) Clone(deviceID byte) meters.Connection {
+	conns := make([]meters.Connection, 0, len(f.conns))
+	for _, conn := range f.conns {
+		conns = append(conns, conn.Clone(deviceID))
+	}
+
+	return &failover{
+		failoverState: f.failoverState,
+		conns:         conns,
+	}
+}
+
+// do executes the request using the active connection and switches to the next uri on repeated failures
+func (f *failover) do(fun func(modbus.Client) ([]byte, error)) ([]byte, error) {
+	f.mu.Lock()
+	active := f.active
+	f.mu.Unlock()
+
+	res, err := fun(f.conns[active].ModbusClient())
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// device responded or another request has already switched
+	var mbErr *modbus.Error
+	if err == nil || errors.As(err, &mbErr) || active != f.active {
+		f.failures = 0
+		return res, err
+	}
+
+	if f.failures++; f.failures >= failoverThreshold {
+		f.conns[active].Close()
+		f.failures = 0
+		f.active = (active + 1) % len(f.uris)
+		f.log.WARN.Printf("%s not responding, switching to %s", f.uris[active], f.uris[f.active])
+	}
+
+	return res, err
+}
+
+// failoverClient executes requests using the active connection
+type failoverClient struct {
+	f *failover
+}
+
+var _ modbus.Client = (*failoverClient)(nil)
+
+func (c *failoverClient) ReadCoils(address, quantity uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadCoils(address, quantity)
+	})
+}
+
+func (c *failoverClient) ReadDiscreteInputs(address, quantity uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadDiscreteInputs(address, quantity)
+	})
+}
+
+func (c *failoverClient) WriteSingleCoil(address, value uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.WriteSingleCoil(address, value)
+	})
+}
+
+func (c *failoverClient) WriteMultipleCoils(address, quantity uint16, value []byte) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.WriteMultipleCoils(address, quantity, value)
+	})
+}
+
+func (c *failoverClient) ReadInputRegisters(address, quantity uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadInputRegisters(address, quantity)
+	})
+}
+
+func (c *failoverClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadHoldingRegisters(address, quantity)
+	})
+}
+
+func (c *failoverClient) WriteSingleRegister(address, value uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.WriteSingleRegister(address, value)
+	})
+}
+
+func (c *failoverClient) WriteMultipleRegisters(address, quantity uint16, value []byte) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.WriteMultipleRegisters(address, quantity, value)
+	})
+}
+
+func (c *failoverClient) ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
+	})
+}
+
+func (c *failoverClient) MaskWriteRegister(address, andMask, orMask uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.MaskWriteRegister(address, andMask, orMask)
+	})
+}
+
+func (c *failoverClient) ReadFIFOQueue(address uint16) ([]byte, error) {
+	return c.f.do(func(mc modbus.Client) ([]byte, error) {
+		return mc.ReadFIFOQueue(address)
+	})
+}
+
+// ActiveEndpoints returns the active uri of registered connections with failover uris, keyed by the configured uris
+func ActiveEndpoints() map[string]string {
+	mu.Lock()
+	conns := slices.Collect(maps.Values(connections))
+	mu.Unlock()
+
+	res := make(map[string]string)
+	for _, conn := range conns {
+		if f, ok := conn.Connection.(*failover); ok {
+			res[strings.Join(f.Endpoints(), ",")] = f.Active()
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,67 @@
+package modbus
+
+import (
+	"io"
+	"testing"
+
+	"github.com/grid-x/modbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+type failoverTestClient struct {
+	modbus.Client
+	err error
+}
+
+func (c *failoverTestClient) ReadHoldingRegisters(address, quantity uint16) ([]byte, error) {
+	return []byte{0, 1}, c.err
+}
+
+type failoverTestConn struct {
+	meters.Connection
+	client *failoverTestClient
+	closed int
+}
+
+func (c *failoverTestConn) ModbusClient() modbus.Client {
+	return c.client
+}
+
+func (c *failoverTestConn) Close() {
+	c.closed++
+}
+
+func TestFailover(t *testing.T) {
+	primary := &failoverTestConn{client: &failoverTestClient{err: io.EOF}}
+	backup := &failoverTestConn{client: &failoverTestClient{}}
+
+	f := newFailover([]string{"lan:502", "wifi:502"}, []meters.Connection{primary, backup})
+
+	// modbus exceptions indicate a responding device
+	primary.client.err = &modbus.Error{ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}
+	for range failoverThreshold {
+		_, err := f.ModbusClient().ReadHoldingRegisters(0, 1)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, "lan:502", f.String())
+
+	primary.client.err = io.EOF
+	for range failoverThreshold {
+		_, err := f.ModbusClient().ReadHoldingRegisters(0, 1)
+		assert.ErrorIs(t, err, io.EOF)
+	}
+	assert.Equal(t, "wifi:502", f.String())
+	assert.Equal(t, 1, primary.closed)
+
+	b, err := f.ModbusClient().ReadHoldingRegisters(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1}, b)
+
+	// switch back to the first uri
+	backup.client.err = io.EOF
+	for range failoverThreshold {
+		_, _ = f.ModbusClient().ReadHoldingRegisters(0, 1)
+	}
+	assert.Equal(t, "lan:502", f.String())
+}
@@ -68,6 +68,13 @@ func decodeBool16(mask uint64) func(b []byte) float64 {
 	}
 }
 
+// decodeBits extracts the masked and shifted bits of an unsigned integer
+func decodeBits(f func(b []byte) uint64, mask uint64, shift uint) func(b []byte) float64 {
+	return func(b []byte) float64 {
+		return float64((f(b) & mask) >> shift)
+	}
+}
+
 func decodeNaN16(f func(b []byte) float64, nan ...uint16) func(b []byte) float64 {
 	return func(b []byte) float64 {
 		u := binary.BigEndian.Uint16(b)
@@ -0,0 +1,60 @@
+package modbus
+
+import (
+	"fmt"
+	"net"
+)
+
+// localIP resolves the local address setting of multi-homed hosts.
+// The setting is either an ip address or the name of an interface whose first address is used, preferring IPv4.
+func localIP(s string) (net.IP, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		return ip, nil
+	}
+
+	iface, err := net.InterfaceByName(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local address: %s", s)
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+
+	var res net.IP
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+
+		if ipnet.IP.To4() != nil {
+			return ipnet.IP, nil
+		}
+
+		if res == nil {
+			res = ipnet.IP
+		}
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("interface %s has no address", s)
+	}
+
+	return res, nil
+}
+
+// localAddr returns the dialer's local address for the network or nil if not bound
+func localAddr(network string, ip net.IP) net.Addr {
+	if ip == nil {
+		return nil
+	}
+
+	switch network {
+	case "udp":
+		return &net.UDPAddr{IP: ip}
+	default:
+		return &net.TCPAddr{IP: ip}
+	}
+}
@@ -1,22 +1,20 @@
 package modbus
 
 import (
-	"sync"
 	"time"
 
 	"github.com/grid-x/modbus"
 	"github.com/volkszaehler/mbmd/meters"
 )
 
+// logger forwards the physical connection's trace output to the logger of the current transaction
 type logger struct {
-	mu     sync.RWMutex
 	logger meters.Logger
 }
 
+// WithLogger executes fun using the given logger.
+// Must always be called while the bus is assigned to the transaction, hence no locking is required.
 func (l *logger) WithLogger(logger modbus.Logger, fun func() ([]byte, error)) ([]byte, error) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
 	if l.logger != logger {
 		// small delay when switching logger/ slave id to mimic mbmd behavior
 		time.Sleep(10 * time.Millisecond)
@@ -27,7 +25,7 @@ func (l *logger) WithLogger(logger modbus.Logger, fun func() ([]byte, error)) ([
 }
 
 // Printf implements modbus.Logger interface.
-// Must always be called while being wrapped in WithLogger, hence the lock is held.
+// Must always be called while being wrapped in WithLogger, hence the bus is held.
 func (l *logger) Printf(format string, v ...interface{}) {
 	if l.logger != nil {
 		l.logger.Printf(format, v...)
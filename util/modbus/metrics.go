@@ -0,0 +1,31 @@
+package modbus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestMetric *prometheus.CounterVec
+	errorMetric   *prometheus.CounterVec
+)
+
+// Modbus metrics are labelled by physical connection and slave id
+func init() {
+	labels := []string{"connection", "id"}
+
+	requestMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "modbus",
+		Name:      "request_total",
+		Help:      "Total count of Modbus requests",
+	}, labels)
+
+	errorMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "modbus",
+		Name:      "error_total",
+		Help:      "Total count of failed Modbus requests",
+	}, labels)
+
+	prometheus.MustRegister(requestMetric, errorMetric)
+}
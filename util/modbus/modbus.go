@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/evcc-io/evcc/util"
 	"github.com/volkszaehler/mbmd/meters"
@@ -18,6 +22,8 @@ const (
 	Rtu
 	Ascii
 	Udp
+	SolarmanV5
+	AA55
 
 	CoilOn uint16 = 0xFF00
 )
@@ -33,19 +39,33 @@ type TcpSettings struct {
 
 // Settings contains the ModBus settings
 type Settings struct {
-	ID                  uint8  `json:",omitempty" yaml:",omitempty"`
-	SubDevice           int    `json:",omitempty" yaml:",omitempty"`
-	URI, Device, Comset string `json:",omitempty" yaml:",omitempty"`
-	Baudrate            int    `json:",omitempty" yaml:",omitempty"`
-	UDP                 bool   `json:",omitempty" yaml:",omitempty"`
-	RTU                 *bool  `json:",omitempty" yaml:",omitempty"`
+	ID                  uint8               `json:",omitempty" yaml:",omitempty"`
+	SubDevice           int                 `json:",omitempty" yaml:",omitempty"`
+	URI, Device, Comset string              `json:",omitempty" yaml:",omitempty"`
+	URIs                []string            `json:",omitempty" yaml:",omitempty"` // failover uris
+	Baudrate            int                 `json:",omitempty" yaml:",omitempty"`
+	UDP                 bool                `json:",omitempty" yaml:",omitempty"`
+	RTU                 *bool               `json:",omitempty" yaml:",omitempty"`
+	Ascii               bool                `json:",omitempty" yaml:",omitempty"`
+	SolarmanV5          *SolarmanV5Settings `json:",omitempty" yaml:",omitempty"` // SolarmanV5 logger protocol
+	AA55                bool                `json:",omitempty" yaml:",omitempty"` // GoodWe udp protocol
+	RateLimit           time.Duration       `json:",omitempty" yaml:",omitempty"` // minimum interval between transactions of all users of the connection
+	LocalAddress        string              `json:",omitempty" yaml:",omitempty"`
+	Priority            int                 `json:",omitempty" yaml:",omitempty"` // bus access priority among devices sharing the connection
+	Profile             string              `json:",omitempty" yaml:",omitempty"` // named connection profile of the device family
 }
 
-// Protocol identifies the wire format from the RTU setting
+// Protocol identifies the wire format from the RTU and Ascii settings
 func (s Settings) Protocol() Protocol {
 	switch {
+	case s.SolarmanV5 != nil || strings.HasPrefix(strings.ToLower(s.URI), solarmanV5Scheme):
+		return SolarmanV5
+	case s.AA55 || strings.HasPrefix(strings.ToLower(s.URI), aa55Scheme):
+		return AA55
 	case s.UDP:
 		return Udp
+	case s.Ascii:
+		return Ascii
 	case s.Device != "" || s.RTU != nil && *s.RTU:
 		return Rtu
 	default:
@@ -57,14 +77,21 @@ func (s *Settings) String() string {
 	if s.URI != "" {
 		return s.URI
 	}
+	if len(s.URIs) > 0 {
+		return s.URIs[0]
+	}
 	return s.Device
 }
 
 type meterConnection struct {
 	meters.Connection
-	proto Protocol
-	refs  int // count of references; first connection has ref count 0
+	proto    Protocol
+	refs     int // count of references; first connection has ref count 0
+	limit    *rateLimit
+	bus      *scheduler
+	coalesce *coalescer // optional read coalescing
 	*logger
+	singleSession bool // device accepts a single session per host
 }
 
 var (
@@ -111,6 +138,8 @@ func registeredConnection(ctx context.Context, key string, proto Protocol, newCo
 	connection := &meterConnection{
 		Connection: newConn,
 		proto:      proto,
+		limit:      new(rateLimit),
+		bus:        newScheduler(),
 		logger:     new(logger),
 	}
 
@@ -120,6 +149,42 @@ func registeredConnection(ctx context.Context, key string, proto Protocol, newCo
 	return connection, nil
 }
 
+// enableCoalescing merges concurrent register reads of all users of the connection
+func (conn *meterConnection) enableCoalescing(window time.Duration) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if conn.coalesce == nil {
+		conn.coalesce = newCoalescer(window)
+	}
+}
+
+// HealthChecker is implemented by physical connections able to verify the device responds
+type HealthChecker interface {
+	Healthy() bool
+}
+
+// Unhealthy returns the sorted addresses of registered connections failing their health check
+func Unhealthy() []string {
+	mu.Lock()
+	conns := slices.Collect(maps.Values(connections))
+	mu.Unlock()
+
+	var res []string
+	for _, conn := range conns {
+		if hc, ok := conn.Connection.(HealthChecker); ok && !hc.Healthy() {
+			res = append(res, conn.String())
+		}
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+// ChargerPriority is the bus access priority of connections created using NewConnection.
+// These are native charger implementations, giving charger reads precedence over meter reads.
+const ChargerPriority = 1
+
 // NewConnection creates physical modbus device from config
 func NewConnection(ctx context.Context, uri, device, comset string, baudrate int, proto Protocol, slaveID uint8) (*Connection, error) {
 	conn, err := physicalConnection(ctx, proto, Settings{
@@ -134,18 +199,130 @@ func NewConnection(ctx context.Context, uri, device, comset string, baudrate int
 
 	res := &Connection{
 		slaveID:    slaveID,
+		priority:   ChargerPriority,
 		Connection: conn.Clone(slaveID),
 		logger:     conn.logger,
+		limit:      conn.limit,
+		bus:        conn.bus,
+		coalesce:   conn.coalesce,
+	}
+
+	return res, nil
+}
+
+// NewConnectionWithSettings creates physical modbus device from settings
+func NewConnectionWithSettings(ctx context.Context, cfg Settings) (*Connection, error) {
+	profile, err := connectionProfileByName(cfg.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := physicalConnection(ctx, cfg.Protocol(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.limit.SetInterval(max(cfg.RateLimit, profile.Gap))
+
+	res := &Connection{
+		slaveID:    cfg.ID,
+		priority:   cfg.Priority,
+		Connection: conn.Clone(cfg.ID),
+		logger:     conn.logger,
+		limit:      conn.limit,
+		bus:        conn.bus,
+		coalesce:   conn.coalesce,
 	}
 
+	// profile defaults, may be overridden by the caller
+	res.ConnectDelay(profile.ConnectDelay)
+	res.Timeout(profile.Timeout)
+
 	return res, nil
 }
 
 func physicalConnection(ctx context.Context, proto Protocol, cfg Settings) (*meterConnection, error) {
-	if (cfg.Device != "") == (cfg.URI != "") {
+	if (cfg.Device != "") == (cfg.URI != "" || len(cfg.URIs) > 0) {
 		return nil, errors.New("invalid modbus configuration: must have either uri or device")
 	}
 
+	var local net.IP
+	if cfg.LocalAddress != "" {
+		if proto != SolarmanV5 && proto != Tcp {
+			return nil, errors.New("invalid modbus configuration: local address requires tcp or solarmanv5")
+		}
+
+		var err error
+		if local, err = localIP(cfg.LocalAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := connectionProfileByName(cfg.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.URIs) > 0 {
+		if profile.SingleSession {
+			return nil, errors.New("invalid modbus configuration: failover uris not supported for single session profile")
+		}
+
+		return failoverConnection(ctx, proto, cfg, local)
+	}
+
+	if proto == SolarmanV5 {
+		if err := cfg.parseSolarmanV5URI(); err != nil {
+			return nil, err
+		}
+
+		if cfg.URI == "" {
+			return nil, errors.New("invalid modbus configuration: solarmanv5 requires uri")
+		}
+
+		sv := cfg.SolarmanV5
+
+		// loggers only support a single session, connections are shared per address
+		uri := util.DefaultPort(cfg.URI, solarmanV5DefaultPort)
+		conn, err := NewSolarmanV5(uri, uint32(sv.LoggerSerial))
+		if err != nil {
+			return nil, err
+		}
+		if err := sv.configure(conn); err != nil {
+			return nil, err
+		}
+		if local != nil {
+			conn.LocalAddress(local)
+		}
+
+		mc, err := registeredConnection(ctx, uri, proto, conn)
+		if err != nil {
+			return nil, err
+		}
+
+		if shared, ok := mc.Connection.(*SolarmanV5Connection); ok && shared != conn {
+			if err := shared.useLoggerSerial(uint32(sv.LoggerSerial)); err != nil {
+				unregisterConnection(uri)
+				return nil, err
+			}
+		}
+
+		if sv.Coalesce > 0 {
+			mc.enableCoalescing(sv.Coalesce)
+		}
+
+		return mc, err
+	}
+
+	if proto == AA55 {
+		if cfg.URI == "" {
+			return nil, errors.New("invalid modbus configuration: aa55 requires uri")
+		}
+
+		uri := aa55Address(cfg.URI)
+		return registeredConnection(ctx, uri, proto, NewAA55(uri))
+	}
+
 	if cfg.Device != "" {
 		switch strings.ToUpper(cfg.Comset) {
 		case "8N1", "8E1", "8N2":
@@ -169,9 +346,40 @@ func physicalConnection(ctx context.Context, proto Protocol, cfg Settings) (*met
 
 	uri := util.DefaultPort(cfg.URI, 502)
 
+	if profile.SingleSession {
+		return singleSessionConnection(ctx, uri, proto, local)
+	}
+
+	return registeredConnection(ctx, uri, proto, networkConnection(proto, uri, local))
+}
+
+// failoverConnection creates a connection switching between the primary and failover uris on repeated failures
+func failoverConnection(ctx context.Context, proto Protocol, cfg Settings, local net.IP) (*meterConnection, error) {
+	if proto == SolarmanV5 || proto == AA55 {
+		return nil, errors.New("invalid modbus configuration: failover uris not supported for solarmanv5 or aa55")
+	}
+
+	var uris []string
+	var conns []meters.Connection
+
+	for _, uri := range append([]string{cfg.URI}, cfg.URIs...) {
+		if uri == "" {
+			continue
+		}
+
+		uri = util.DefaultPort(uri, 502)
+		uris = append(uris, uri)
+		conns = append(conns, networkConnection(proto, uri, local))
+	}
+
+	return registeredConnection(ctx, strings.Join(uris, ","), proto, newFailover(uris, conns))
+}
+
+// networkConnection creates a connection for the given network protocol
+func networkConnection(proto Protocol, uri string, local net.IP) meters.Connection {
 	switch proto {
 	case Udp:
-		return registeredConnection(ctx, uri, proto, meters.NewRTUOverUDP(uri))
+		return meters.NewRTUOverUDP(uri)
 
 	case Rtu:
 		// use retry outside of grid-x/modbus
@@ -179,7 +387,7 @@ func physicalConnection(ctx context.Context, proto Protocol, cfg Settings) (*met
 		conn.Handler.LinkRecoveryTimeout = 0
 		conn.Handler.ProtocolRecoveryTimeout = 0
 
-		return registeredConnection(ctx, uri, proto, conn)
+		return conn
 
 	case Ascii:
 		// use retry outside of grid-x/modbus
@@ -187,14 +395,18 @@ func physicalConnection(ctx context.Context, proto Protocol, cfg Settings) (*met
 		conn.Handler.LinkRecoveryTimeout = 0
 		conn.Handler.ProtocolRecoveryTimeout = 0
 
-		return registeredConnection(ctx, uri, proto, conn)
+		return conn
 
 	default:
+		if local != nil {
+			return newLocalTCP(uri, local)
+		}
+
 		// use retry outside of grid-x/modbus
 		conn := meters.NewTCP(uri)
 		conn.Handler.LinkRecoveryTimeout = 0
 		conn.Handler.ProtocolRecoveryTimeout = 0
 
-		return registeredConnection(ctx, uri, proto, conn)
+		return conn
 	}
 }
@@ -2,6 +2,7 @@ package modbus
 
 import (
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
@@ -31,8 +32,14 @@ func TestSettingsProtocol(t *testing.T) {
 		res Protocol
 	}{
 		{Settings{UDP: true}, Udp},
+		{Settings{URI: "foo", SolarmanV5: new(SolarmanV5Settings)}, SolarmanV5},
+		{Settings{URI: "solarmanv5://foo"}, SolarmanV5},
+		{Settings{URI: "foo", AA55: true}, AA55},
+		{Settings{URI: "aa55://foo"}, AA55},
 		{Settings{RTU: lo.ToPtr(true)}, Rtu},
 		{Settings{Device: "foo"}, Rtu},
+		{Settings{Device: "foo", Ascii: true}, Ascii},
+		{Settings{URI: "foo", Ascii: true}, Ascii},
 		{Settings{URI: "foo"}, Tcp},
 		{Settings{}, Tcp},
 	}
@@ -41,3 +48,37 @@ func TestSettingsProtocol(t *testing.T) {
 		require.Equal(t, tc.res, tc.Protocol(), tc)
 	}
 }
+
+func TestSettingsSolarmanV5URI(t *testing.T) {
+	s := Settings{URI: "solarmanv5://192.168.1.100:8899?serial=2712345678&lenient=true&strictstatus=1&doublecrc=off&transport=udp&coalesce=50ms&keepalive=30s&idletimeout=50s"}
+	require.NoError(t, s.parseSolarmanV5URI())
+	require.Equal(t, Settings{
+		URI: "192.168.1.100:8899",
+		SolarmanV5: &SolarmanV5Settings{
+			LoggerSerial: 2712345678,
+			Lenient:      true,
+			StrictStatus: true,
+			DoubleCRC:    DoubleCRCOff,
+			Transport:    "udp",
+			Coalesce:     50 * time.Millisecond,
+			KeepAlive:    30 * time.Second,
+			IdleTimeout:  50 * time.Second,
+		},
+	}, s)
+
+	// caller's settings are not modified
+	sv := &SolarmanV5Settings{LoggerSerial: 1}
+	s = Settings{URI: "SolarmanV5://192.168.1.100?serial=auto", SolarmanV5: sv}
+	require.NoError(t, s.parseSolarmanV5URI())
+	require.Equal(t, "192.168.1.100", s.URI)
+	require.Equal(t, LoggerSerialAuto, s.SolarmanV5.LoggerSerial)
+	require.Equal(t, LoggerSerial(1), sv.LoggerSerial)
+
+	s = Settings{URI: "solarmanv5://192.168.1.100?serial=foo"}
+	require.Error(t, s.parseSolarmanV5URI())
+
+	// other uris are left untouched
+	s = Settings{URI: "192.168.1.100:502"}
+	require.NoError(t, s.parseSolarmanV5URI())
+	require.Equal(t, Settings{URI: "192.168.1.100:502"}, s)
+}
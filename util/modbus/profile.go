@@ -0,0 +1,75 @@
+package modbus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// connectionProfile bundles the connection quirks of a device family
+type connectionProfile struct {
+	ConnectDelay  time.Duration // delay after connecting before starting communication
+	Gap           time.Duration // minimum interval between transactions
+	Timeout       time.Duration // default response timeout
+	SingleSession bool          // device accepts only a single tcp session per host
+}
+
+var profiles = map[string]connectionProfile{
+	// Huawei SDongle and SmartLogger drop requests sent right after connecting or in quick succession
+	// and reject additional tcp sessions while one is open
+	"huawei": {
+		ConnectDelay:  time.Second,
+		Gap:           100 * time.Millisecond,
+		Timeout:       15 * time.Second,
+		SingleSession: true,
+	},
+}
+
+// connectionProfileByName returns the named connection profile
+func connectionProfileByName(name string) (connectionProfile, error) {
+	if name == "" {
+		return connectionProfile{}, nil
+	}
+
+	p, ok := profiles[strings.ToLower(name)]
+	if !ok {
+		return connectionProfile{}, fmt.Errorf("invalid connection profile: %s", name)
+	}
+
+	return p, nil
+}
+
+// sessionHost returns the host part of the connection key
+func sessionHost(key string) string {
+	if host, _, err := net.SplitHostPort(key); err == nil {
+		return host
+	}
+	return key
+}
+
+// singleSessionConnection registers the connection unless another single session connection to the same host exists
+func singleSessionConnection(ctx context.Context, uri string, proto Protocol, local net.IP) (*meterConnection, error) {
+	host := sessionHost(uri)
+
+	mu.Lock()
+	for key, conn := range connections {
+		if key != uri && conn.singleSession && sessionHost(key) == host {
+			mu.Unlock()
+			return nil, fmt.Errorf("device at %s accepts a single session only, already connected via %s", host, key)
+		}
+	}
+	mu.Unlock()
+
+	conn, err := registeredConnection(ctx, uri, proto, networkConnection(proto, uri, local))
+	if err != nil {
+		return nil, err
+	}
+
+	mu.Lock()
+	conn.singleSession = true
+	mu.Unlock()
+
+	return conn, nil
+}
@@ -0,0 +1,42 @@
+package modbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionProfile(t *testing.T) {
+	p, err := connectionProfileByName("Huawei")
+	require.NoError(t, err)
+	assert.True(t, p.SingleSession)
+
+	_, err = connectionProfileByName("foo")
+	assert.Error(t, err)
+
+	p, err = connectionProfileByName("")
+	require.NoError(t, err)
+	assert.Equal(t, connectionProfile{}, p)
+}
+
+func TestSingleSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := NewConnectionWithSettings(ctx, Settings{URI: "192.0.2.1:502", ID: 1, Profile: "huawei"})
+	require.NoError(t, err)
+	assert.Equal(t, profiles["huawei"].Gap, conn.limit.interval)
+
+	// same session
+	_, err = NewConnectionWithSettings(ctx, Settings{URI: "192.0.2.1", ID: 2, Profile: "huawei"})
+	require.NoError(t, err)
+
+	// second session to the same host
+	_, err = NewConnectionWithSettings(ctx, Settings{URI: "192.0.2.1:6607", ID: 1, Profile: "huawei"})
+	assert.Error(t, err)
+
+	_, err = NewConnectionWithSettings(ctx, Settings{URI: "192.0.2.1:502", URIs: []string{"192.0.2.2:502"}, Profile: "huawei"})
+	assert.Error(t, err)
+}
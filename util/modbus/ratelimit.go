@@ -0,0 +1,38 @@
+package modbus
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimit enforces a minimum interval between the transactions of a physical connection
+// shared by all its users. Devices like Growatt or Sofar inverters fail when polled too fast.
+type rateLimit struct {
+	mu       sync.Mutex
+	interval time.Duration
+	last     time.Time
+}
+
+// SetInterval sets the minimum interval. The longest interval requested by any user applies.
+func (r *rateLimit) SetInterval(interval time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interval = max(r.interval, interval)
+}
+
+// wait blocks until the minimum interval since the previous transaction has passed
+func (r *rateLimit) wait() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if d := r.interval - time.Since(r.last); d > 0 {
+		time.Sleep(d)
+	}
+
+	r.last = time.Now()
+}
@@ -0,0 +1,26 @@
+package modbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	var r rateLimit
+
+	r.SetInterval(50 * time.Millisecond)
+	r.SetInterval(10 * time.Millisecond) // longest interval applies
+	assert.Equal(t, 50*time.Millisecond, r.interval)
+
+	start := time.Now()
+	r.wait()
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+	r.wait()
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// nil rate limit does not block
+	(*rateLimit)(nil).wait()
+}
@@ -14,11 +14,25 @@ import (
 
 // Register contains the ModBus register configuration
 type Register struct {
-	Address  uint16 // Length  uint16
-	Type     string
-	Decode   string // TODO deprecated, use Encoding
-	Encoding string
-	BitMask  string
+	Address   uint16 // Length  uint16
+	Type      string
+	Decode    string // TODO deprecated, use Encoding
+	Encoding  string
+	BitMask   string
+	Shift     uint   // bit position of the masked value
+	Quantity  uint16 // number of registers for string encoding
+	WordOrder string // big (default) or little endian order of 16 bit words
+	ByteOrder string // big (default) or little endian order of bytes within words
+}
+
+// validOrder checks byte or word order settings
+func validOrder(order string) bool {
+	switch strings.ToLower(order) {
+	case "", "big", "little":
+		return true
+	default:
+		return false
+	}
 }
 
 func (r Register) Error() error {
@@ -31,9 +45,54 @@ func (r Register) Error() error {
 	if r.Decode != "" && r.Encoding != "" {
 		return errors.New("must not have decide when encoding is specified")
 	}
+	if !validOrder(r.WordOrder) {
+		return fmt.Errorf("invalid word order: %s", r.WordOrder)
+	}
+	if !validOrder(r.ByteOrder) {
+		return fmt.Errorf("invalid byte order: %s", r.ByteOrder)
+	}
+	if r.WordOrder != "" && r.swapped() {
+		return errors.New("must not have word order when using swapped encoding")
+	}
 	return nil
 }
 
+// swapped checks for encodings with swapped word order
+func (r Register) swapped() bool {
+	enc := strings.ToLower(r.encoding())
+	return enc != "bytes" && strings.HasSuffix(enc, "s")
+}
+
+// Reorder converts between the register's byte and word order and big endian.
+// Conversion is symmetric and applies to both reading and writing.
+func (r Register) Reorder(b []byte) []byte {
+	littleWords := strings.EqualFold(r.WordOrder, "little")
+	littleBytes := strings.EqualFold(r.ByteOrder, "little")
+
+	if !littleWords && !littleBytes || len(b)%2 != 0 {
+		return b
+	}
+
+	res := make([]byte, len(b))
+	words := len(b) / 2
+
+	for i := range words {
+		j := i
+		if littleWords {
+			j = words - 1 - i
+		}
+
+		hi, lo := b[2*i], b[2*i+1]
+		if littleBytes {
+			hi, lo = lo, hi
+		}
+
+		res[2*j], res[2*j+1] = hi, lo
+	}
+
+	return res
+}
+
 func (r Register) encoding() string {
 	if r.Encoding != "" {
 		return r.Encoding
@@ -44,6 +103,11 @@ func (r Register) encoding() string {
 func (r Register) Length() (uint16, error) {
 	enc := r.encoding()
 	switch {
+	case strings.EqualFold(enc, "string"):
+		if r.Quantity == 0 {
+			return 0, errors.New("string encoding requires quantity")
+		}
+		return r.Quantity, nil
 	case strings.Contains(enc, "8") || strings.Contains(enc, "16"):
 		return 1, nil
 	case strings.Contains(enc, "32") || strings.Contains(enc, "754"):
@@ -71,12 +135,26 @@ func (r Register) FuncCode() (uint8, error) {
 		return modbus.FuncCodeWriteMultipleRegisters, nil
 	case "writecoil":
 		return modbus.FuncCodeWriteSingleCoil, nil
+	case "maskwrite":
+		return modbus.FuncCodeMaskWriteRegister, nil
 	default:
 		return 0, fmt.Errorf("invalid register type: %s", r.Type)
 	}
 }
 
+// DecodeFunc returns the decoder for the register's encoding, byte and word order
 func (r Register) DecodeFunc() (func([]byte) float64, error) {
+	f, err := r.decodeFunc()
+	if err != nil || r.WordOrder == "" && r.ByteOrder == "" {
+		return f, err
+	}
+
+	return func(b []byte) float64 {
+		return f(r.Reorder(b))
+	}, nil
+}
+
+func (r Register) decodeFunc() (func([]byte) float64, error) {
 	switch strings.ToLower(r.encoding()) {
 	// 8 bit (coil)
 	case "bool8":
@@ -88,7 +166,7 @@ func (r Register) DecodeFunc() (func([]byte) float64, error) {
 	case "int16nan":
 		return decodeNaN16(asFloat64(encoding.Int16), 1<<15, 1<<15-1), nil
 	case "uint16":
-		return asFloat64(encoding.Uint16), nil
+		return r.decodeUint(asUint64(encoding.Uint16))
 	case "uint16nan":
 		return decodeNaN16(asFloat64(encoding.Uint16), 1<<16-1), nil
 	case "bool16":
@@ -106,9 +184,9 @@ func (r Register) DecodeFunc() (func([]byte) float64, error) {
 	case "int32s":
 		return asFloat64(encoding.Int32LswFirst), nil
 	case "uint32":
-		return asFloat64(encoding.Uint32), nil
+		return r.decodeUint(asUint64(encoding.Uint32))
 	case "uint32s":
-		return asFloat64(encoding.Uint32LswFirst), nil
+		return r.decodeUint(asUint64(encoding.Uint32LswFirst))
 	case "uint32nan":
 		return decodeNaN32(asFloat64(encoding.Uint32), 1<<32-1), nil
 	case "float32", "ieee754":
@@ -122,17 +200,38 @@ func (r Register) DecodeFunc() (func([]byte) float64, error) {
 	case "int64":
 		return asFloat64(encoding.Int64), nil
 	case "uint64":
-		return asFloat64(encoding.Uint64), nil
+		return r.decodeUint(encoding.Uint64)
 	case "uint64nan":
 		return decodeNaN64(asFloat64(encoding.Uint64), 1<<64-1), nil
 	case "float64":
 		return encoding.Float64, nil
 
+	// text
+	case "string":
+		return nil, errors.New("string encoding is not numeric")
+
 	default:
 		return nil, fmt.Errorf("invalid register decoding: %s", r.encoding())
 	}
 }
 
+// decodeUint decodes unsigned integers applying the optional bit mask and shift
+func (r Register) decodeUint(f func([]byte) uint64) (func([]byte) float64, error) {
+	if r.BitMask == "" && r.Shift == 0 {
+		return asFloat64(f), nil
+	}
+
+	mask := uint64(math.MaxUint64)
+	if r.BitMask != "" {
+		var err error
+		if mask, err = decodeMask(r.BitMask); err != nil {
+			return nil, err
+		}
+	}
+
+	return decodeBits(f, mask, r.Shift), nil
+}
+
 func (r Register) encodeToBytes(fun func(float64) uint64) (func(float64) ([]byte, error), error) {
 	length, err := r.Length()
 	if err != nil {
@@ -171,7 +270,23 @@ func (r Register) encodeToBytes(fun func(float64) uint64) (func(float64) ([]byte
 	}, nil
 }
 
+// EncodeFunc returns the encoder for the register's encoding, byte and word order
 func (r Register) EncodeFunc() (func(float64) ([]byte, error), error) {
+	f, err := r.encodeFunc()
+	if err != nil || r.WordOrder == "" && r.ByteOrder == "" {
+		return f, err
+	}
+
+	return func(v float64) ([]byte, error) {
+		b, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		return r.Reorder(b), nil
+	}, nil
+}
+
+func (r Register) encodeFunc() (func(float64) ([]byte, error), error) {
 	enc := strings.ToLower(r.encoding())
 
 	switch {
@@ -180,12 +295,12 @@ func (r Register) EncodeFunc() (func(float64) ([]byte, error), error) {
 
 	case strings.HasPrefix(enc, "int"):
 		return r.encodeToBytes(func(v float64) uint64 {
-			return uint64(int64(v))
+			return uint64(int64(math.Round(v)))
 		})
 
 	case strings.HasPrefix(enc, "uint"):
 		return r.encodeToBytes(func(v float64) uint64 {
-			return uint64(v)
+			return uint64(math.Round(v))
 		})
 
 	case strings.HasPrefix(enc, "float") || strings.HasPrefix(enc, "ieee754"):
@@ -214,6 +329,26 @@ func (r Register) EncodeFunc() (func(float64) ([]byte, error), error) {
 	}
 }
 
+// MaskValues returns the and/or masks for writing the shifted value to the bits of the bit mask.
+// The result is (current AND and) OR (or AND NOT and) as defined for mask write register (FC22).
+// Boolean encodings set all bits of the mask.
+func (r Register) MaskValues(val uint16) (uint16, uint16, error) {
+	mask, err := decodeMask(r.BitMask)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if mask > math.MaxUint16 {
+		return 0, 0, fmt.Errorf("invalid mask: %s", r.BitMask)
+	}
+
+	if strings.HasPrefix(strings.ToLower(r.encoding()), "bool") && val != 0 {
+		return ^uint16(mask), uint16(mask), nil
+	}
+
+	return ^uint16(mask), (val << r.Shift) & uint16(mask), nil
+}
+
 type RegisterOperation struct {
 	FuncCode uint8
 	Addr     uint16
@@ -251,3 +386,10 @@ func asFloat64[T constraints.Signed | constraints.Unsigned | constraints.Float](
 		return res
 	}
 }
+
+// asUint64 creates a function that returns unsigned values as uint64
+func asUint64[T constraints.Unsigned](f func([]byte) T) func([]byte) uint64 {
+	return func(v []byte) uint64 {
+		return uint64(f(v))
+	}
+}
@@ -17,6 +17,7 @@ func TestLength(t *testing.T) {
 		{"int16", 1},
 		{"float32", 2},
 		{"uint64s", 4},
+		{"bytes", 0},
 	}
 
 	for _, tc := range tc {
@@ -75,6 +76,9 @@ func TestDecoding(t *testing.T) {
 		{Register{Decode: "float32s"}, []byte{0x61, 0x4e, 0x4b, 0x3c}, 12345678},
 		{Register{Decode: "float32s"}, []byte{0xff, 0x7f, 0xff, 0xff}, 0},    // NaN swapped
 		{Register{Decode: "float32nans"}, []byte{0xff, 0xff, 0xff, 0x7f}, 0}, // NaN
+		{Register{Encoding: "uint16", BitMask: "0x0f00", Shift: 8}, []byte{0x12, 0x34}, 2},
+		{Register{Encoding: "uint16", Shift: 4}, []byte{0x12, 0x34}, 0x123},
+		{Register{Encoding: "uint32s", BitMask: "0x8000"}, []byte{0x80, 0x00, 0x00, 0x01}, 0x8000},
 	}
 
 	for _, tc := range tc {
@@ -83,3 +87,76 @@ func TestDecoding(t *testing.T) {
 		require.Equal(t, tc.out, fun(tc.in), tc)
 	}
 }
+
+func TestStringLength(t *testing.T) {
+	_, err := Register{Encoding: "string"}.Length()
+	require.Error(t, err)
+
+	res, err := Register{Encoding: "string", Quantity: 8}.Length()
+	require.NoError(t, err)
+	require.Equal(t, uint16(8), res)
+}
+
+func TestMaskValues(t *testing.T) {
+	const cur = 0x1234
+
+	tc := []struct {
+		r       Register
+		in      uint16
+		and, or uint16
+		out     uint16
+	}{
+		{Register{Encoding: "uint16", BitMask: "0x00f0", Shift: 4}, 0x5, 0xff0f, 0x0050, 0x1254},
+		{Register{Encoding: "uint16", BitMask: "0x00f0", Shift: 4}, 0x15, 0xff0f, 0x0050, 0x1254}, // overflow
+		{Register{Encoding: "bool16", BitMask: "0x0008"}, 1, 0xfff7, 0x0008, 0x123c},
+		{Register{Encoding: "bool16", BitMask: "0x0004"}, 0, 0xfffb, 0, 0x1230},
+	}
+
+	for _, tc := range tc {
+		and, or, err := tc.r.MaskValues(tc.in)
+		require.NoError(t, err, tc)
+		require.Equal(t, tc.and, and, tc)
+		require.Equal(t, tc.or, or, tc)
+
+		// mask write register semantics
+		require.Equal(t, tc.out, cur&and|or&^and, tc)
+	}
+
+	_, _, err := Register{Encoding: "uint32", BitMask: "0x10000"}.MaskValues(1)
+	require.Error(t, err)
+}
+
+func TestByteWordOrder(t *testing.T) {
+	tc := []struct {
+		r   Register
+		in  float64
+		out []byte
+	}{
+		{Register{Encoding: "uint32"}, 0x12345678, []byte{0x12, 0x34, 0x56, 0x78}},                                           // ABCD
+		{Register{Encoding: "uint32", WordOrder: "little"}, 0x12345678, []byte{0x56, 0x78, 0x12, 0x34}},                      // CDAB
+		{Register{Encoding: "uint32", ByteOrder: "little"}, 0x12345678, []byte{0x34, 0x12, 0x78, 0x56}},                      // BADC
+		{Register{Encoding: "uint32", WordOrder: "little", ByteOrder: "little"}, 0x12345678, []byte{0x78, 0x56, 0x34, 0x12}}, // DCBA
+		{Register{Encoding: "int16", ByteOrder: "little"}, -2, []byte{0xfe, 0xff}},
+		{Register{Encoding: "float32", WordOrder: "little"}, 12345678, []byte{0x61, 0x4e, 0x4b, 0x3c}},
+		{Register{Encoding: "uint64", WordOrder: "little"}, 0x0102030405060000, []byte{0x00, 0x00, 0x05, 0x06, 0x03, 0x04, 0x01, 0x02}},
+	}
+
+	for _, tc := range tc {
+		tc.r.Type = "holding"
+		require.NoError(t, tc.r.Error(), tc)
+
+		encode, err := tc.r.EncodeFunc()
+		require.NoError(t, err, tc)
+		b, err := encode(tc.in)
+		require.NoError(t, err, tc)
+		require.Equal(t, tc.out, b, tc)
+
+		decode, err := tc.r.DecodeFunc()
+		require.NoError(t, err, tc)
+		require.Equal(t, tc.in, decode(b), tc)
+	}
+
+	require.Error(t, Register{Type: "holding", Encoding: "uint32", WordOrder: "middle"}.Error())
+	require.Error(t, Register{Type: "holding", Encoding: "uint32s", WordOrder: "little"}.Error())
+	require.NoError(t, Register{Type: "holding", Encoding: "bytes", WordOrder: "little"}.Error())
+}
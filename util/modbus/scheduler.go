@@ -0,0 +1,112 @@
+package modbus
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// scheduler serializes the transactions of a physical connection. Waiting writes are served before reads,
+// then devices with higher priority. Within the same priority, the device served least recently goes first
+// so that a device issuing many requests cannot starve other devices on the bus.
+type scheduler struct {
+	mu      sync.Mutex
+	busy    bool
+	seq     uint64
+	turn    uint64
+	waiting []*busRequest
+	served  map[uint8]uint64 // turn of the last transaction per slave id
+	queued  chan struct{}    // optional notification of waiting transactions
+}
+
+// busRequest is a transaction waiting for the bus
+type busRequest struct {
+	slaveID  uint8
+	write    bool
+	priority int
+	seq      uint64
+	ready    chan struct{}
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{
+		served: make(map[uint8]uint64),
+	}
+}
+
+// compare orders requests by precedence
+func (s *scheduler) compare(a, b *busRequest) int {
+	if a.write != b.write {
+		if a.write {
+			return -1
+		}
+		return 1
+	}
+
+	return cmp.Or(
+		cmp.Compare(b.priority, a.priority),
+		cmp.Compare(s.served[a.slaveID], s.served[b.slaveID]),
+		cmp.Compare(a.seq, b.seq),
+	)
+}
+
+// acquire blocks until the bus is assigned to the transaction
+func (s *scheduler) acquire(slaveID uint8, priority int, write bool) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+
+	s.seq++
+	seq := s.seq
+
+	if !s.busy {
+		s.busy = true
+		s.turn++
+		s.served[slaveID] = s.turn
+		s.mu.Unlock()
+		return
+	}
+
+	req := &busRequest{
+		slaveID:  slaveID,
+		write:    write,
+		priority: priority,
+		seq:      seq,
+		ready:    make(chan struct{}),
+	}
+
+	s.waiting = append(s.waiting, req)
+	s.mu.Unlock()
+
+	if s.queued != nil {
+		s.queued <- struct{}{}
+	}
+
+	<-req.ready
+}
+
+// release assigns the bus to the next waiting transaction
+func (s *scheduler) release() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.waiting) == 0 {
+		s.busy = false
+		return
+	}
+
+	next := slices.MinFunc(s.waiting, s.compare)
+	s.waiting = slices.DeleteFunc(s.waiting, func(r *busRequest) bool {
+		return r == next
+	})
+
+	s.turn++
+	s.served[next.slaveID] = s.turn
+	close(next.ready)
+}
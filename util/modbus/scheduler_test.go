@@ -0,0 +1,61 @@
+package modbus
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	s := newScheduler()
+	s.queued = make(chan struct{})
+
+	// meter 2 currently reading
+	s.acquire(2, 0, false)
+
+	var (
+		mu    sync.Mutex
+		order []string
+		wg    sync.WaitGroup
+	)
+
+	enqueue := func(name string, slaveID uint8, priority int, write bool) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.acquire(slaveID, priority, write)
+
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+
+			s.release()
+		}()
+
+		// wait until queued to make arrival order deterministic
+		<-s.queued
+	}
+
+	enqueue("meter read 1", 2, 0, false)
+	enqueue("meter read 2", 2, 0, false)
+	enqueue("pv read", 3, 0, false)
+	enqueue("charger read", 1, 1, false)
+	enqueue("charger write", 1, 0, true)
+
+	s.release()
+	wg.Wait()
+
+	assert.Equal(t, []string{
+		"charger write", // writes first
+		"charger read",  // higher priority
+		"pv read",       // not served recently
+		"meter read 1",
+		"meter read 2",
+	}, order)
+
+	// bus is free
+	s.acquire(1, 0, false)
+	s.release()
+	assert.False(t, s.busy)
+}
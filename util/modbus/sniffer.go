@@ -0,0 +1,235 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// rtuMaxSize is the maximum Modbus RTU frame size
+const rtuMaxSize = 256
+
+// Frame is a Modbus RTU frame observed on the bus
+type Frame struct {
+	Time     time.Time
+	Slave    uint8
+	Func     uint8
+	Data     []byte // pdu data without function code
+	Response bool
+}
+
+// Exception returns true if the frame is an exception response
+func (f Frame) Exception() bool {
+	return f.Response && f.Func&0x80 != 0
+}
+
+func (f Frame) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "slave=%d func=%02x", f.Slave, f.Func)
+
+	if f.Exception() {
+		fmt.Fprintf(&b, " exception=%02x", f.Data[0])
+		return b.String()
+	}
+
+	addr := func() {
+		fmt.Fprintf(&b, " addr=%d (0x%04x)", binary.BigEndian.Uint16(f.Data), binary.BigEndian.Uint16(f.Data))
+	}
+
+	switch fn := f.Func; {
+	case !f.Response && fn >= modbus.FuncCodeReadCoils && fn <= modbus.FuncCodeReadInputRegisters,
+		f.Response && (fn == modbus.FuncCodeWriteMultipleCoils || fn == modbus.FuncCodeWriteMultipleRegisters):
+		addr()
+		fmt.Fprintf(&b, " qty=%d", binary.BigEndian.Uint16(f.Data[2:]))
+	case fn == modbus.FuncCodeWriteSingleCoil, fn == modbus.FuncCodeWriteSingleRegister:
+		addr()
+		fmt.Fprintf(&b, " value=0x%04x", binary.BigEndian.Uint16(f.Data[2:]))
+	case fn == modbus.FuncCodeWriteMultipleCoils, fn == modbus.FuncCodeWriteMultipleRegisters:
+		addr()
+		fmt.Fprintf(&b, " qty=%d data=% x", binary.BigEndian.Uint16(f.Data[2:]), f.Data[5:])
+	default:
+		fmt.Fprintf(&b, " data=% x", f.Data)
+	}
+
+	return b.String()
+}
+
+// Transaction is a request and its response. Either may be missing if not observed.
+type Transaction struct {
+	Request, Response *Frame
+}
+
+// RegisterValue is a register value decoded from a transaction
+type RegisterValue struct {
+	Address, Value uint16
+}
+
+// Registers returns the register values read or written by the transaction
+func (t Transaction) Registers() []RegisterValue {
+	if t.Request == nil {
+		return nil
+	}
+
+	req := t.Request.Data
+	addr := binary.BigEndian.Uint16(req)
+
+	var values []byte
+
+	switch t.Request.Func {
+	case modbus.FuncCodeReadHoldingRegisters, modbus.FuncCodeReadInputRegisters:
+		if t.Response == nil || t.Response.Exception() {
+			return nil
+		}
+		values = t.Response.Data[1:]
+	case modbus.FuncCodeWriteSingleRegister:
+		values = req[2:4]
+	case modbus.FuncCodeWriteMultipleRegisters:
+		values = req[5:]
+	default:
+		return nil
+	}
+
+	res := make([]RegisterValue, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		res = append(res, RegisterValue{
+			Address: addr + uint16(i/2),
+			Value:   binary.BigEndian.Uint16(values[i:]),
+		})
+	}
+
+	return res
+}
+
+// Sniffer passively decodes Modbus RTU transactions from the raw bus traffic.
+// Frames are delimited using their function code specific length and CRC since
+// inter-frame silence can't reliably be detected through the OS serial drivers.
+type Sniffer struct {
+	buf     []byte
+	pending *Frame
+}
+
+// Decode adds the received bytes and returns the completed transactions
+func (s *Sniffer) Decode(b []byte, ts time.Time) []Transaction {
+	s.buf = append(s.buf, b...)
+
+	var res []Transaction
+
+	for len(s.buf) >= rtuMinSize {
+		f, n := s.frame(ts)
+		if n == 0 {
+			break
+		}
+
+		s.buf = s.buf[n:]
+		if f == nil {
+			continue
+		}
+
+		if f.Response {
+			if s.pending != nil && s.pending.Slave == f.Slave && s.pending.Func == f.Func&0x7f {
+				res = append(res, Transaction{Request: s.pending, Response: f})
+			} else {
+				res = append(res, Transaction{Response: f})
+			}
+			s.pending = nil
+			continue
+		}
+
+		// request without response
+		if s.pending != nil {
+			res = append(res, Transaction{Request: s.pending})
+		}
+		s.pending = f
+	}
+
+	return res
+}
+
+// Flush returns the pending request and discards incomplete data, e.g. after bus silence
+func (s *Sniffer) Flush() []Transaction {
+	s.buf = s.buf[:0]
+
+	if s.pending == nil {
+		return nil
+	}
+
+	res := []Transaction{{Request: s.pending}}
+	s.pending = nil
+
+	return res
+}
+
+type frameCandidate struct {
+	size     int
+	response bool
+}
+
+// candidates returns the possible frame sizes for the buffer's function code, expected direction first
+func (s *Sniffer) candidates() []frameCandidate {
+	buf := s.buf
+	fn := buf[1]
+
+	var res []frameCandidate
+
+	switch {
+	case fn&0x80 != 0:
+		return []frameCandidate{{5, true}}
+	case fn >= modbus.FuncCodeReadCoils && fn <= modbus.FuncCodeReadInputRegisters:
+		res = []frameCandidate{{8, false}, {5 + int(buf[2]), true}}
+	case fn == modbus.FuncCodeWriteSingleCoil, fn == modbus.FuncCodeWriteSingleRegister:
+		res = []frameCandidate{{8, false}, {8, true}}
+	case fn == modbus.FuncCodeWriteMultipleCoils, fn == modbus.FuncCodeWriteMultipleRegisters:
+		res = []frameCandidate{{8, true}}
+		if len(buf) > 6 {
+			res = append([]frameCandidate{{9 + int(buf[6]), false}}, res...)
+		}
+	default:
+		return nil
+	}
+
+	if s.pending != nil && s.pending.Slave == buf[0] && s.pending.Func == fn {
+		slices.Reverse(res)
+	}
+
+	return res
+}
+
+// frame decodes the frame at the start of the buffer. It returns the number of bytes consumed,
+// skipping a byte if no valid frame is found, or zero if more data is required.
+func (s *Sniffer) frame(ts time.Time) (*Frame, int) {
+	var incomplete bool
+
+	for _, c := range s.candidates() {
+		if c.size > rtuMaxSize {
+			continue
+		}
+
+		if len(s.buf) < c.size {
+			incomplete = true
+			continue
+		}
+
+		adu := s.buf[:c.size]
+		if binary.LittleEndian.Uint16(adu[c.size-2:]) != crc16(adu[:c.size-2]) {
+			continue
+		}
+
+		return &Frame{
+			Time:     ts,
+			Slave:    adu[0],
+			Func:     adu[1],
+			Data:     slices.Clone(adu[2 : c.size-2]),
+			Response: c.response,
+		}, c.size
+	}
+
+	if incomplete {
+		return nil, 0
+	}
+
+	return nil, 1
+}
@@ -0,0 +1,61 @@
+package modbus
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnifferDecode(t *testing.T) {
+	req := rtuTestFrame(1, 3, 0x00, 0x10, 0x00, 0x02)
+	res := rtuTestFrame(1, 3, 4, 0x12, 0x34, 0xff, 0xfe)
+	write := rtuTestFrame(2, 16, 0x01, 0x00, 0x00, 0x01, 2, 0x00, 0x10)
+	writeRes := rtuTestFrame(2, 16, 0x01, 0x00, 0x00, 0x01)
+
+	// leading garbage and split reads
+	stream := slices.Concat([]byte{0x00, 0xaa}, req, res, write, writeRes)
+
+	var s Sniffer
+	var txs []Transaction
+	for b := range slices.Chunk(stream, 3) {
+		txs = append(txs, s.Decode(b, time.Now())...)
+	}
+
+	require.Len(t, txs, 2)
+
+	require.NotNil(t, txs[0].Request)
+	require.NotNil(t, txs[0].Response)
+	assert.Equal(t, "slave=1 func=03 addr=16 (0x0010) qty=2", txs[0].Request.String())
+	assert.Equal(t, []RegisterValue{{0x10, 0x1234}, {0x11, 0xfffe}}, txs[0].Registers())
+
+	require.NotNil(t, txs[1].Request)
+	require.NotNil(t, txs[1].Response)
+	assert.Equal(t, "slave=2 func=10 addr=256 (0x0100) qty=1 data=00 10", txs[1].Request.String())
+	assert.Equal(t, []RegisterValue{{0x100, 0x10}}, txs[1].Registers())
+}
+
+func TestSnifferUnanswered(t *testing.T) {
+	req := rtuTestFrame(1, 4, 0x00, 0x10, 0x00, 0x01)
+	exc := rtuTestFrame(1, 0x84, 0x02)
+
+	var s Sniffer
+	assert.Empty(t, s.Decode(req, time.Now()))
+
+	// repeated request without response
+	txs := s.Decode(req, time.Now())
+	require.Len(t, txs, 1)
+	assert.Nil(t, txs[0].Response)
+
+	txs = s.Decode(exc, time.Now())
+	require.Len(t, txs, 1)
+	assert.True(t, txs[0].Response.Exception())
+	assert.Empty(t, txs[0].Registers())
+
+	// pending request is returned on flush
+	assert.Empty(t, s.Decode(req, time.Now()))
+	assert.Len(t, s.Flush(), 1)
+	assert.Empty(t, s.Flush())
+}
@@ -0,0 +1,843 @@
+package modbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+// SolarmanV5 frame layout, see https://pysolarmanv5.readthedocs.io/en/latest/solarmanv5_protocol.html
+const (
+	solarmanV5Start = 0xA5
+	solarmanV5End   = 0x15
+
+	solarmanV5HeaderSize  = 11 // start, length, control code, serial, logger serial
+	solarmanV5TrailerSize = 2  // checksum, end
+
+	solarmanV5RequestPayloadSize  = 15 // frame type, sensor type, total working time, power on time, offset time
+	solarmanV5ResponsePayloadSize = 14 // frame type, status, total working time, power on time, offset time
+
+	solarmanV5MaxPayload = 1024
+
+	solarmanV5ControlRequest  = 0x4510
+	solarmanV5ControlResponse = 0x1510
+
+	solarmanV5StatusOK = 0x01
+
+	solarmanV5Scheme         = "solarmanv5://"
+	solarmanV5DefaultPort    = 8899
+	solarmanV5DefaultTimeout = 10 * time.Second
+
+	solarmanV5BusyRetries = 3                      // write retries while the device is busy
+	solarmanV5BusyDelay   = 500 * time.Millisecond // increasing delay between busy retries
+
+	solarmanV5Retransmit = time.Second // udp retransmission interval of unanswered requests
+
+	rtuMinSize = 4
+)
+
+// SolarmanV5Connection implements meters.Connection for Modbus RTU encapsulated in SolarmanV5 frames
+// as used by Solarman/IGEN Wi-Fi data logging sticks (Deye, Sofar, Afore etc.)
+type SolarmanV5Connection struct {
+	Client  modbus.Client
+	handler *solarmanV5Handler
+}
+
+var _ meters.Connection = (*SolarmanV5Connection)(nil)
+
+// NewSolarmanV5 creates a SolarmanV5 modbus client.
+// Loggers only support a single tcp session, the physical connection is shared per address by the connection registry.
+// Devices daisy-chained on the logger's RS485 bus are addressed by slave id over the same session.
+func NewSolarmanV5(address string, loggerSerial uint32) (*SolarmanV5Connection, error) {
+	if loggerSerial == 0 {
+		return nil, errors.New("solarmanv5: logger serial required, use auto for detection")
+	}
+
+	transport := &solarmanV5Transport{
+		log:          util.NewLogger("solarmanv5"),
+		address:      address,
+		loggerSerial: loggerSerial,
+		timeout:      solarmanV5DefaultTimeout,
+		busyDelay:    solarmanV5BusyDelay,
+		slaveBackoff: solarmanV5SlaveBackoff,
+		retransmit:   solarmanV5Retransmit,
+	}
+	transport.redactSerial()
+
+	handler := &solarmanV5Handler{
+		transport: transport,
+	}
+
+	return &SolarmanV5Connection{
+		Client:  modbus.NewClient(handler),
+		handler: handler,
+	}, nil
+}
+
+// useLoggerSerial verifies the logger serial of another device sharing the connection.
+// A detected serial is replaced by an explicitly configured one.
+func (b *SolarmanV5Connection) useLoggerSerial(loggerSerial uint32) error {
+	if loggerSerial == uint32(LoggerSerialAuto) {
+		return nil
+	}
+
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch t.loggerSerial {
+	case loggerSerial:
+	case uint32(LoggerSerialAuto):
+		t.loggerSerial = loggerSerial
+		t.redactSerial()
+	default:
+		return fmt.Errorf("solarmanv5: logger serial %d does not match serial %d already used for %s", loggerSerial, t.loggerSerial, t.address)
+	}
+
+	return nil
+}
+
+// Lenient disables verification of response control code and logger serial for non-conforming logger clones
+func (b *SolarmanV5Connection) Lenient() {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.lenient {
+		t.log.WARN.Printf("%s: response control code and logger serial validation disabled", t.address)
+	}
+
+	t.lenient = true
+}
+
+// StrictStatus rejects responses with a status other than 0x01.
+// Some firmwares send valid responses with status 0x02.
+func (b *SolarmanV5Connection) StrictStatus() {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.strictStatus = true
+}
+
+// DoubleCRC sets the handling of duplicate CRCs appended to the RTU response by some logger firmwares
+func (b *SolarmanV5Connection) DoubleCRC(mode DoubleCRC) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.doubleCRC = mode
+}
+
+// Cache enables caching of register read responses for the given duration
+func (b *SolarmanV5Connection) Cache(ttl time.Duration) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cache == nil {
+		t.cache = newSolarmanV5Cache(ttl, t.Send)
+	}
+}
+
+// KeepAlive sets the TCP keepalive interval of the logger session
+func (b *SolarmanV5Connection) KeepAlive(interval time.Duration) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.keepAlive = interval
+}
+
+// IdleTimeout sets the idle duration after which the logger session is closed and reopened before the next request.
+// Some loggers silently drop sessions without traffic, reconnecting in time avoids failing requests.
+func (b *SolarmanV5Connection) IdleTimeout(timeout time.Duration) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.idleTimeout = timeout
+}
+
+// UDP sends requests as udp datagrams instead of using a tcp session.
+// Some logger firmwares only answer on udp. Unanswered requests are retransmitted until the response timeout.
+func (b *SolarmanV5Connection) UDP() {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.udp = true
+}
+
+// LocalAddress binds the logger connection to the given local ip address
+func (b *SolarmanV5Connection) LocalAddress(ip net.IP) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.localIP = ip
+}
+
+// String returns the bus connection address
+func (b *SolarmanV5Connection) String() string {
+	return b.handler.transport.address
+}
+
+// ModbusClient returns the modbus client
+func (b *SolarmanV5Connection) ModbusClient() modbus.Client {
+	return b.Client
+}
+
+// Logger sets a logging instance for physical bus operations
+func (b *SolarmanV5Connection) Logger(l meters.Logger) {
+	b.handler.transport.setLogger(l)
+}
+
+// Slave sets the modbus device id for the following operations
+func (b *SolarmanV5Connection) Slave(deviceID uint8) {
+	b.handler.SetSlave(deviceID)
+}
+
+// Timeout sets the modbus timeout of the connection's slave.
+// Devices behind the same logger may respond at different speeds.
+func (b *SolarmanV5Connection) Timeout(timeout time.Duration) time.Duration {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timeouts == nil {
+		t.timeouts = make(map[byte]time.Duration)
+	}
+
+	slave := b.handler.slaveID
+	res := t.slaveTimeout(slave)
+	t.timeouts[slave] = timeout
+	return res
+}
+
+// ConnectDelay sets the the initial delay after connecting before starting communication
+func (b *SolarmanV5Connection) ConnectDelay(delay time.Duration) {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.connectDelay = delay
+}
+
+// Close closes the modbus connection.
+// This forces the modbus client to reopen the connection before the next bus operations.
+// The shared logger session is kept if only the connection's slave is failing.
+func (b *SolarmanV5Connection) Close() {
+	t := b.handler.transport
+	t.mu.Lock()
+	isolated := t.isolatedFailure(b.handler.slaveID)
+	t.mu.Unlock()
+
+	if !isolated {
+		_ = b.handler.Close()
+	}
+}
+
+// Clone clones the modbus connection for the given slave id, keeping the underlying transport.
+// Requests of all slave ids are routed over the logger's single session.
+func (b *SolarmanV5Connection) Clone(deviceID byte) meters.Connection {
+	handler := &solarmanV5Handler{
+		transport: b.handler.transport,
+	}
+	handler.SetSlave(deviceID)
+
+	return &SolarmanV5Connection{
+		Client:  modbus.NewClient(handler),
+		handler: handler,
+	}
+}
+
+// solarmanV5Handler implements the grid-x modbus ClientHandler.
+// Packaging is Modbus RTU, transport wraps the RTU frames into SolarmanV5 frames.
+type solarmanV5Handler struct {
+	slaveID   byte
+	transport *solarmanV5Transport
+}
+
+var _ modbus.ClientHandler = (*solarmanV5Handler)(nil)
+
+func (h *solarmanV5Handler) SetSlave(slaveID byte) {
+	h.slaveID = slaveID
+}
+
+// Encode encodes the PDU as Modbus RTU frame
+func (h *solarmanV5Handler) Encode(pdu *modbus.ProtocolDataUnit) ([]byte, error) {
+	adu := make([]byte, 0, len(pdu.Data)+4)
+	adu = append(adu, h.slaveID, pdu.FunctionCode)
+	adu = append(adu, pdu.Data...)
+	return binary.LittleEndian.AppendUint16(adu, crc16(adu)), nil
+}
+
+// Verify verifies the Modbus RTU response matches the request
+func (h *solarmanV5Handler) Verify(aduRequest, aduResponse []byte) error {
+	if len(aduResponse) < rtuMinSize {
+		return fmt.Errorf("modbus: response length '%d' does not meet minimum '%d'", len(aduResponse), rtuMinSize)
+	}
+	if aduResponse[0] != aduRequest[0] {
+		return fmt.Errorf("modbus: response slave id '%v' does not match request '%v'", aduResponse[0], aduRequest[0])
+	}
+	return nil
+}
+
+// Decode extracts the PDU from the Modbus RTU frame
+func (h *solarmanV5Handler) Decode(adu []byte) (*modbus.ProtocolDataUnit, error) {
+	n := len(adu)
+	if crc, expected := binary.LittleEndian.Uint16(adu[n-2:]), crc16(adu[:n-2]); crc != expected {
+		solarmanV5ChecksumMetric.WithLabelValues(h.transport.address).Inc()
+		return nil, fmt.Errorf("modbus: response crc '%04x' does not match expected '%04x'", crc, expected)
+	}
+
+	return &modbus.ProtocolDataUnit{
+		FunctionCode: adu[1],
+		Data:         adu[2 : n-2],
+	}, nil
+}
+
+// Send sends the request. Writes rejected as busy are retried, e.g. Deye inverters
+// reject work mode or export limit writes during internal updates.
+func (h *solarmanV5Handler) Send(aduRequest []byte) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := h.send(aduRequest)
+		if err != nil || attempt > solarmanV5BusyRetries || !isBusyWriteResponse(aduRequest, res) {
+			return res, err
+		}
+
+		h.transport.log.DEBUG.Printf("%s: device busy, retrying write (%d/%d)", h.transport.address, attempt, solarmanV5BusyRetries)
+		time.Sleep(time.Duration(attempt) * h.transport.busyDelay)
+	}
+}
+
+func (h *solarmanV5Handler) send(aduRequest []byte) ([]byte, error) {
+	if c := h.transport.getCache(); c != nil {
+		return c.Send(aduRequest)
+	}
+	return h.transport.Send(aduRequest)
+}
+
+// isBusyWriteResponse checks if the response is a busy exception to a write request
+func isBusyWriteResponse(aduRequest, aduResponse []byte) bool {
+	return len(aduRequest) >= 2 && len(aduResponse) >= 3 && isWriteFunction(aduRequest[1]) &&
+		aduResponse[1] == aduRequest[1]|0x80 && Exception(aduResponse[2]) == ExceptionServerDeviceBusy
+}
+
+func (h *solarmanV5Handler) Connect() error {
+	return h.transport.Connect()
+}
+
+func (h *solarmanV5Handler) Close() error {
+	return h.transport.Close()
+}
+
+// solarmanV5Transport is the physical logger connection shared by all handlers
+type solarmanV5Transport struct {
+	mu           sync.Mutex
+	log          *util.Logger
+	logger       meters.Logger // modbus trace logger
+	address      string
+	loggerSerial uint32
+	timeout      time.Duration
+	connectDelay time.Duration
+	busyDelay    time.Duration
+	keepAlive    time.Duration
+	idleTimeout  time.Duration
+	slaveBackoff time.Duration
+	retransmit   time.Duration
+	udp          bool
+	localIP      net.IP
+	lenient      bool
+	strictStatus bool
+	doubleCRC    DoubleCRC
+	cache        *solarmanV5Cache
+
+	timeouts map[byte]time.Duration          // per slave response timeouts
+	slaves   map[byte]*SolarmanV5SlaveHealth // per slave health
+
+	conn      net.Conn
+	reader    *solarmanV5Reader
+	seq       uint8
+	connected bool      // connection has been established before
+	lastUsed  time.Time // last successful request or connect
+}
+
+func (t *solarmanV5Transport) getCache() *solarmanV5Cache {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.cache
+}
+
+func (t *solarmanV5Transport) setLogger(l meters.Logger) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.logger = l
+}
+
+// redactSerial hides the logger serial from logs
+func (t *solarmanV5Transport) redactSerial() {
+	if t.loggerSerial != uint32(LoggerSerialAuto) {
+		t.log.Redact(strconv.FormatUint(uint64(t.loggerSerial), 10))
+	}
+}
+
+// Send wraps the Modbus RTU request into a SolarmanV5 frame and returns the unwrapped RTU response.
+// Loggers silently drop idle connections, a request failing on a reused connection is retried once on a new connection.
+func (t *solarmanV5Transport) Send(aduRequest []byte) ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.conn != nil && t.idleTimeout > 0 && time.Since(t.lastUsed) > t.idleTimeout {
+		t.log.DEBUG.Printf("%s: reconnecting idle connection", t.address)
+		t.close()
+	}
+
+	slave := aduRequest[0]
+	if err := t.checkSlave(slave); err != nil {
+		return nil, err
+	}
+
+	reused := t.conn != nil
+
+	start := time.Now()
+	res, err := t.send(aduRequest)
+
+	if err != nil && reused && t.conn == nil && !isTimeout(err) {
+		t.log.DEBUG.Printf("%s: retrying after connection error: %v", t.address, err)
+		solarmanV5RetryMetric.WithLabelValues(t.address).Inc()
+		res, err = t.send(aduRequest)
+	}
+
+	t.updateSlave(slave, err)
+	solarmanV5RoundtripMetric.WithLabelValues(t.address).Observe(time.Since(start).Seconds())
+
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	solarmanV5RequestMetric.WithLabelValues(t.address, result).Inc()
+
+	return res, err
+}
+
+// isTimeout checks if err is a network timeout
+func isTimeout(err error) bool {
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
+
+func (t *solarmanV5Transport) send(aduRequest []byte) ([]byte, error) {
+	if err := t.connect(); err != nil {
+		return nil, err
+	}
+
+	var deadline time.Time
+	if timeout := t.slaveTimeout(aduRequest[0]); timeout > 0 {
+		deadline = time.Now().Add(timeout)
+		if err := t.conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	t.seq++
+	payload := requestPayload(aduRequest)
+
+	request := t.encodeFrame(payload)
+	t.trace("send", request)
+
+	frame, err := t.roundtrip(request, deadline)
+	if err != nil {
+		// keep the session if other slaves behind the logger respond, late responses are discarded as stale
+		if !isTimeout(err) || !t.othersResponding(aduRequest[0]) {
+			t.close()
+		}
+		return nil, err
+	}
+
+	t.trace("recv", frame)
+	t.lastUsed = time.Now()
+
+	if err := verifyResponse(frame, t.loggerSerial, t.lenient, t.strictStatus); err != nil {
+		return nil, err
+	}
+
+	adu, err := parseResponse(frame)
+	if err != nil {
+		return nil, err
+	}
+
+	adu, trimmed := fixDoubleCRC(adu, t.doubleCRC)
+	if trimmed {
+		t.log.DEBUG.Printf("%s: removed duplicate crc", t.address)
+		solarmanV5DoubleCRCMetric.WithLabelValues(t.address).Inc()
+	}
+
+	return adu, nil
+}
+
+// roundtrip writes the request and reads the matching response.
+// Datagrams may get lost, over udp the request is retransmitted until the deadline.
+// Responses to earlier transmissions carry the same sequence number and are accepted.
+func (t *solarmanV5Transport) roundtrip(request []byte, deadline time.Time) ([]byte, error) {
+	for {
+		if _, err := t.conn.Write(request); err != nil {
+			return nil, err
+		}
+
+		if !t.udp {
+			return t.readResponse(t.seq)
+		}
+
+		next := time.Now().Add(t.retransmit)
+		if !deadline.IsZero() && deadline.Before(next) {
+			next = deadline
+		}
+		if err := t.conn.SetReadDeadline(next); err != nil {
+			return nil, err
+		}
+
+		frame, err := t.readResponse(t.seq)
+		if err == nil || !isTimeout(err) || !deadline.IsZero() && !time.Now().Before(deadline) {
+			return frame, err
+		}
+
+		t.log.DEBUG.Printf("%s: retransmitting unanswered request", t.address)
+		solarmanV5RetransmitMetric.WithLabelValues(t.address).Inc()
+	}
+}
+
+// requestPayload creates the request payload wrapping the Modbus RTU frame
+func requestPayload(adu []byte) []byte {
+	// payload: frame type, sensor type, total working time, power on time, offset time
+	payload := make([]byte, solarmanV5RequestPayloadSize, solarmanV5RequestPayloadSize+len(adu))
+	payload[0] = 0x02
+	return append(payload, adu...)
+}
+
+// encodeFrame creates the SolarmanV5 request frame for the given payload
+func (t *solarmanV5Transport) encodeFrame(payload []byte) []byte {
+	b := make([]byte, 0, solarmanV5HeaderSize+len(payload)+solarmanV5TrailerSize)
+	b = append(b, solarmanV5Start)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(payload)))
+	b = binary.LittleEndian.AppendUint16(b, solarmanV5ControlRequest)
+	b = append(b, t.seq, 0)
+	b = binary.LittleEndian.AppendUint32(b, t.loggerSerial)
+	b = append(b, payload...)
+
+	return append(b, solarmanV5Checksum(b[1:]), solarmanV5End)
+}
+
+// readResponse reads the next complete SolarmanV5 response frame matching the request sequence number.
+// Stale responses to previous requests and unsolicited frames are discarded until the stream is in sync again.
+func (t *solarmanV5Transport) readResponse(seq uint8) ([]byte, error) {
+	for {
+		frame, skipped, err := t.reader.next()
+		if skipped > 0 {
+			t.log.DEBUG.Printf("%s: skipped %d bytes while resyncing", t.address, skipped)
+		}
+		if n := t.reader.checksumErrors; n > 0 {
+			solarmanV5ChecksumMetric.WithLabelValues(t.address).Add(float64(n))
+			t.reader.checksumErrors = 0
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// loggers may send heartbeats at any time
+		if control := binary.LittleEndian.Uint16(frame[3:5]); isUnsolicitedControl(control) {
+			t.log.DEBUG.Printf("%s: discarding unsolicited frame: control code %04x", t.address, control)
+			continue
+		}
+
+		if frame[5] == seq {
+			return frame, nil
+		}
+
+		t.log.DEBUG.Printf("%s: discarding stale response: sequence %d, expected %d", t.address, frame[5], seq)
+	}
+}
+
+// isUnsolicitedControl checks if the control code belongs to a frame pushed by the logger on its own
+func isUnsolicitedControl(control uint16) bool {
+	switch control {
+	case solarmanV5ControlHandshake, solarmanV5ControlData, solarmanV5ControlInfo, solarmanV5ControlHeartbeat, solarmanV5ControlReport:
+		return true
+	default:
+		return false
+	}
+}
+
+// verifyResponse checks that the frame is a response sent by the logger with the given serial.
+// Lenient skips control code and logger serial validation, strict status requires status 0x01.
+func verifyResponse(frame []byte, loggerSerial uint32, lenient, strictStatus bool) error {
+	if control := binary.LittleEndian.Uint16(frame[3:5]); control != solarmanV5ControlResponse && !lenient {
+		return fmt.Errorf("solarmanv5: invalid response control code %04x, expected %04x", control, solarmanV5ControlResponse)
+	}
+
+	if serial := binary.LittleEndian.Uint32(frame[7:11]); serial != loggerSerial && !lenient {
+		return fmt.Errorf("solarmanv5: response from logger serial %d, expected %d", serial, loggerSerial)
+	}
+
+	if !strictStatus {
+		return nil
+	}
+
+	if len(frame) < solarmanV5HeaderSize+2+solarmanV5TrailerSize {
+		return errors.New("solarmanv5: response without status")
+	}
+
+	if status := frame[solarmanV5HeaderSize+1]; status != solarmanV5StatusOK {
+		return fmt.Errorf("solarmanv5: invalid response status %02x, expected %02x", status, solarmanV5StatusOK)
+	}
+
+	return nil
+}
+
+// parseResponse extracts the Modbus RTU frame from the SolarmanV5 response frame
+func parseResponse(frame []byte) ([]byte, error) {
+	if len(frame) < solarmanV5HeaderSize+solarmanV5ResponsePayloadSize+rtuMinSize+solarmanV5TrailerSize {
+		return nil, errors.New("solarmanv5: frame does not contain a valid modbus rtu frame")
+	}
+
+	return frame[solarmanV5HeaderSize+solarmanV5ResponsePayloadSize : len(frame)-solarmanV5TrailerSize], nil
+}
+
+// Connect establishes the logger connection
+func (t *solarmanV5Transport) Connect() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.connect()
+}
+
+func (t *solarmanV5Transport) connect() error {
+	if t.conn != nil {
+		return nil
+	}
+
+	if t.loggerSerial == uint32(LoggerSerialAuto) {
+		host, _, err := net.SplitHostPort(t.address)
+		if err != nil {
+			return err
+		}
+
+		serial, err := solarmanV5DiscoverSerial(net.JoinHostPort(host, strconv.Itoa(solarmanV5DiscoveryPort)), t.localIP, t.timeout)
+		if err != nil {
+			return err
+		}
+
+		t.loggerSerial = serial
+		t.redactSerial()
+
+		t.log.DEBUG.Printf("%s: detected logger serial %d", t.address, serial)
+	}
+
+	network := "tcp"
+	if t.udp {
+		network = "udp"
+	}
+
+	dialer := net.Dialer{Timeout: t.timeout, LocalAddr: localAddr(network, t.localIP)}
+	if t.keepAlive > 0 && !t.udp {
+		dialer.KeepAliveConfig = net.KeepAliveConfig{
+			Enable:   true,
+			Idle:     t.keepAlive,
+			Interval: t.keepAlive,
+		}
+	}
+	conn, err := dialer.Dial(network, t.address)
+	if err != nil {
+		return err
+	}
+
+	if t.connected {
+		solarmanV5ReconnectMetric.WithLabelValues(t.address).Inc()
+	}
+
+	t.conn = conn
+	if t.udp {
+		t.reader = newSolarmanV5Reader(newDatagramReader(conn))
+	} else {
+		t.reader = newSolarmanV5Reader(conn)
+	}
+	t.connected = true
+	t.lastUsed = time.Now()
+
+	// silent period
+	time.Sleep(t.connectDelay)
+
+	return nil
+}
+
+// Close closes the logger connection
+func (t *solarmanV5Transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.close()
+}
+
+func (t *solarmanV5Transport) close() error {
+	if t.conn == nil {
+		return nil
+	}
+
+	err := t.conn.Close()
+	t.conn = nil
+	t.reader = nil
+
+	return err
+}
+
+// datagramReader serves partial reads from whole datagrams.
+// Reading a datagram into a short buffer would discard its remainder.
+type datagramReader struct {
+	conn net.Conn
+	buf  []byte
+	data []byte
+}
+
+func newDatagramReader(conn net.Conn) *datagramReader {
+	return &datagramReader{
+		conn: conn,
+		buf:  make([]byte, solarmanV5HeaderSize+solarmanV5MaxPayload+solarmanV5TrailerSize),
+	}
+}
+
+func (r *datagramReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		n, err := r.conn.Read(r.buf)
+		if err != nil {
+			return 0, err
+		}
+		r.data = r.buf[:n]
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+// solarmanV5Reader is a frame scanner tolerating fragmented reads and garbage between frames
+type solarmanV5Reader struct {
+	r              io.Reader
+	buf            []byte
+	checksumErrors int // frames dropped due to invalid checksum
+}
+
+func newSolarmanV5Reader(r io.Reader) *solarmanV5Reader {
+	return &solarmanV5Reader{r: r}
+}
+
+// fill ensures at least n bytes are buffered
+func (r *solarmanV5Reader) fill(n int) error {
+	if len(r.buf) >= n {
+		return nil
+	}
+
+	have := len(r.buf)
+	r.buf = append(r.buf, make([]byte, n-have)...)
+
+	m, err := io.ReadFull(r.r, r.buf[have:])
+	r.buf = r.buf[:have+m]
+
+	return err
+}
+
+// next returns the next frame with valid length, checksum and end byte.
+// Any data not forming a valid frame is skipped and counted.
+func (r *solarmanV5Reader) next() ([]byte, int, error) {
+	var skipped int
+
+	// drop leading bytes and resync on the next start byte
+	resync := func(n int) {
+		r.buf = r.buf[n:]
+		if i := bytes.IndexByte(r.buf, solarmanV5Start); i >= 0 {
+			n += i
+			r.buf = r.buf[i:]
+		} else {
+			n += len(r.buf)
+			r.buf = r.buf[:0]
+		}
+		skipped += n
+	}
+
+	for {
+		if err := r.fill(1); err != nil {
+			return nil, skipped, err
+		}
+
+		if r.buf[0] != solarmanV5Start {
+			resync(0)
+			continue
+		}
+
+		if err := r.fill(solarmanV5HeaderSize); err != nil {
+			return nil, skipped, err
+		}
+
+		length := int(binary.LittleEndian.Uint16(r.buf[1:3]))
+		if length > solarmanV5MaxPayload {
+			resync(1)
+			continue
+		}
+
+		size := solarmanV5HeaderSize + length + solarmanV5TrailerSize
+		if err := r.fill(size); err != nil {
+			return nil, skipped, err
+		}
+
+		if r.buf[size-1] != solarmanV5End || r.buf[size-2] != solarmanV5Checksum(r.buf[1:size-2]) {
+			if r.buf[size-1] == solarmanV5End {
+				r.checksumErrors++
+			}
+			resync(1)
+			continue
+		}
+
+		frame := bytes.Clone(r.buf[:size])
+		r.buf = r.buf[size:]
+
+		return frame, skipped, nil
+	}
+}
+
+// solarmanV5Checksum is the sum of all frame bytes between start and checksum
+func solarmanV5Checksum(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+	return sum
+}
+
+// crc16 calculates the Modbus RTU CRC
+func crc16(b []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, v := range b {
+		crc ^= uint16(v)
+		for range 8 {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
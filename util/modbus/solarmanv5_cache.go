@@ -0,0 +1,70 @@
+package modbus
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// solarmanV5Cache caches register read responses of a logger connection.
+// Loggers rate-limit to roughly one request per second, devices reading the same registers within
+// an update cycle are served from the cache.
+type solarmanV5Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	send    func([]byte) ([]byte, error)
+	entries map[string]solarmanV5CacheEntry
+}
+
+type solarmanV5CacheEntry struct {
+	res     []byte
+	created time.Time
+}
+
+func newSolarmanV5Cache(ttl time.Duration, send func([]byte) ([]byte, error)) *solarmanV5Cache {
+	return &solarmanV5Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		send:    send,
+		entries: make(map[string]solarmanV5CacheEntry),
+	}
+}
+
+// Send returns cached responses for register reads. Any other request invalidates the cache.
+func (c *solarmanV5Cache) Send(adu []byte) ([]byte, error) {
+	if !isRegisterRead(adu) {
+		c.mu.Lock()
+		clear(c.entries)
+		c.mu.Unlock()
+
+		return c.send(adu)
+	}
+
+	key := string(adu)
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Sub(e.created) < c.ttl {
+		c.mu.Unlock()
+		return bytes.Clone(e.res), nil
+	}
+	c.mu.Unlock()
+
+	res, err := c.send(adu)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = solarmanV5CacheEntry{res: bytes.Clone(res), created: c.now()}
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+// isRegisterRead checks if the Modbus RTU request is a holding or input register read
+func isRegisterRead(adu []byte) bool {
+	return len(adu) == 8 && (adu[1] == modbus.FuncCodeReadHoldingRegisters || adu[1] == modbus.FuncCodeReadInputRegisters)
+}
@@ -0,0 +1,59 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestRequest(slave, fn byte, addr, qty uint16) []byte {
+	b := []byte{slave, fn}
+	b = binary.BigEndian.AppendUint16(b, addr)
+	b = binary.BigEndian.AppendUint16(b, qty)
+	return binary.LittleEndian.AppendUint16(b, crc16(b))
+}
+
+func TestSolarmanV5Cache(t *testing.T) {
+	var requests int
+	send := func(adu []byte) ([]byte, error) {
+		requests++
+		return rtuTestFrame(adu[0], adu[1], 2, 0, byte(requests)), nil
+	}
+
+	now := time.Now()
+	c := newSolarmanV5Cache(time.Second, send)
+	c.now = func() time.Time { return now }
+
+	read := readTestRequest(1, 3, 100, 1)
+
+	res, err := c.Send(read)
+	require.NoError(t, err)
+	assert.Equal(t, rtuTestFrame(1, 3, 2, 0, 1), res)
+
+	// cached
+	res, err = c.Send(read)
+	require.NoError(t, err)
+	assert.Equal(t, rtuTestFrame(1, 3, 2, 0, 1), res)
+	assert.Equal(t, 1, requests)
+
+	// other register
+	_, err = c.Send(readTestRequest(1, 3, 101, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	// expired
+	now = now.Add(time.Second)
+	res, err = c.Send(read)
+	require.NoError(t, err)
+	assert.Equal(t, rtuTestFrame(1, 3, 2, 0, 3), res)
+
+	// writes invalidate
+	_, err = c.Send(rtuTestFrame(1, 6, 0, 100, 0, 1))
+	require.NoError(t, err)
+	_, err = c.Send(read)
+	require.NoError(t, err)
+	assert.Equal(t, 5, requests)
+}
@@ -0,0 +1,95 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/grid-x/modbus"
+)
+
+// DoubleCRC controls removal of the duplicate CRC some logger firmwares append to the RTU response
+type DoubleCRC int
+
+const (
+	DoubleCRCAuto DoubleCRC = iota // remove duplicate CRC if the response is longer than expected
+	DoubleCRCOn                    // always remove the trailing duplicate CRC
+	DoubleCRCOff                   // never modify the response
+)
+
+// String implements fmt.Stringer
+func (d DoubleCRC) String() string {
+	switch d {
+	case DoubleCRCOn:
+		return "on"
+	case DoubleCRCOff:
+		return "off"
+	default:
+		return "auto"
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *DoubleCRC) UnmarshalText(text []byte) error {
+	switch str := strings.ToLower(strings.TrimSpace(string(text))); str {
+	case "", "auto":
+		*d = DoubleCRCAuto
+	case "on", "true":
+		*d = DoubleCRCOn
+	case "off", "false":
+		*d = DoubleCRCOff
+	default:
+		return fmt.Errorf("invalid double crc mode: %s", str)
+	}
+
+	return nil
+}
+
+// rtuResponseLength returns the expected length of the Modbus RTU response including CRC or 0 if unknown
+func rtuResponseLength(adu []byte) int {
+	if len(adu) < 3 {
+		return 0
+	}
+
+	switch fn := adu[1]; {
+	case fn&0x80 != 0:
+		return 5
+	case fn == modbus.FuncCodeReadCoils, fn == modbus.FuncCodeReadDiscreteInputs,
+		fn == modbus.FuncCodeReadHoldingRegisters, fn == modbus.FuncCodeReadInputRegisters:
+		return 5 + int(adu[2])
+	case fn == modbus.FuncCodeWriteSingleCoil, fn == modbus.FuncCodeWriteMultipleCoils,
+		fn == modbus.FuncCodeWriteSingleRegister, fn == modbus.FuncCodeWriteMultipleRegisters:
+		return 8
+	default:
+		return 0
+	}
+}
+
+// fixDoubleCRC removes the duplicate CRC some logger firmwares append to the RTU frame and returns if it was removed.
+// In auto mode the CRC is only removed if the response is two bytes longer than expected for its function code,
+// otherwise payloads ending in CRC-valid bytes would be truncated.
+func fixDoubleCRC(adu []byte, mode DoubleCRC) ([]byte, bool) {
+	n := len(adu)
+	if n <= rtuMinSize+2 || mode == DoubleCRCOff {
+		return adu, false
+	}
+
+	if mode == DoubleCRCOn {
+		return adu[:n-2], true
+	}
+
+	if l := rtuResponseLength(adu); l > 0 && n != l+2 {
+		return adu, false
+	}
+
+	if binary.LittleEndian.Uint16(adu[n-4:]) == crc16(adu[:n-4]) {
+		return adu[:n-2], true
+	}
+
+	return adu, false
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d DoubleCRC) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
@@ -0,0 +1,54 @@
+package modbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixDoubleCRC(t *testing.T) {
+	adu := rtuTestFrame(1, 3, 2, 0x12, 0x34)
+
+	// register payload ending in bytes that are a valid crc of the preceding bytes
+	payload := rtuTestFrame(1, 3, 4, 0x12, 0x34)
+	tricky := rtuTestFrame(payload...)
+
+	tc := []struct {
+		name    string
+		mode    DoubleCRC
+		in, out []byte
+		trimmed bool
+	}{
+		{"auto single", DoubleCRCAuto, adu, adu, false},
+		{"auto double", DoubleCRCAuto, rtuTestFrame(adu...), adu, true},
+		{"auto crc-valid payload", DoubleCRCAuto, tricky, tricky, false},
+		{"auto exception", DoubleCRCAuto, rtuTestFrame(rtuTestFrame(1, 0x83, 2)...), rtuTestFrame(1, 0x83, 2), true},
+		{"off", DoubleCRCOff, rtuTestFrame(adu...), rtuTestFrame(adu...), false},
+		{"on", DoubleCRCOn, rtuTestFrame(adu...), adu, true},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			res, trimmed := fixDoubleCRC(tc.in, tc.mode)
+			assert.Equal(t, tc.out, res)
+			assert.Equal(t, tc.trimmed, trimmed)
+		})
+	}
+}
+
+func TestDoubleCRCUnmarshalText(t *testing.T) {
+	for in, mode := range map[string]DoubleCRC{
+		"":     DoubleCRCAuto,
+		"auto": DoubleCRCAuto,
+		"On":   DoubleCRCOn,
+		"off":  DoubleCRCOff,
+	} {
+		var d DoubleCRC
+		require.NoError(t, d.UnmarshalText([]byte(in)))
+		assert.Equal(t, mode, d)
+	}
+
+	var d DoubleCRC
+	assert.Error(t, d.UnmarshalText([]byte("foo")))
+}
@@ -0,0 +1,424 @@
+package modbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// SolarmanV5Emulator is an in-process SolarmanV5 logger serving a register map.
+// It allows testing SolarmanV5 devices without logger hardware. Quirks must be configured before connecting.
+type SolarmanV5Emulator struct {
+	listener     net.Listener
+	packetConn   net.PacketConn // udp mode
+	loggerSerial uint32
+
+	mu        sync.Mutex
+	registers map[byte]map[uint16]uint16
+	offline   map[byte]bool
+	conns     map[net.Conn]struct{}
+	accepted  int
+	requests  int
+
+	// DoubleCRC appends a second CRC to the RTU response like some logger firmwares
+	DoubleCRC bool
+	// Delay delays all responses
+	Delay time.Duration
+	// Heartbeat sends unsolicited heartbeat frames in the given interval
+	Heartbeat time.Duration
+	// Busy rejects the given number of writes with a busy exception
+	Busy int
+	// ControlCode overrides the response control code like non-conforming logger clones
+	ControlCode uint16
+	// Status overrides the response status like firmwares deviating from the protocol
+	Status byte
+	// Serial overrides the logger serial echoed in responses
+	Serial uint32
+	// Drop silently discards the given number of requests like lost datagrams
+	Drop int
+	// Zero answers reads of unset registers with zero instead of an exception
+	Zero bool
+}
+
+// NewSolarmanV5Emulator creates a logger emulator with the given serial listening on a random local port
+func NewSolarmanV5Emulator(loggerSerial uint32) (*SolarmanV5Emulator, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	e := &SolarmanV5Emulator{
+		listener:     l,
+		loggerSerial: loggerSerial,
+		registers:    make(map[byte]map[uint16]uint16),
+		offline:      make(map[byte]bool),
+		conns:        make(map[net.Conn]struct{}),
+	}
+
+	go e.run()
+
+	return e, nil
+}
+
+// NewSolarmanV5UDPEmulator creates a logger emulator with the given serial answering udp datagrams on a random local port
+func NewSolarmanV5UDPEmulator(loggerSerial uint32) (*SolarmanV5Emulator, error) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	e := &SolarmanV5Emulator{
+		packetConn:   pc,
+		loggerSerial: loggerSerial,
+		registers:    make(map[byte]map[uint16]uint16),
+		offline:      make(map[byte]bool),
+		conns:        make(map[net.Conn]struct{}),
+	}
+
+	go e.serveUDP()
+
+	return e, nil
+}
+
+// Addr returns the listener address
+func (e *SolarmanV5Emulator) Addr() string {
+	if e.packetConn != nil {
+		return e.packetConn.LocalAddr().String()
+	}
+	return e.listener.Addr().String()
+}
+
+// Close stops the emulator and closes all connections
+func (e *SolarmanV5Emulator) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for conn := range e.conns {
+		conn.Close()
+	}
+
+	if e.packetConn != nil {
+		return e.packetConn.Close()
+	}
+	return e.listener.Close()
+}
+
+// SetRegisters sets consecutive register values of the given slave starting at addr.
+// Holding and input registers share the same register map.
+func (e *SolarmanV5Emulator) SetRegisters(slave byte, addr uint16, values ...uint16) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.registers[slave] == nil {
+		e.registers[slave] = make(map[uint16]uint16)
+	}
+
+	for i, v := range values {
+		e.registers[slave][addr+uint16(i)] = v
+	}
+}
+
+// SetOffline lets the given slave stop responding like a dead inverter on the logger's bus
+func (e *SolarmanV5Emulator) SetOffline(slave byte, offline bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.offline[slave] = offline
+}
+
+// Register returns the register value of the given slave
+func (e *SolarmanV5Emulator) Register(slave byte, addr uint16) (uint16, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	v, ok := e.registers[slave][addr]
+	return v, ok
+}
+
+// Connections returns the number of accepted connections. It is always zero in udp mode.
+func (e *SolarmanV5Emulator) Connections() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.accepted
+}
+
+// Requests returns the number of answered requests
+func (e *SolarmanV5Emulator) Requests() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.requests
+}
+
+func (e *SolarmanV5Emulator) run() {
+	for {
+		conn, err := e.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		e.mu.Lock()
+		e.conns[conn] = struct{}{}
+		e.accepted++
+		e.mu.Unlock()
+
+		go e.handle(conn)
+	}
+}
+
+func (e *SolarmanV5Emulator) handle(conn net.Conn) {
+	var writeMu sync.Mutex
+	write := func(b []byte) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+
+		_, err := conn.Write(b)
+		return err
+	}
+
+	done := make(chan struct{})
+
+	defer func() {
+		close(done)
+		conn.Close()
+
+		e.mu.Lock()
+		delete(e.conns, conn)
+		e.mu.Unlock()
+	}()
+
+	if e.Heartbeat > 0 {
+		go func() {
+			var seq uint8
+			for tick := time.NewTicker(e.Heartbeat); ; {
+				select {
+				case <-done:
+					tick.Stop()
+					return
+				case <-tick.C:
+					seq++
+					if err := write(e.encodeFrame(solarmanV5ControlHeartbeat, seq, []byte{0x00})); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	r := newSolarmanV5Reader(conn)
+
+	for {
+		frame, _, err := r.next()
+		if err != nil {
+			return
+		}
+
+		res, ok := e.process(frame)
+		if !ok {
+			continue
+		}
+
+		if err := write(res); err != nil {
+			return
+		}
+
+		e.mu.Lock()
+		e.requests++
+		e.mu.Unlock()
+	}
+}
+
+// serveUDP answers each request datagram with a response datagram
+func (e *SolarmanV5Emulator) serveUDP() {
+	buf := make([]byte, solarmanV5HeaderSize+solarmanV5MaxPayload+solarmanV5TrailerSize)
+
+	for {
+		n, addr, err := e.packetConn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		frame, _, err := newSolarmanV5Reader(bytes.NewReader(buf[:n])).next()
+		if err != nil {
+			continue
+		}
+
+		res, ok := e.process(frame)
+		if !ok {
+			continue
+		}
+
+		if _, err := e.packetConn.WriteTo(res, addr); err != nil {
+			return
+		}
+
+		e.mu.Lock()
+		e.requests++
+		e.mu.Unlock()
+	}
+}
+
+// process executes the request frame and returns the response frame.
+// Requests not answered by a logger return false.
+func (e *SolarmanV5Emulator) process(frame []byte) ([]byte, bool) {
+	// loggers ignore frames addressed to other serials
+	if binary.LittleEndian.Uint16(frame[3:5]) != solarmanV5ControlRequest ||
+		binary.LittleEndian.Uint32(frame[7:11]) != e.loggerSerial {
+		return nil, false
+	}
+
+	adu := frame[solarmanV5HeaderSize+solarmanV5RequestPayloadSize : len(frame)-solarmanV5TrailerSize]
+	if len(adu) < rtuMinSize || binary.LittleEndian.Uint16(adu[len(adu)-2:]) != crc16(adu[:len(adu)-2]) {
+		return nil, false
+	}
+
+	e.mu.Lock()
+	offline := e.offline[adu[0]]
+	drop := e.Drop > 0
+	if drop {
+		e.Drop--
+	}
+	e.mu.Unlock()
+
+	if offline || drop {
+		return nil, false
+	}
+
+	res := e.response(adu[:len(adu)-2])
+	res = binary.LittleEndian.AppendUint16(res, crc16(res))
+	if e.DoubleCRC {
+		res = binary.LittleEndian.AppendUint16(res, crc16(res))
+	}
+
+	time.Sleep(e.Delay)
+
+	payload := make([]byte, solarmanV5ResponsePayloadSize, solarmanV5ResponsePayloadSize+len(res))
+	payload[0], payload[1] = 0x02, solarmanV5StatusOK
+	if e.Status != 0 {
+		payload[1] = e.Status
+	}
+
+	control := uint16(solarmanV5ControlResponse)
+	if e.ControlCode != 0 {
+		control = e.ControlCode
+	}
+
+	b := e.encodeFrame(control, frame[5], append(payload, res...))
+	if e.Serial != 0 {
+		binary.LittleEndian.PutUint32(b[7:11], e.Serial)
+		b[len(b)-2] = solarmanV5Checksum(b[1 : len(b)-2])
+	}
+
+	return b, true
+}
+
+// encodeFrame creates a logger frame with the given control code and payload
+func (e *SolarmanV5Emulator) encodeFrame(control uint16, seq uint8, payload []byte) []byte {
+	b := make([]byte, 0, solarmanV5HeaderSize+len(payload)+solarmanV5TrailerSize)
+	b = append(b, solarmanV5Start)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(payload)))
+	b = binary.LittleEndian.AppendUint16(b, control)
+	b = append(b, seq, 0)
+	b = binary.LittleEndian.AppendUint32(b, e.loggerSerial)
+	b = append(b, payload...)
+
+	return append(b, solarmanV5Checksum(b[1:]), solarmanV5End)
+}
+
+// response executes the Modbus RTU request without CRC and returns the response without CRC
+func (e *SolarmanV5Emulator) response(req []byte) []byte {
+	slave, fn, data := req[0], req[1], req[2:]
+
+	res, err := e.execute(slave, fn, data)
+	if err != nil {
+		var me *modbus.Error
+		if !errors.As(err, &me) {
+			me = &modbus.Error{ExceptionCode: modbus.ExceptionCodeServerDeviceFailure}
+		}
+		return []byte{slave, fn | 0x80, me.ExceptionCode}
+	}
+
+	return append([]byte{slave, fn}, res...)
+}
+
+func (e *SolarmanV5Emulator) execute(slave, fn byte, data []byte) ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	illegal := func(code byte) error {
+		return &modbus.Error{FunctionCode: fn, ExceptionCode: code}
+	}
+
+	if e.Busy > 0 && isWriteFunction(fn) {
+		e.Busy--
+		return nil, illegal(modbus.ExceptionCodeServerDeviceBusy)
+	}
+
+	switch fn {
+	case modbus.FuncCodeReadHoldingRegisters, modbus.FuncCodeReadInputRegisters:
+		if len(data) != 4 {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataValue)
+		}
+
+		addr, qty := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		if qty == 0 || qty > maxBlockQuantity {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataValue)
+		}
+
+		res := []byte{byte(2 * qty)}
+		for i := range qty {
+			v, ok := e.registers[slave][addr+i]
+			if !ok && !e.Zero {
+				return nil, illegal(modbus.ExceptionCodeIllegalDataAddress)
+			}
+			res = binary.BigEndian.AppendUint16(res, v)
+		}
+
+		return res, nil
+
+	case modbus.FuncCodeWriteSingleRegister:
+		if len(data) != 4 {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataValue)
+		}
+
+		addr := binary.BigEndian.Uint16(data)
+		if _, ok := e.registers[slave][addr]; !ok {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataAddress)
+		}
+
+		e.registers[slave][addr] = binary.BigEndian.Uint16(data[2:])
+
+		return data, nil
+
+	case modbus.FuncCodeWriteMultipleRegisters:
+		if len(data) < 5 {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataValue)
+		}
+
+		addr, qty := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		if int(data[4]) != 2*int(qty) || len(data) != 5+int(data[4]) {
+			return nil, illegal(modbus.ExceptionCodeIllegalDataValue)
+		}
+
+		for i := range qty {
+			if _, ok := e.registers[slave][addr+i]; !ok {
+				return nil, illegal(modbus.ExceptionCodeIllegalDataAddress)
+			}
+		}
+
+		for i := range qty {
+			e.registers[slave][addr+i] = binary.BigEndian.Uint16(data[5+2*i:])
+		}
+
+		return data[:4], nil
+
+	default:
+		return nil, illegal(modbus.ExceptionCodeIllegalFunction)
+	}
+}
@@ -0,0 +1,399 @@
+package modbus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolarmanV5Emulator(t *testing.T) {
+	tc := []struct {
+		name      string
+		serial    uint32
+		doubleCRC bool
+		heartbeat time.Duration
+		delay     time.Duration
+	}{
+		{"plain", 2712345690, false, 0, 0},
+		{"double crc", 2712345691, true, 0, 0},
+		{"heartbeat", 2712345692, false, 10 * time.Millisecond, 20 * time.Millisecond},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewSolarmanV5Emulator(tc.serial)
+			require.NoError(t, err)
+			defer e.Close()
+
+			e.DoubleCRC = tc.doubleCRC
+			e.Heartbeat = tc.heartbeat
+			e.Delay = tc.delay
+			e.SetRegisters(1, 100, 1, 2, 3)
+
+			c, err := NewSolarmanV5(e.Addr(), tc.serial)
+			require.NoError(t, err)
+			defer c.Close()
+
+			conn := c.Clone(1)
+
+			b, err := conn.ModbusClient().ReadHoldingRegisters(100, 3)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{0, 1, 0, 2, 0, 3}, b)
+
+			_, err = conn.ModbusClient().WriteMultipleRegisters(101, 2, []byte{0, 4, 0, 5})
+			require.NoError(t, err)
+
+			b, err = conn.ModbusClient().ReadInputRegisters(101, 2)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{0, 4, 0, 5}, b)
+
+			// unknown register
+			_, err = conn.ModbusClient().ReadHoldingRegisters(200, 1)
+			var me *modbus.Error
+			require.ErrorAs(t, err, &me)
+			assert.Equal(t, byte(modbus.ExceptionCodeIllegalDataAddress), me.ExceptionCode)
+
+			assert.Equal(t, 4, e.Requests())
+		})
+	}
+}
+
+func TestSolarmanV5EmulatorTimeout(t *testing.T) {
+	const loggerSerial = 2712345693
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.Delay = 100 * time.Millisecond
+	e.SetRegisters(1, 0, 1)
+
+	c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.Slave(1)
+	c.Timeout(10 * time.Millisecond)
+
+	_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+	assert.True(t, isTimeout(err))
+}
+
+func TestSolarmanV5BusyRetry(t *testing.T) {
+	tc := []struct {
+		name string
+		busy int
+		err  bool
+	}{
+		{"retried", solarmanV5BusyRetries, false},
+		{"exhausted", solarmanV5BusyRetries + 1, true},
+	}
+
+	for i, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			loggerSerial := uint32(2712345694 + i)
+
+			e, err := NewSolarmanV5Emulator(loggerSerial)
+			require.NoError(t, err)
+			defer e.Close()
+
+			e.Busy = tc.busy
+			e.SetRegisters(1, 0, 0)
+
+			c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+			require.NoError(t, err)
+			defer c.Close()
+
+			c.handler.transport.busyDelay = time.Millisecond
+			c.Slave(1)
+
+			_, err = c.ModbusClient().WriteSingleRegister(0, 42)
+			if tc.err {
+				assert.True(t, IsException(err, ExceptionServerDeviceBusy))
+				return
+			}
+
+			require.NoError(t, err)
+			v, _ := e.Register(1, 0)
+			assert.Equal(t, uint16(42), v)
+		})
+	}
+
+	// reads are not retried
+	assert.False(t, isBusyWriteResponse([]byte{1, 3}, []byte{1, 0x83, 6}))
+	assert.True(t, isBusyWriteResponse([]byte{1, 6}, []byte{1, 0x86, 6}))
+}
+
+func TestSolarmanV5IdleTimeout(t *testing.T) {
+	loggerSerial := uint32(2712345696)
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 0, 1)
+
+	c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.KeepAlive(time.Second)
+	c.IdleTimeout(50 * time.Millisecond)
+	c.Slave(1)
+
+	for range 2 {
+		_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, e.Connections())
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, e.Connections())
+}
+
+func TestSolarmanV5Lenient(t *testing.T) {
+	tc := []struct {
+		name   string
+		quirk  func(e *SolarmanV5Emulator)
+		option func(c *SolarmanV5Connection)
+		strict bool // option rejects the quirk instead of accepting it
+	}{
+		{"control code", func(e *SolarmanV5Emulator) { e.ControlCode = 0x1110 }, (*SolarmanV5Connection).Lenient, false},
+		{"logger serial", func(e *SolarmanV5Emulator) { e.Serial = 1234 }, (*SolarmanV5Connection).Lenient, false},
+		{"status", func(e *SolarmanV5Emulator) { e.Status = 0x02 }, (*SolarmanV5Connection).StrictStatus, true},
+	}
+
+	for i, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, option := range []bool{false, true} {
+				loggerSerial := uint32(2712345710 + 2*i)
+				if option {
+					loggerSerial++
+				}
+
+				e, err := NewSolarmanV5Emulator(loggerSerial)
+				require.NoError(t, err)
+				defer e.Close()
+
+				tc.quirk(e)
+				e.SetRegisters(1, 0, 42)
+
+				c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+				require.NoError(t, err)
+				defer c.Close()
+
+				if option {
+					tc.option(c)
+				}
+				c.Slave(1)
+
+				_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+				assert.Equal(t, option != tc.strict, err == nil, err)
+			}
+		})
+	}
+}
+
+func TestSolarmanV5DoubleCRCMode(t *testing.T) {
+	for i, mode := range []DoubleCRC{DoubleCRCAuto, DoubleCRCOff} {
+		loggerSerial := uint32(2712345699 + i)
+
+		e, err := NewSolarmanV5Emulator(loggerSerial)
+		require.NoError(t, err)
+		defer e.Close()
+
+		e.DoubleCRC = true
+		e.SetRegisters(1, 0, 42)
+
+		c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+		require.NoError(t, err)
+		defer c.Close()
+
+		c.DoubleCRC(mode)
+		c.Slave(1)
+
+		_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+		if mode == DoubleCRCOff {
+			assert.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, testutil.ToFloat64(solarmanV5DoubleCRCMetric.WithLabelValues(e.Addr())))
+	}
+}
+
+func TestSolarmanV5DeadSlave(t *testing.T) {
+	const loggerSerial = 2712345701
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 0, 1)
+	e.SetRegisters(2, 0, 2)
+
+	c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.handler.transport.slaveBackoff = 50 * time.Millisecond
+
+	alive := c.Clone(1).(*SolarmanV5Connection)
+	dead := c.Clone(2).(*SolarmanV5Connection)
+	dead.Timeout(20 * time.Millisecond)
+
+	_, err = alive.ModbusClient().ReadHoldingRegisters(0, 1)
+	require.NoError(t, err)
+
+	e.SetOffline(2, true)
+
+	for range solarmanV5SlaveFailures {
+		_, err = dead.ModbusClient().ReadHoldingRegisters(0, 1)
+		assert.True(t, isTimeout(err))
+
+		// modbus.Connection closes the connection on errors
+		dead.Close()
+	}
+
+	// fail fast without blocking the logger
+	assert.False(t, dead.SlaveHealth().Available())
+	_, err = dead.ModbusClient().ReadHoldingRegisters(0, 1)
+	require.Error(t, err)
+	assert.False(t, isTimeout(err))
+
+	// other slave unaffected, session kept
+	b, err := alive.ModbusClient().ReadHoldingRegisters(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 1}, b)
+	assert.Equal(t, 1, e.Connections())
+	assert.Equal(t, 0, alive.SlaveHealth().Failures)
+
+	// recovery
+	e.SetOffline(2, false)
+	time.Sleep(60 * time.Millisecond)
+
+	b, err = dead.ModbusClient().ReadHoldingRegisters(0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 2}, b)
+	assert.True(t, dead.SlaveHealth().Available())
+	assert.Equal(t, 0, dead.SlaveHealth().Failures)
+}
+
+func TestSolarmanV5UDP(t *testing.T) {
+	const loggerSerial = 2712345702
+
+	e, err := NewSolarmanV5UDPEmulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.Drop = 1
+	e.SetRegisters(1, 0, 1, 2, 3)
+
+	c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.handler.transport.retransmit = 20 * time.Millisecond
+	c.UDP()
+	c.Slave(1)
+
+	// first datagram is lost
+	b, err := c.ModbusClient().ReadHoldingRegisters(0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 0, 2, 0, 3}, b)
+	assert.Equal(t, 1.0, testutil.ToFloat64(solarmanV5RetransmitMetric.WithLabelValues(e.Addr())))
+
+	_, err = c.ModbusClient().WriteSingleRegister(1, 42)
+	require.NoError(t, err)
+	v, _ := e.Register(1, 1)
+	assert.Equal(t, uint16(42), v)
+
+	// unanswered requests time out
+	e.SetOffline(1, true)
+	c.Timeout(50 * time.Millisecond)
+
+	_, err = c.ModbusClient().ReadHoldingRegisters(0, 1)
+	assert.True(t, isTimeout(err))
+	assert.Equal(t, 2, e.Requests())
+}
+
+func TestSolarmanV5Health(t *testing.T) {
+	const loggerSerial = 2712345703
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.SetRegisters(1, 100, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := NewConnectionWithSettings(ctx, Settings{
+		URI: fmt.Sprintf("solarmanv5://%s?serial=%d", e.Addr(), loggerSerial),
+		ID:  1,
+	})
+	require.NoError(t, err)
+	conn.Timeout(20 * time.Millisecond)
+
+	c := conn.Connection.(*SolarmanV5Connection)
+
+	// unused connections are healthy
+	assert.True(t, c.Healthy())
+
+	// exception responses prove the slave is alive
+	require.NoError(t, c.Ping())
+
+	_, err = conn.ReadHoldingRegisters(100, 1)
+	require.NoError(t, err)
+	assert.True(t, c.Healthy())
+	assert.Equal(t, 2, e.Requests())
+
+	// ping after health interval
+	expire := func() {
+		t := c.handler.transport
+		t.mu.Lock()
+		t.slaves[1].LastSuccess = time.Now().Add(-solarmanV5HealthInterval)
+		t.mu.Unlock()
+	}
+
+	expire()
+	assert.True(t, c.Healthy())
+	assert.Equal(t, 3, e.Requests())
+	assert.Empty(t, Unhealthy())
+
+	e.SetOffline(1, true)
+	expire()
+
+	assert.True(t, isTimeout(c.Ping()))
+	assert.Equal(t, []string{e.Addr()}, Unhealthy())
+}
+
+func TestSolarmanV5EmulatorZero(t *testing.T) {
+	const loggerSerial = 2712345720
+
+	e, err := NewSolarmanV5Emulator(loggerSerial)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.Zero = true
+	e.SetRegisters(1, 101, 7)
+
+	c, err := NewSolarmanV5(e.Addr(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	b, err := c.Clone(1).ModbusClient().ReadHoldingRegisters(100, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 7, 0, 0}, b)
+}
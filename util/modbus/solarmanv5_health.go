@@ -0,0 +1,75 @@
+package modbus
+
+import (
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+// solarmanV5HealthInterval is the duration a response proves the logger healthy without sending a ping
+const solarmanV5HealthInterval = time.Minute
+
+// Ping reads a single holding register of the connection's slave, bypassing the cache.
+// Exception responses prove the device is alive and are not an error.
+func (b *SolarmanV5Connection) Ping() error {
+	return b.handler.transport.ping(b.handler.slaveID)
+}
+
+// Healthy checks if the logger responds. A recent response of any slave is sufficient,
+// otherwise the slaves that have responded before are pinged.
+// Connections without any previous response are considered healthy.
+func (b *SolarmanV5Connection) Healthy() bool {
+	t := b.handler.transport
+	t.mu.Lock()
+
+	var slaves []byte
+	for id, h := range t.slaves {
+		if h.LastSuccess.IsZero() {
+			continue
+		}
+		if time.Since(h.LastSuccess) < solarmanV5HealthInterval {
+			t.mu.Unlock()
+			return true
+		}
+		slaves = append(slaves, id)
+	}
+
+	t.mu.Unlock()
+
+	if len(slaves) == 0 {
+		return true
+	}
+
+	for _, slave := range slaves {
+		if err := t.ping(slave); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ping reads a single holding register of the given slave
+func (t *solarmanV5Transport) ping(slave byte) error {
+	h := &solarmanV5Handler{slaveID: slave, transport: t}
+
+	adu, err := h.Encode(&modbus.ProtocolDataUnit{
+		FunctionCode: modbus.FuncCodeReadHoldingRegisters,
+		Data:         []byte{0, 0, 0, 1},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := t.Send(adu)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Verify(adu, res); err != nil {
+		return err
+	}
+
+	_, err = h.Decode(res)
+	return err
+}
@@ -0,0 +1,80 @@
+package modbus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	solarmanV5RequestMetric    *prometheus.CounterVec
+	solarmanV5RetryMetric      *prometheus.CounterVec
+	solarmanV5ChecksumMetric   *prometheus.CounterVec
+	solarmanV5ReconnectMetric  *prometheus.CounterVec
+	solarmanV5DoubleCRCMetric  *prometheus.CounterVec
+	solarmanV5RetransmitMetric *prometheus.CounterVec
+	solarmanV5RoundtripMetric  *prometheus.HistogramVec
+	solarmanV5SlaveUpMetric    *prometheus.GaugeVec
+)
+
+// SolarmanV5 metrics are labelled by logger address
+func init() {
+	labels := []string{"connection"}
+
+	solarmanV5RequestMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "request_total",
+		Help:      "Total count of SolarmanV5 requests",
+	}, append(labels, "result"))
+
+	solarmanV5RetryMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "retry_total",
+		Help:      "Total count of SolarmanV5 requests retried after connection loss",
+	}, labels)
+
+	solarmanV5ChecksumMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "checksum_error_total",
+		Help:      "Total count of SolarmanV5 frames and Modbus RTU responses with invalid checksum",
+	}, labels)
+
+	solarmanV5ReconnectMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "reconnect_total",
+		Help:      "Total count of SolarmanV5 logger reconnects",
+	}, labels)
+
+	solarmanV5DoubleCRCMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "double_crc_total",
+		Help:      "Total count of Modbus RTU responses with duplicate CRC removed",
+	}, labels)
+
+	solarmanV5RetransmitMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "retransmit_total",
+		Help:      "Total count of SolarmanV5 requests retransmitted over udp",
+	}, labels)
+
+	solarmanV5RoundtripMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "roundtrip_duration_seconds",
+		Help:      "A histogram of SolarmanV5 request round-trip durations",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, labels)
+
+	solarmanV5SlaveUpMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "evcc",
+		Subsystem: "solarmanv5",
+		Name:      "slave_up",
+		Help:      "SolarmanV5 slave responding (1) or timing out (0)",
+	}, append(labels, "slave"))
+
+	prometheus.MustRegister(solarmanV5RequestMetric, solarmanV5RetryMetric, solarmanV5ChecksumMetric, solarmanV5ReconnectMetric, solarmanV5DoubleCRCMetric, solarmanV5RetransmitMetric, solarmanV5RoundtripMetric, solarmanV5SlaveUpMetric)
+}
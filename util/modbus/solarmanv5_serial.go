@@ -0,0 +1,100 @@
+package modbus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	solarmanV5DiscoveryPort    = 48899
+	solarmanV5DiscoveryMessage = "WIFIKIT-214028-READ"
+)
+
+// LoggerSerial is the SolarmanV5 logger serial number.
+// It accepts decimal or hex (0x prefixed) values and `auto` for detecting the serial on first connect.
+// The zero value denotes a missing serial.
+type LoggerSerial uint32
+
+// LoggerSerialAuto detects the logger serial on first connect
+const LoggerSerialAuto LoggerSerial = math.MaxUint32
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *LoggerSerial) UnmarshalText(text []byte) error {
+	str := strings.TrimSpace(string(text))
+
+	if strings.EqualFold(str, "auto") {
+		*s = LoggerSerialAuto
+		return nil
+	}
+
+	// serials are decimal, leading zeros must not be parsed as octal
+	base := 10
+	if hex, ok := strings.CutPrefix(strings.ToLower(str), "0x"); ok {
+		str, base = hex, 16
+	}
+
+	v, err := strconv.ParseUint(str, base, 32)
+	if err != nil || v == 0 || LoggerSerial(v) == LoggerSerialAuto {
+		return fmt.Errorf("invalid logger serial: %s", text)
+	}
+
+	*s = LoggerSerial(v)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s LoggerSerial) MarshalText() ([]byte, error) {
+	if s == LoggerSerialAuto {
+		return []byte("auto"), nil
+	}
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// solarmanV5DiscoverSerial queries the logger for its serial number using the local discovery protocol
+func solarmanV5DiscoverSerial(address string, local net.IP, timeout time.Duration) (uint32, error) {
+	dialer := net.Dialer{Timeout: timeout, LocalAddr: localAddr("udp", local)}
+	conn, err := dialer.Dial("udp", address)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if timeout > 0 {
+		if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := conn.Write([]byte(solarmanV5DiscoveryMessage)); err != nil {
+		return 0, err
+	}
+
+	b := make([]byte, 256)
+	n, err := conn.Read(b)
+	if err != nil {
+		return 0, fmt.Errorf("logger serial discovery: %w", err)
+	}
+
+	return parseSolarmanV5Discovery(b[:n])
+}
+
+// parseSolarmanV5Discovery parses the discovery response `ip,mac,serial`
+func parseSolarmanV5Discovery(b []byte) (uint32, error) {
+	fields := strings.Split(strings.TrimSpace(string(b)), ",")
+	if len(fields) < 3 {
+		return 0, errors.New("logger serial discovery: invalid response")
+	}
+
+	v, err := strconv.ParseUint(fields[2], 10, 32)
+	if err != nil || v == 0 {
+		return 0, fmt.Errorf("logger serial discovery: invalid serial: %s", fields[2])
+	}
+
+	return uint32(v), nil
+}
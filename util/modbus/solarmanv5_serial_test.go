@@ -0,0 +1,62 @@
+package modbus
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggerSerial(t *testing.T) {
+	for _, tc := range []struct {
+		in       any
+		expected LoggerSerial
+		err      bool
+	}{
+		{2712345678, 2712345678, false},
+		{"2712345678", 2712345678, false},
+		{"0xA1B2C3D4", 0xA1B2C3D4, false},
+		{"02712345678", 2712345678, false},
+		{"auto", LoggerSerialAuto, false},
+		{"", 0, true},
+		{"0", 0, true},
+		{"0x1FFFFFFFF", 0, true},
+		{"foo", 0, true},
+	} {
+		var cc Settings
+		err := util.DecodeOther(map[string]any{"solarmanv5": map[string]any{"loggerserial": tc.in}}, &cc)
+
+		if tc.err {
+			assert.Error(t, err, tc.in)
+			continue
+		}
+
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.expected, cc.SolarmanV5.LoggerSerial, tc.in)
+	}
+}
+
+func TestSolarmanV5DiscoverSerial(t *testing.T) {
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		b := make([]byte, 64)
+		n, addr, err := l.ReadFrom(b)
+		if err != nil || string(b[:n]) != solarmanV5DiscoveryMessage {
+			return
+		}
+		_, _ = l.WriteTo([]byte("192.168.1.10,ACCF23123456,2712345678"), addr)
+	}()
+
+	serial, err := solarmanV5DiscoverSerial(l.LocalAddr().String(), net.IPv4(127, 0, 0, 1), time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2712345678), serial)
+
+	_, err = parseSolarmanV5Discovery([]byte("192.168.1.10,ACCF23123456"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,277 @@
+package modbus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// SolarmanV5 logger push frames, see https://github.com/XtheOne/Inverter-Data-Logger
+const (
+	solarmanV5ControlHandshake = 0x4110
+	solarmanV5ControlData      = 0x4210
+	solarmanV5ControlInfo      = 0x4310
+	solarmanV5ControlHeartbeat = 0x4710
+	solarmanV5ControlReport    = 0x4810
+
+	// server responses use the request control code minus offset
+	solarmanV5ControlResponseOffset = 0x3000
+
+	solarmanV5DataHeaderSize = 15 // frame type, sensor type, total working time, power on time, offset time
+
+	// Handshake (0x4110) payload offsets as observed with LSW-3 loggers. There is no published
+	// specification, the frame starts like a data frame but has no sensor type:
+	//
+	//	offset  size  field
+	//	0       1     frame type
+	//	1       4     total working time in seconds, little endian
+	//	5       4     power on time in seconds, little endian
+	//	9       4     offset time
+	//	13      6     unknown
+	//	19      40    firmware version, ascii, zero-padded
+	//	59      6     unknown
+	//	65      1     Wi-Fi signal strength
+	solarmanV5HandshakePowerOnTime  = 5
+	solarmanV5HandshakeFirmware     = 19
+	solarmanV5HandshakeFirmwareSize = 40
+	solarmanV5HandshakeRSSI         = 65
+
+	solarmanV5ServerIdleTimeout = 5 * time.Minute  // loggers send heartbeats every minute
+	solarmanV5ServerDataTimeout = 15 * time.Minute // loggers push data every 5 minutes
+)
+
+// map of created server instances
+var (
+	solarmanV5Servers   = make(map[string]*SolarmanV5Server)
+	solarmanV5ServersMu sync.Mutex
+)
+
+// SolarmanV5Server receives data pushed by SolarmanV5 loggers configured to use evcc as their server
+type SolarmanV5Server struct {
+	log      *util.Logger
+	listener net.Listener
+
+	mu   sync.Mutex
+	data map[uint32]*util.Monitor[[]byte]
+	info map[uint32]*util.Monitor[SolarmanV5LoggerInfo]
+}
+
+// SolarmanV5LoggerInfo is the logger status sent during handshake
+type SolarmanV5LoggerInfo struct {
+	Firmware string
+	RSSI     int
+	Uptime   time.Duration // uptime at handshake
+	Updated  time.Time
+}
+
+// CurrentUptime returns the logger uptime extrapolated from the handshake
+func (i SolarmanV5LoggerInfo) CurrentUptime() time.Duration {
+	return i.Uptime + time.Since(i.Updated)
+}
+
+// parseSolarmanV5Handshake parses the handshake payload
+func parseSolarmanV5Handshake(payload []byte) (SolarmanV5LoggerInfo, error) {
+	if len(payload) <= solarmanV5HandshakeRSSI {
+		return SolarmanV5LoggerInfo{}, fmt.Errorf("invalid handshake frame length: %d", len(payload))
+	}
+
+	firmware := payload[solarmanV5HandshakeFirmware : solarmanV5HandshakeFirmware+solarmanV5HandshakeFirmwareSize]
+	if i := bytes.IndexByte(firmware, 0); i >= 0 {
+		firmware = firmware[:i]
+	}
+
+	return SolarmanV5LoggerInfo{
+		Firmware: strings.TrimSpace(string(firmware)),
+		RSSI:     int(payload[solarmanV5HandshakeRSSI]),
+		Uptime:   time.Duration(binary.LittleEndian.Uint32(payload[solarmanV5HandshakePowerOnTime:])) * time.Second,
+		Updated:  time.Now(),
+	}, nil
+}
+
+// GetSolarmanV5Server returns the shared server instance listening on the given address
+func GetSolarmanV5Server(address string) (*SolarmanV5Server, error) {
+	solarmanV5ServersMu.Lock()
+	defer solarmanV5ServersMu.Unlock()
+
+	if s, ok := solarmanV5Servers[address]; ok {
+		return s, nil
+	}
+
+	s, err := NewSolarmanV5Server(address)
+	if err != nil {
+		return nil, err
+	}
+
+	solarmanV5Servers[address] = s
+
+	return s, nil
+}
+
+// NewSolarmanV5Server creates a server listening on the given address
+func NewSolarmanV5Server(address string) (*SolarmanV5Server, error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SolarmanV5Server{
+		log:      util.NewLogger("solarmanv5"),
+		listener: l,
+		data:     make(map[uint32]*util.Monitor[[]byte]),
+		info:     make(map[uint32]*util.Monitor[SolarmanV5LoggerInfo]),
+	}
+
+	go s.run()
+
+	return s, nil
+}
+
+// Addr returns the listener address
+func (s *SolarmanV5Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Close stops accepting logger connections
+func (s *SolarmanV5Server) Close() error {
+	return s.listener.Close()
+}
+
+// Data returns the data pushed by the given logger, excluding the data frame header
+func (s *SolarmanV5Server) Data(loggerSerial uint32) *util.Monitor[[]byte] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.data[loggerSerial]
+	if !ok {
+		m = util.NewMonitor[[]byte](solarmanV5ServerDataTimeout)
+		s.data[loggerSerial] = m
+	}
+
+	return m
+}
+
+// LoggerInfo returns the status of the given logger received during handshake
+func (s *SolarmanV5Server) LoggerInfo(loggerSerial uint32) *util.Monitor[SolarmanV5LoggerInfo] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.info[loggerSerial]
+	if !ok {
+		m = util.NewMonitor[SolarmanV5LoggerInfo](0)
+		s.info[loggerSerial] = m
+	}
+
+	return m
+}
+
+func (s *SolarmanV5Server) run() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				s.log.ERROR.Println(err)
+			}
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *SolarmanV5Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	s.log.DEBUG.Printf("logger connected: %s", conn.RemoteAddr())
+
+	r := newSolarmanV5Reader(conn)
+
+	for {
+		if err := conn.SetDeadline(time.Now().Add(solarmanV5ServerIdleTimeout)); err != nil {
+			return
+		}
+
+		frame, skipped, err := r.next()
+		if skipped > 0 {
+			s.log.TRACE.Printf("skipped %d bytes while resyncing", skipped)
+		}
+		if err != nil {
+			s.log.DEBUG.Printf("logger disconnected: %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+
+		s.log.TRACE.Printf("recv % x", frame)
+
+		res, err := s.handleFrame(frame)
+		if err != nil {
+			s.log.DEBUG.Println(err)
+			continue
+		}
+
+		s.log.TRACE.Printf("send % x", res)
+
+		if _, err := conn.Write(res); err != nil {
+			return
+		}
+	}
+}
+
+// handleFrame processes a logger frame and returns the response to be sent
+func (s *SolarmanV5Server) handleFrame(frame []byte) ([]byte, error) {
+	control := binary.LittleEndian.Uint16(frame[3:5])
+	loggerSerial := binary.LittleEndian.Uint32(frame[7:11])
+	payload := frame[solarmanV5HeaderSize : len(frame)-solarmanV5TrailerSize]
+
+	switch control {
+	case solarmanV5ControlData:
+		if len(payload) < solarmanV5DataHeaderSize {
+			return nil, fmt.Errorf("logger %d: invalid data frame length: %d", loggerSerial, len(payload))
+		}
+
+		s.Data(loggerSerial).Set(append([]byte(nil), payload[solarmanV5DataHeaderSize:]...))
+
+	case solarmanV5ControlHandshake:
+		// status is informational, the handshake is acknowledged regardless
+		if info, err := parseSolarmanV5Handshake(payload); err == nil {
+			s.LoggerInfo(loggerSerial).Set(info)
+		} else {
+			s.log.DEBUG.Printf("logger %d: %v", loggerSerial, err)
+		}
+
+	case solarmanV5ControlInfo, solarmanV5ControlHeartbeat, solarmanV5ControlReport:
+
+	default:
+		return nil, fmt.Errorf("logger %d: unknown control code: %04x", loggerSerial, control)
+	}
+
+	var frameType byte
+	if len(payload) > 0 {
+		frameType = payload[0]
+	}
+
+	return encodeServerResponse(frame, control-solarmanV5ControlResponseOffset, frameType), nil
+}
+
+// encodeServerResponse creates the response acknowledging a logger frame
+func encodeServerResponse(frame []byte, control uint16, frameType byte) []byte {
+	b := make([]byte, 0, solarmanV5HeaderSize+10+solarmanV5TrailerSize)
+	b = append(b, solarmanV5Start)
+	b = binary.LittleEndian.AppendUint16(b, 10)
+	b = binary.LittleEndian.AppendUint16(b, control)
+
+	// serial and logger serial are echoed
+	b = append(b, frame[5:solarmanV5HeaderSize]...)
+
+	// payload: frame type, status, timestamp, reserved
+	b = append(b, frameType, 0x01)
+	b = binary.LittleEndian.AppendUint32(b, uint32(time.Now().Unix()))
+	b = binary.LittleEndian.AppendUint32(b, 0)
+
+	return append(b, solarmanV5Checksum(b[1:]), solarmanV5End)
+}
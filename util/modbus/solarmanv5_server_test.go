@@ -0,0 +1,97 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// solarmanV5TestPush creates a logger frame with the given control code and payload
+func solarmanV5TestPush(control uint16, loggerSerial uint32, payload []byte) []byte {
+	b := []byte{solarmanV5Start}
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(payload)))
+	b = binary.LittleEndian.AppendUint16(b, control)
+	b = append(b, 7, 0)
+	b = binary.LittleEndian.AppendUint32(b, loggerSerial)
+	b = append(b, payload...)
+	return append(b, solarmanV5Checksum(b[1:]), solarmanV5End)
+}
+
+func TestSolarmanV5Server(t *testing.T) {
+	const loggerSerial = 2712345678
+
+	s, err := NewSolarmanV5Server("127.0.0.1:0")
+	require.NoError(t, err)
+	defer s.Close()
+
+	conn, err := net.Dial("tcp", s.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	r := newSolarmanV5Reader(conn)
+
+	tc := []struct {
+		control, response uint16
+		payload           []byte
+	}{
+		{solarmanV5ControlHandshake, 0x1110, []byte{0x00, 0x01, 0x02}},
+		{solarmanV5ControlHeartbeat, 0x1710, nil},
+		{solarmanV5ControlData, 0x1210, append(append([]byte{0x01}, make([]byte, solarmanV5DataHeaderSize-1)...), 0x12, 0x34, 0xFF, 0xFE)},
+	}
+
+	for _, tc := range tc {
+		_, err := conn.Write(solarmanV5TestPush(tc.control, loggerSerial, tc.payload))
+		require.NoError(t, err)
+
+		res, _, err := r.next()
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.response, binary.LittleEndian.Uint16(res[3:5]))
+		assert.Equal(t, []byte{7, 0}, res[5:7])
+		assert.Equal(t, uint32(loggerSerial), binary.LittleEndian.Uint32(res[7:11]))
+		assert.Equal(t, byte(0x01), res[solarmanV5HeaderSize+1], "status")
+	}
+
+	data, err := s.Data(loggerSerial).Get()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x12, 0x34, 0xFF, 0xFE}, data)
+}
+
+func TestSolarmanV5Handshake(t *testing.T) {
+	const loggerSerial = 2712345679
+
+	s, err := NewSolarmanV5Server("127.0.0.1:0")
+	require.NoError(t, err)
+	defer s.Close()
+
+	payload := make([]byte, solarmanV5HandshakeRSSI+1)
+	binary.LittleEndian.PutUint32(payload[solarmanV5HandshakePowerOnTime:], 3600)
+	copy(payload[solarmanV5HandshakeFirmware:], "LSW3_15_FFFF_1.0.91R")
+	payload[solarmanV5HandshakeRSSI] = 78
+
+	conn, err := net.Dial("tcp", s.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write(solarmanV5TestPush(solarmanV5ControlHandshake, loggerSerial, payload))
+	require.NoError(t, err)
+
+	// wait for acknowledge
+	_, _, err = newSolarmanV5Reader(conn).next()
+	require.NoError(t, err)
+
+	info, err := s.LoggerInfo(loggerSerial).Get()
+	require.NoError(t, err)
+	assert.Equal(t, "LSW3_15_FFFF_1.0.91R", info.Firmware)
+	assert.Equal(t, 78, info.RSSI)
+	assert.Equal(t, time.Hour, info.Uptime)
+	assert.GreaterOrEqual(t, info.CurrentUptime(), time.Hour)
+
+	// short handshake is acknowledged without status
+	_, err = parseSolarmanV5Handshake([]byte{0x00, 0x01, 0x02})
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package modbus
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SolarmanV5Settings contains the SolarmanV5 logger settings
+type SolarmanV5Settings struct {
+	LoggerSerial LoggerSerial  `json:",omitempty" yaml:",omitempty"`
+	Lenient      bool          `json:",omitempty" yaml:",omitempty"` // accept responses with unexpected control code or logger serial
+	StrictStatus bool          `json:",omitempty" yaml:",omitempty"` // reject responses with status other than 0x01
+	DoubleCRC    DoubleCRC     `json:",omitempty" yaml:",omitempty"`
+	Transport    string        `json:",omitempty" yaml:",omitempty"`
+	Coalesce     time.Duration `json:",omitempty" yaml:",omitempty"`
+	Cache        time.Duration `json:",omitempty" yaml:",omitempty"`
+	KeepAlive    time.Duration `json:",omitempty" yaml:",omitempty"`
+	IdleTimeout  time.Duration `json:",omitempty" yaml:",omitempty"`
+}
+
+// parseSolarmanV5URI applies the settings of a solarmanv5://host:port?serial=... uri
+func (s *Settings) parseSolarmanV5URI() error {
+	if !strings.HasPrefix(strings.ToLower(s.URI), solarmanV5Scheme) {
+		return nil
+	}
+
+	u, err := url.Parse(s.URI)
+	if err != nil {
+		return fmt.Errorf("invalid solarmanv5 uri: %w", err)
+	}
+
+	// don't modify the caller's settings
+	cc := new(SolarmanV5Settings)
+	if s.SolarmanV5 != nil {
+		*cc = *s.SolarmanV5
+	}
+
+	s.URI = u.Host
+	s.SolarmanV5 = cc
+
+	q := u.Query()
+
+	if v := q.Get("serial"); v != "" {
+		if err := cc.LoggerSerial.UnmarshalText([]byte(v)); err != nil {
+			return err
+		}
+	}
+
+	for key, b := range map[string]*bool{
+		"lenient":      &cc.Lenient,
+		"strictstatus": &cc.StrictStatus,
+	} {
+		if v := q.Get(key); v != "" {
+			if *b, err = strconv.ParseBool(v); err != nil {
+				return fmt.Errorf("invalid %s: %s", key, v)
+			}
+		}
+	}
+
+	if v := q.Get("transport"); v != "" {
+		cc.Transport = v
+	}
+
+	if v := q.Get("doublecrc"); v != "" {
+		if err := cc.DoubleCRC.UnmarshalText([]byte(v)); err != nil {
+			return err
+		}
+	}
+
+	for key, d := range map[string]*time.Duration{
+		"coalesce":    &cc.Coalesce,
+		"cache":       &cc.Cache,
+		"keepalive":   &cc.KeepAlive,
+		"idletimeout": &cc.IdleTimeout,
+	} {
+		if v := q.Get(key); v != "" {
+			if *d, err = time.ParseDuration(v); err != nil {
+				return fmt.Errorf("invalid %s: %s", key, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// configure applies the settings to the connection
+func (cc *SolarmanV5Settings) configure(conn *SolarmanV5Connection) error {
+	if cc.Lenient {
+		conn.Lenient()
+	}
+	if cc.StrictStatus {
+		conn.StrictStatus()
+	}
+	switch strings.ToLower(cc.Transport) {
+	case "", "tcp":
+	case "udp":
+		conn.UDP()
+	default:
+		return fmt.Errorf("invalid solarmanv5 transport: %s", cc.Transport)
+	}
+	if cc.DoubleCRC != DoubleCRCAuto {
+		conn.DoubleCRC(cc.DoubleCRC)
+	}
+	if cc.Cache > 0 {
+		conn.Cache(cc.Cache)
+	}
+	if cc.KeepAlive > 0 {
+		conn.KeepAlive(cc.KeepAlive)
+	}
+	if cc.IdleTimeout > 0 {
+		conn.IdleTimeout(cc.IdleTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package modbus
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	solarmanV5SlaveFailures = 3           // consecutive timeouts before a slave is considered unavailable
+	solarmanV5SlaveBackoff  = time.Minute // duration requests to an unavailable slave fail without being sent
+)
+
+// SolarmanV5SlaveHealth is the health of a device daisy-chained behind the logger.
+// Only timeouts are slave failures, exception responses prove the device is alive.
+type SolarmanV5SlaveHealth struct {
+	Failures    int       // consecutive timeouts
+	LastSuccess time.Time // last response received
+	LastError   error     // last timeout
+	RetryAfter  time.Time // requests fail fast until
+}
+
+// Available returns false while requests to the slave fail fast
+func (h SolarmanV5SlaveHealth) Available() bool {
+	return !time.Now().Before(h.RetryAfter)
+}
+
+// SlaveHealth returns the health of the connection's slave behind the shared logger
+func (b *SolarmanV5Connection) SlaveHealth() SolarmanV5SlaveHealth {
+	t := b.handler.transport
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if h, ok := t.slaves[b.handler.slaveID]; ok {
+		return *h
+	}
+
+	return SolarmanV5SlaveHealth{}
+}
+
+// slave returns the health of the given slave. Lock must be held.
+func (t *solarmanV5Transport) slave(slave byte) *SolarmanV5SlaveHealth {
+	if t.slaves == nil {
+		t.slaves = make(map[byte]*SolarmanV5SlaveHealth)
+	}
+
+	h, ok := t.slaves[slave]
+	if !ok {
+		h = new(SolarmanV5SlaveHealth)
+		t.slaves[slave] = h
+	}
+
+	return h
+}
+
+// slaveTimeout returns the response timeout of the given slave. Lock must be held.
+func (t *solarmanV5Transport) slaveTimeout(slave byte) time.Duration {
+	if d, ok := t.timeouts[slave]; ok {
+		return d
+	}
+	return t.timeout
+}
+
+// checkSlave fails fast if the slave is unavailable. Lock must be held.
+func (t *solarmanV5Transport) checkSlave(slave byte) error {
+	if h := t.slave(slave); !h.Available() {
+		return fmt.Errorf("solarmanv5: slave %d unavailable until %s: %v", slave, h.RetryAfter.Format(time.TimeOnly), h.LastError)
+	}
+	return nil
+}
+
+// updateSlave records the request result of the given slave. Lock must be held.
+func (t *solarmanV5Transport) updateSlave(slave byte, err error) {
+	h := t.slave(slave)
+
+	switch {
+	case err == nil:
+		if h.Failures >= solarmanV5SlaveFailures {
+			t.log.INFO.Printf("%s: slave %d available again", t.address, slave)
+		}
+
+		h.Failures = 0
+		h.LastSuccess = time.Now()
+		h.LastError = nil
+		h.RetryAfter = time.Time{}
+
+	case isTimeout(err):
+		h.Failures++
+		h.LastError = err
+
+		if h.Failures >= solarmanV5SlaveFailures {
+			h.RetryAfter = time.Now().Add(t.slaveBackoff)
+			t.log.WARN.Printf("%s: slave %d unavailable after %d timeouts, retrying in %v", t.address, slave, h.Failures, t.slaveBackoff)
+		}
+
+	default:
+		// connection errors are not slave specific
+		return
+	}
+
+	up := 1.0
+	if h.Failures > 0 {
+		up = 0
+	}
+	solarmanV5SlaveUpMetric.WithLabelValues(t.address, strconv.Itoa(int(slave))).Set(up)
+}
+
+// isolatedFailure checks if the slave is failing while other slaves behind the logger respond.
+// The shared session must not be closed in this case. Lock must be held.
+func (t *solarmanV5Transport) isolatedFailure(slave byte) bool {
+	if h, ok := t.slaves[slave]; !ok || h.Failures == 0 {
+		return false
+	}
+
+	return t.othersResponding(slave)
+}
+
+// othersResponding checks if any other slave behind the logger responds. Lock must be held.
+func (t *solarmanV5Transport) othersResponding(slave byte) bool {
+	for id, h := range t.slaves {
+		if id != slave && h.Failures == 0 && !h.LastSuccess.IsZero() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,332 @@
+package modbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// solarmanV5TestResponse creates a response frame wrapping the given RTU frame
+func solarmanV5TestResponse(seq uint8, loggerSerial uint32, adu []byte) []byte {
+	b := []byte{solarmanV5Start}
+	b = binary.LittleEndian.AppendUint16(b, uint16(solarmanV5ResponsePayloadSize+len(adu)))
+	b = binary.LittleEndian.AppendUint16(b, solarmanV5ControlResponse)
+	b = append(b, seq, 0)
+	b = binary.LittleEndian.AppendUint32(b, loggerSerial)
+	b = append(b, 0x02, 0x01)
+	b = append(b, make([]byte, solarmanV5ResponsePayloadSize-2)...)
+	b = append(b, adu...)
+	return append(b, solarmanV5Checksum(b[1:]), solarmanV5End)
+}
+
+func rtuTestFrame(b ...byte) []byte {
+	return binary.LittleEndian.AppendUint16(b, crc16(b))
+}
+
+func TestSolarmanV5Reader(t *testing.T) {
+	frame := solarmanV5TestResponse(1, 1234, rtuTestFrame(1, 3, 2, 0x12, 0x34))
+
+	corrupt := bytes.Clone(frame)
+	corrupt[len(corrupt)-2]++
+
+	tc := []struct {
+		name    string
+		data    []byte
+		skipped int
+	}{
+		{"plain", frame, 0},
+		{"garbage", append([]byte{0x00, 0xA5, 0xFF}, frame...), 3},
+		{"corrupt", append(corrupt, frame...), len(corrupt)},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			// fragmented reads
+			r := newSolarmanV5Reader(iotest.OneByteReader(bytes.NewReader(tc.data)))
+
+			res, skipped, err := r.next()
+			require.NoError(t, err)
+			assert.Equal(t, frame, res)
+			assert.Equal(t, tc.skipped, skipped)
+
+			_, _, err = r.next()
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestSolarmanV5ParseResponse(t *testing.T) {
+	adu := rtuTestFrame(1, 3, 2, 0x12, 0x34)
+
+	res, err := parseResponse(solarmanV5TestResponse(1, 1234, adu))
+	require.NoError(t, err)
+	assert.Equal(t, adu, res)
+
+	_, err = parseResponse(solarmanV5TestResponse(1, 1234, nil))
+	assert.Error(t, err)
+}
+
+func TestSolarmanV5VerifyResponse(t *testing.T) {
+	adu := rtuTestFrame(1, 3, 2, 0x12, 0x34)
+
+	frame := solarmanV5TestResponse(1, 1234, adu)
+	require.NoError(t, verifyResponse(frame, 1234, false, false))
+	require.NoError(t, verifyResponse(frame, 1234, false, true))
+
+	// mis-addressed logger
+	err := verifyResponse(frame, 4321, false, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "serial 1234")
+	require.NoError(t, verifyResponse(frame, 4321, true, false))
+
+	// non-conforming control code
+	frame = slices.Clone(frame)
+	binary.LittleEndian.PutUint16(frame[3:5], 0x1110)
+	err = verifyResponse(frame, 1234, false, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "control code 1110")
+	require.NoError(t, verifyResponse(frame, 1234, true, false))
+
+	// non-conforming status is only rejected in strict mode
+	frame = solarmanV5TestResponse(1, 1234, adu)
+	for _, status := range []byte{0x00, 0x02, 0x05} {
+		frame[solarmanV5HeaderSize+1] = status
+		frame[len(frame)-2] = solarmanV5Checksum(frame[1 : len(frame)-2])
+
+		require.NoError(t, verifyResponse(frame, 1234, false, false), status)
+
+		err = verifyResponse(frame, 1234, false, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status")
+	}
+}
+
+func TestSolarmanV5Connection(t *testing.T) {
+	const loggerSerial = 2712345678
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := newSolarmanV5Reader(conn)
+		for {
+			req, _, err := r.next()
+			if err != nil {
+				return
+			}
+
+			if binary.LittleEndian.Uint32(req[7:]) != loggerSerial {
+				return
+			}
+
+			// respond with two registers in two separate segments
+			res := solarmanV5TestResponse(req[5], loggerSerial, rtuTestFrame(1, 3, 4, 0x00, 0x01, 0x00, 0x02))
+			_, _ = conn.Write(res[:7])
+			_, _ = conn.Write(res[7:])
+		}
+	}()
+
+	c, err := NewSolarmanV5(l.Addr().String(), loggerSerial)
+	require.NoError(t, err)
+
+	conn := c.Clone(1)
+	defer conn.Close()
+
+	b, err := conn.ModbusClient().ReadHoldingRegisters(0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x01, 0x00, 0x02}, b)
+}
+
+func TestSolarmanV5SharedTransport(t *testing.T) {
+	// serial must be configured or detected explicitly
+	_, err := NewSolarmanV5("127.0.0.1:8899", 0)
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	settings := func(uri string, serial LoggerSerial) Settings {
+		return Settings{URI: uri, SolarmanV5: &SolarmanV5Settings{LoggerSerial: serial}}
+	}
+
+	a, err := NewConnectionWithSettings(ctx, settings("127.0.0.3:8899", LoggerSerialAuto))
+	require.NoError(t, err)
+
+	// explicit serial is adopted by the shared connection
+	b, err := NewConnectionWithSettings(ctx, settings("127.0.0.3:8899", 1))
+	require.NoError(t, err)
+
+	ta := a.Connection.(*SolarmanV5Connection).handler.transport
+	assert.Same(t, ta, b.Connection.(*SolarmanV5Connection).handler.transport)
+	assert.Equal(t, uint32(1), ta.loggerSerial)
+
+	// conflicting serial for the same logger
+	_, err = NewConnectionWithSettings(ctx, settings("127.0.0.3:8899", 2))
+	require.Error(t, err)
+
+	c, err := NewConnectionWithSettings(ctx, settings("127.0.0.4:8899", 2))
+	require.NoError(t, err)
+	assert.NotSame(t, ta, c.Connection.(*SolarmanV5Connection).handler.transport)
+
+	// clones share the transport but not the slave id
+	d := a.Clone(2).Connection.(*SolarmanV5Connection)
+	assert.Same(t, ta, d.handler.transport)
+	assert.NotEqual(t, a.Connection.(*SolarmanV5Connection).handler.slaveID, d.handler.slaveID)
+}
+
+func TestSolarmanV5SlaveRouting(t *testing.T) {
+	const loggerSerial = 2712345680
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	var accepted atomic.Int32
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			accepted.Add(1)
+
+			go func() {
+				defer conn.Close()
+
+				r := newSolarmanV5Reader(conn)
+				for {
+					req, _, err := r.next()
+					if err != nil {
+						return
+					}
+
+					// answer with the requesting slave id as register value
+					slave := req[solarmanV5HeaderSize+solarmanV5RequestPayloadSize]
+					_, _ = conn.Write(solarmanV5TestResponse(req[5], loggerSerial, rtuTestFrame(slave, 3, 2, 0x00, slave)))
+				}
+			}()
+		}
+	}()
+
+	c, err := NewSolarmanV5(l.Addr().String(), loggerSerial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	var wg sync.WaitGroup
+	for slave := range byte(3) {
+		conn := c.Clone(slave + 1)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range 5 {
+				b, err := conn.ModbusClient().ReadHoldingRegisters(0, 1)
+				assert.NoError(t, err)
+				assert.Equal(t, []byte{0x00, slave + 1}, b)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), accepted.Load())
+}
+
+func TestSolarmanV5StaleResponse(t *testing.T) {
+	const loggerSerial = 2712345678
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := newSolarmanV5Reader(conn)
+		for {
+			req, _, err := r.next()
+			if err != nil {
+				return
+			}
+
+			// delayed answer to previous request precedes the actual response
+			_, _ = conn.Write(solarmanV5TestResponse(req[5]-1, loggerSerial, rtuTestFrame(1, 3, 2, 0xFF, 0xFF)))
+			_, _ = conn.Write(solarmanV5TestResponse(req[5], loggerSerial, rtuTestFrame(1, 3, 2, 0x00, 0x01)))
+		}
+	}()
+
+	c, err := NewSolarmanV5(l.Addr().String(), loggerSerial)
+	require.NoError(t, err)
+
+	conn := c.Clone(1)
+	defer conn.Close()
+
+	for range 2 {
+		b, err := conn.ModbusClient().ReadHoldingRegisters(0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x00, 0x01}, b)
+	}
+}
+
+func TestSolarmanV5Retry(t *testing.T) {
+	const loggerSerial = 2712345679
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			// answer a single request, then drop the idle connection
+			if req, _, err := newSolarmanV5Reader(conn).next(); err == nil {
+				_, _ = conn.Write(solarmanV5TestResponse(req[5], loggerSerial, rtuTestFrame(1, 3, 2, 0x00, 0x01)))
+			}
+			conn.Close()
+		}
+	}()
+
+	address := l.Addr().String()
+	c, err := NewSolarmanV5(address, loggerSerial)
+	require.NoError(t, err)
+
+	conn := c.Clone(1)
+	defer conn.Close()
+
+	for range 2 {
+		b, err := conn.ModbusClient().ReadHoldingRegisters(0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x00, 0x01}, b)
+	}
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(solarmanV5RetryMetric.WithLabelValues(address)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(solarmanV5ReconnectMetric.WithLabelValues(address)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(solarmanV5RequestMetric.WithLabelValues(address, "ok")))
+}
@@ -0,0 +1,57 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// formatSolarmanV5Frame decodes a plaintext SolarmanV5 frame into named fields for trace logging.
+// The logger serial is printed in decimal notation to match the log redaction.
+func formatSolarmanV5Frame(frame []byte) string {
+	if len(frame) < solarmanV5HeaderSize+solarmanV5TrailerSize {
+		return fmt.Sprintf("invalid frame: % x", frame)
+	}
+
+	control := binary.LittleEndian.Uint16(frame[3:5])
+	payload := frame[solarmanV5HeaderSize : len(frame)-solarmanV5TrailerSize]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "control=%04x seq=%d serial=%d", control, frame[5], binary.LittleEndian.Uint32(frame[7:11]))
+
+	var offset int
+	switch control {
+	case solarmanV5ControlRequest:
+		offset = solarmanV5RequestPayloadSize
+	case solarmanV5ControlResponse:
+		offset = solarmanV5ResponsePayloadSize
+	}
+
+	if offset == 0 || len(payload) < offset+rtuMinSize {
+		fmt.Fprintf(&b, " payload=% x", payload)
+		return b.String()
+	}
+
+	adu := payload[offset:]
+	n := len(adu)
+	fmt.Fprintf(&b, " slave=%d func=%02x data=% x crc=%04x", adu[0], adu[1], adu[2:n-2], binary.LittleEndian.Uint16(adu[n-2:]))
+
+	return b.String()
+}
+
+// trace logs the decoded frame to the solarmanv5 log area and forwards it to the modbus trace logger.
+// The modbus trace logger does not redact the logger serial, hence it is removed here.
+func (t *solarmanV5Transport) trace(dir string, frame []byte) {
+	s := formatSolarmanV5Frame(frame)
+	t.log.TRACE.Printf("%s: %s %s", t.address, dir, s)
+
+	if t.logger != nil {
+		if len(frame) >= solarmanV5HeaderSize {
+			serial := fmt.Sprintf("serial=%d", binary.LittleEndian.Uint32(frame[7:11]))
+			s = strings.Replace(s, serial, "serial="+util.RedactReplacement, 1)
+		}
+		t.logger.Printf("modbus: %s %s", dir, s)
+	}
+}
@@ -0,0 +1,63 @@
+package modbus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSolarmanV5Frame(t *testing.T) {
+	tr := &solarmanV5Transport{loggerSerial: 1234, seq: 7}
+
+	tc := []struct {
+		name  string
+		frame []byte
+		res   string
+	}{
+		{
+			"request",
+			tr.encodeFrame(requestPayload(rtuTestFrame(1, 3, 0x00, 0x10, 0x00, 0x02))),
+			"control=4510 seq=7 serial=1234 slave=1 func=03 data=00 10 00 02 crc=cec5",
+		},
+		{
+			"response",
+			solarmanV5TestResponse(7, 1234, rtuTestFrame(1, 3, 2, 0x12, 0x34)),
+			"control=1510 seq=7 serial=1234 slave=1 func=03 data=02 12 34 crc=33b5",
+		},
+		{
+			"heartbeat",
+			solarmanV5TestPush(0x4710, 1234, []byte{0x00}),
+			"control=4710 seq=7 serial=1234 payload=00",
+		},
+		{
+			"invalid",
+			[]byte{solarmanV5Start, 0x00},
+			"invalid frame: a5 00",
+		},
+	}
+
+	for _, tc := range tc {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.res, formatSolarmanV5Frame(tc.frame))
+		})
+	}
+}
+
+type traceLogger []string
+
+func (l *traceLogger) Printf(format string, v ...any) {
+	*l = append(*l, fmt.Sprintf(format, v...))
+}
+
+func TestSolarmanV5TraceLogger(t *testing.T) {
+	var logger traceLogger
+
+	tr := &solarmanV5Transport{log: util.NewLogger("test"), loggerSerial: 1234, seq: 7}
+	tr.setLogger(&logger)
+
+	tr.trace("send", tr.encodeFrame(requestPayload(rtuTestFrame(1, 3, 0x00, 0x10, 0x00, 0x02))))
+
+	assert.Equal(t, []string{"modbus: send control=4510 seq=7 serial=*** slave=1 func=03 data=00 10 00 02 crc=cec5"}, []string(logger))
+}
@@ -0,0 +1,215 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/volkszaehler/mbmd/meters"
+)
+
+const (
+	tcpHeaderSize = 7 // transaction id, protocol id, length, unit id
+	tcpMaxLength  = 260
+)
+
+// localTCP is a Modbus TCP connection dialing from a local address on multi-homed hosts.
+// Packaging is done by the grid-x handler which does not allow customizing its dialer.
+type localTCP struct {
+	Client  modbus.Client
+	Handler *localTCPHandler
+}
+
+var _ meters.Connection = (*localTCP)(nil)
+
+// newLocalTCP creates a Modbus TCP client bound to the given local ip address
+func newLocalTCP(address string, local net.IP) *localTCP {
+	handler := &localTCPHandler{
+		TCPClientHandler: meters.NewTCPClientHandler(address),
+		transport:        &localTCPTransport{local: local},
+	}
+
+	return &localTCP{
+		Client:  modbus.NewClient(handler),
+		Handler: handler,
+	}
+}
+
+// String returns the bus connection address
+func (b *localTCP) String() string {
+	return b.Handler.Address
+}
+
+// ModbusClient returns the modbus client
+func (b *localTCP) ModbusClient() modbus.Client {
+	return b.Client
+}
+
+// Logger sets a logging instance for physical bus operations
+func (b *localTCP) Logger(l meters.Logger) {
+	b.Handler.Logger = l
+}
+
+// Slave sets the modbus device id for the following operations
+func (b *localTCP) Slave(deviceID uint8) {
+	b.Handler.SetSlave(deviceID)
+}
+
+// Timeout sets the modbus timeout
+func (b *localTCP) Timeout(timeout time.Duration) time.Duration {
+	t := b.Handler.TCPClientHandler.Timeout
+	b.Handler.TCPClientHandler.Timeout = timeout
+	return t
+}
+
+// ConnectDelay sets the the initial delay after connecting before starting communication
+func (b *localTCP) ConnectDelay(delay time.Duration) {
+	b.Handler.ConnectDelay = delay
+}
+
+// Close closes the modbus connection.
+// This forces the modbus client to reopen the connection before the next bus operations.
+func (b *localTCP) Close() {
+	_ = b.Handler.Close()
+}
+
+// Clone clones the modbus connection, keeping the underlying transport.
+func (b *localTCP) Clone(deviceID byte) meters.Connection {
+	handler := &localTCPHandler{
+		TCPClientHandler: b.Handler.TCPClientHandler.Clone(),
+		transport:        b.Handler.transport,
+	}
+	handler.SetSlave(deviceID)
+
+	return &localTCP{
+		Client:  modbus.NewClient(handler),
+		Handler: handler,
+	}
+}
+
+// localTCPHandler uses the grid-x handler for packaging and its settings, replacing the transport
+type localTCPHandler struct {
+	*modbus.TCPClientHandler
+	transport *localTCPTransport
+}
+
+var _ modbus.ClientHandler = (*localTCPHandler)(nil)
+
+// localTCPTransport is the connection shared by all clones
+type localTCPTransport struct {
+	mu    sync.Mutex
+	local net.IP
+	conn  net.Conn
+}
+
+func (h *localTCPHandler) logf(format string, v ...any) {
+	if h.Logger != nil {
+		h.Logger.Printf(format, v...)
+	}
+}
+
+// Connect establishes the connection
+func (h *localTCPHandler) Connect() error {
+	h.transport.mu.Lock()
+	defer h.transport.mu.Unlock()
+
+	return h.connect()
+}
+
+func (h *localTCPHandler) connect() error {
+	t := h.transport
+	if t.conn != nil {
+		return nil
+	}
+
+	dialer := net.Dialer{Timeout: h.TCPClientHandler.Timeout, LocalAddr: localAddr("tcp", t.local)}
+	conn, err := dialer.Dial("tcp", h.Address)
+	if err != nil {
+		return err
+	}
+
+	t.conn = conn
+
+	// silent period
+	time.Sleep(h.ConnectDelay)
+
+	return nil
+}
+
+// Close closes the connection
+func (h *localTCPHandler) Close() error {
+	h.transport.mu.Lock()
+	defer h.transport.mu.Unlock()
+
+	return h.close()
+}
+
+func (h *localTCPHandler) close() error {
+	t := h.transport
+	if t.conn == nil {
+		return nil
+	}
+
+	err := t.conn.Close()
+	t.conn = nil
+
+	return err
+}
+
+// Send sends the request and reads the response. Transaction ids are verified by the packager.
+func (h *localTCPHandler) Send(aduRequest []byte) ([]byte, error) {
+	h.transport.mu.Lock()
+	defer h.transport.mu.Unlock()
+
+	if err := h.connect(); err != nil {
+		return nil, err
+	}
+
+	conn := h.transport.conn
+	if timeout := h.TCPClientHandler.Timeout; timeout > 0 {
+		if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+	}
+
+	h.logf("modbus: send % x", aduRequest)
+
+	res, err := h.roundtrip(conn, aduRequest)
+	if err != nil {
+		h.logf("modbus: close connection: %v", err)
+		_ = h.close()
+		return nil, err
+	}
+
+	h.logf("modbus: recv % x", res)
+
+	return res, nil
+}
+
+func (h *localTCPHandler) roundtrip(conn net.Conn, aduRequest []byte) ([]byte, error) {
+	if _, err := conn.Write(aduRequest); err != nil {
+		return nil, err
+	}
+
+	res := make([]byte, tcpHeaderSize, tcpMaxLength)
+	if _, err := io.ReadFull(conn, res); err != nil {
+		return nil, err
+	}
+
+	// length includes the unit id
+	length := int(binary.BigEndian.Uint16(res[4:]))
+	if length <= 1 || tcpHeaderSize-1+length > tcpMaxLength {
+		return nil, fmt.Errorf("modbus: invalid length in response header: %d", length)
+	}
+
+	res = res[:tcpHeaderSize-1+length]
+	if _, err := io.ReadFull(conn, res[tcpHeaderSize:]); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,83 @@
+package modbus
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	remote := make(chan net.Addr, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		remote <- conn.RemoteAddr()
+
+		for {
+			req := make([]byte, 12)
+			if _, err := io.ReadFull(conn, req); err != nil {
+				return
+			}
+
+			// read holding register returning the unit id
+			res := append(req[:4:4], 0, 5, req[6], req[7], 2, 0, req[6])
+			_, _ = conn.Write(res)
+		}
+	}()
+
+	// any loopback address on linux
+	local := net.IPv4(127, 0, 0, 2)
+	if l, err := net.Listen("tcp", "127.0.0.2:0"); err != nil {
+		t.Skip("local address not available")
+	} else {
+		l.Close()
+	}
+
+	c := newLocalTCP(l.Addr().String(), local)
+	defer c.Close()
+
+	for _, slave := range []byte{1, 2} {
+		conn := c.Clone(slave)
+
+		b, err := conn.ModbusClient().ReadHoldingRegisters(0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, slave}, b)
+	}
+
+	addr := (<-remote).(*net.TCPAddr)
+	assert.True(t, addr.IP.Equal(local))
+}
+
+func TestLocalIP(t *testing.T) {
+	ip, err := localIP("192.168.1.10")
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.10", ip.String())
+
+	_, err = localIP("no-such-interface")
+	assert.Error(t, err)
+
+	ifaces, err := net.Interfaces()
+	require.NoError(t, err)
+
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback == 0 {
+			continue
+		}
+
+		ip, err := localIP(iface.Name)
+		require.NoError(t, err)
+		assert.True(t, ip.IsLoopback())
+	}
+}
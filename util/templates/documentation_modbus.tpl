@@ -7,6 +7,15 @@ device: {{ .device }} # USB-RS485 Adapter Adresse
 baudrate: {{ .baudrate }} # Prüfe die Geräteeinstellungen, typische Werte sind 9600, 19200, 38400, 57600, 115200
 comset: "{{ .comset }}" # Kommunikationsparameter für den Adapter
 {{- end }}
+{{- if .asciiserial }}
+
+# RS485 via adapter (Modbus ASCII)
+modbus: asciiserial
+id: {{ .id }}
+device: {{ .device }} # USB-RS485 Adapter Adresse
+baudrate: {{ .baudrate }} # Prüfe die Geräteeinstellungen, typische Werte sind 9600, 19200, 38400, 57600, 115200
+comset: "{{ .comset }}" # Kommunikationsparameter für den Adapter
+{{- end }}
 {{- if .rs485tcpip }}
 
 # RS485 via TCP/IP (Modbus RTU)
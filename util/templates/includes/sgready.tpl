@@ -0,0 +1,8 @@
+{{ define "sgready" }}
+{{- if .minruntime }}
+minruntime: {{ .minruntime }}
+{{- end }}
+{{- if .minlocktime }}
+minlocktime: {{ .minlocktime }}
+{{- end }}
+{{- end }}
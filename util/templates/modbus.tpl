@@ -5,14 +5,29 @@ id: {{ .id }}
 device: {{ .device }}
 baudrate: {{ .baudrate }}
 comset: {{ .comset }}
+{{- else if or (eq .modbus "asciiserial") .asciiserial }}
+# RS485 via adapter (Modbus ASCII)
+device: {{ .device }}
+baudrate: {{ .baudrate }}
+comset: {{ .comset }}
+ascii: true
 {{- else if or (eq .modbus "rs485tcpip") .rs485tcpip }}
 # RS485 via TCP/IP (Modbus RTU)
 uri: {{ .host }}:{{ .port }}
 rtu: true
+{{- else if and (or (eq .modbus "tcpip") .tcpip) (eq (printf "%v" .solarmanv5) "true") }}
+# Solarman logger (SolarmanV5)
+uri: {{ .host }}:{{ if (ne .port "502") }}{{ .port }}{{ else }}8899{{ end }}
+solarmanv5:
+  loggerserial: {{ .loggerserial }}
 {{- else if or (eq .modbus "tcpip") .tcpip }}
 # Modbus TCP
 uri: {{ .host }}:{{ .port }}
 rtu: false
+{{- else if and (or (eq .modbus "udp") .udp) (eq (printf "%v" .aa55) "true") }}
+# GoodWe UDP (AA55)
+uri: {{ .host }}:{{ if (ne .port "502") }}{{ .port }}{{ else }}8899{{ end }}
+aa55: true
 {{- else if or (eq .modbus "udp") .udp }}
 # Modbus UDP
 uri: {{ .host }}:{{ if (ne .port "502") }}{{ .port }}{{ else }}8899{{ end }}
@@ -22,4 +37,7 @@ rtu: true
 # configuration error - should not happen
 modbusConnectionTypeNotDefined: {{ .modbus }}
 {{- end }}
+{{- if .ratelimit }}
+ratelimit: {{ .ratelimit }}
+{{- end }}
 {{- end }}
@@ -49,6 +49,8 @@ func TestClass(t *testing.T, class Class, instantiate func(t *testing.T, values
 				values[ModbusKeyTCPIP] = true
 			} else if slices.Contains(modbusChoices, ModbusChoiceUDP) {
 				values[ModbusKeyUDP] = true
+			} else if !slices.Contains(modbusChoices, ModbusChoiceRS485) {
+				values[ModbusKeyASCIISerial] = true
 			} else {
 				values[ModbusKeyRS485TCPIP] = true
 			}
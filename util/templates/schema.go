@@ -0,0 +1,159 @@
+package templates
+
+import (
+	"slices"
+	"strconv"
+)
+
+// Schema returns the JSON schema of a device configuration of the given class.
+// The schema accepts any of the given plugin types or any of the class's templates with their parameters.
+func Schema(class Class, types []string, lang string) map[string]any {
+	types = slices.DeleteFunc(slices.Clone(types), func(s string) bool {
+		return s == "template"
+	})
+
+	oneOf := []any{
+		map[string]any{
+			"description": "Plugin type",
+			"properties": map[string]any{
+				"type": map[string]any{"enum": types},
+			},
+		},
+	}
+
+	for _, t := range ByClass(class) {
+		oneOf = append(oneOf, t.schema(lang))
+	}
+
+	return map[string]any{
+		"type":     "object",
+		"required": []string{"type"},
+		"oneOf":    oneOf,
+	}
+}
+
+// schema returns the JSON schema of the template's parameters
+func (t *Template) schema(lang string) map[string]any {
+	props := map[string]any{
+		"type":     map[string]any{"const": "template"},
+		"template": map[string]any{"const": t.Template},
+	}
+
+	required := []string{"template"}
+
+	for _, p := range t.Params {
+		if p.IsDeprecated() {
+			continue
+		}
+
+		if p.Name == ParamModbus {
+			for k, v := range t.modbusSchema(lang) {
+				props[k] = v
+			}
+			continue
+		}
+
+		props[p.Name] = p.schema(lang)
+
+		if p.IsRequired() {
+			required = append(required, p.Name)
+		}
+	}
+
+	res := map[string]any{
+		"properties": props,
+		"required":   required,
+	}
+
+	if title := t.Title(); title != "" {
+		res["title"] = title
+	}
+
+	return res
+}
+
+// modbusSchema returns the JSON schema of the modbus interface and its parameters
+func (t *Template) modbusSchema(lang string) map[string]any {
+	var interfaces []string
+	for _, choice := range t.ModbusChoices() {
+		interfaces = append(interfaces, ConfigDefaults.Modbus.Interfaces[choice]...)
+	}
+
+	res := map[string]any{
+		ParamModbus: map[string]any{"enum": interfaces},
+	}
+
+	for _, iface := range interfaces {
+		for _, p := range ConfigDefaults.Modbus.Types[iface].Params {
+			res[p.Name] = p.schema(lang)
+		}
+	}
+
+	return res
+}
+
+// schema returns the JSON schema of the parameter value
+func (p *Param) schema(lang string) map[string]any {
+	res := make(map[string]any)
+
+	switch p.Type {
+	case TypeBool:
+		res["type"] = "boolean"
+	case TypeInt:
+		res["type"] = "integer"
+	case TypeFloat:
+		res["type"] = "number"
+	case TypeDuration:
+		res["$ref"] = "#/definitions/duration"
+	case TypeList:
+		res["type"] = "array"
+		res["items"] = map[string]any{"type": "string"}
+	case TypeChargeModes:
+		res["$ref"] = "#/definitions/mode"
+	default:
+		res["type"] = "string"
+	}
+
+	if len(p.Choice) > 0 && p.Type != TypeList {
+		res["enum"] = p.Choice
+	}
+
+	if s := p.Description.String(lang); s != "" {
+		res["description"] = s
+	}
+
+	if p.Default != "" {
+		res["default"] = p.typedDefault()
+	}
+
+	if p.Example != "" {
+		res["examples"] = []string{p.Example}
+	}
+
+	return res
+}
+
+// typedDefault returns the default value as JSON value of the parameter's type
+func (p *Param) typedDefault() any {
+	var (
+		res any
+		err error
+	)
+
+	switch p.Type {
+	case TypeBool:
+		res, err = strconv.ParseBool(p.Default)
+	case TypeInt:
+		res, err = strconv.ParseInt(p.Default, 10, 64)
+	case TypeFloat:
+		res, err = strconv.ParseFloat(p.Default, 64)
+	default:
+		return p.Default
+	}
+
+	if err != nil {
+		return p.Default
+	}
+
+	return res
+}
@@ -0,0 +1,44 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamSchema(t *testing.T) {
+	tc := []struct {
+		param Param
+		res   map[string]any
+	}{
+		{Param{Type: TypeInt, Default: "1"}, map[string]any{"type": "integer", "default": int64(1)}},
+		{Param{Type: TypeFloat, Default: "0.5"}, map[string]any{"type": "number", "default": 0.5}},
+		{Param{Type: TypeBool}, map[string]any{"type": "boolean"}},
+		{Param{Type: TypeBool, Default: "true"}, map[string]any{"type": "boolean", "default": true}},
+		{Param{Type: TypeDuration, Default: "10s"}, map[string]any{"$ref": "#/definitions/duration", "default": "10s"}},
+		{Param{Type: TypeDuration}, map[string]any{"$ref": "#/definitions/duration"}},
+		{Param{Choice: []string{"grid", "pv"}}, map[string]any{"type": "string", "enum": []string{"grid", "pv"}}},
+		{Param{Type: TypeList}, map[string]any{"type": "array", "items": map[string]any{"type": "string"}}},
+		{Param{Example: "foo", Description: TextLanguage{Generic: "Foo"}}, map[string]any{"type": "string", "description": "Foo", "examples": []string{"foo"}}},
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.res, tc.param.schema("en"))
+	}
+}
+
+func TestTemplateSchema(t *testing.T) {
+	tmpl := Template{
+		TemplateDefinition: TemplateDefinition{
+			Template: "demo",
+			Params: []Param{
+				{Name: "host", Required: true},
+				{Name: "legacy", Deprecated: true},
+			},
+		},
+	}
+
+	res := tmpl.schema("en")
+	assert.Equal(t, []string{"template", "host"}, res["required"])
+	assert.NotContains(t, res["properties"], "legacy")
+}
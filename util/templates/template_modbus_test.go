@@ -0,0 +1,51 @@
+package templates
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/evcc-io/evcc/util/modbus"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestModbusTemplateASCII(t *testing.T) {
+	tmpl, err := template.New("test").Parse(modbusTmpl + `{{ template "modbus" . }}`)
+	require.NoError(t, err)
+
+	out := new(bytes.Buffer)
+	require.NoError(t, tmpl.Execute(out, map[string]any{
+		ParamModbus:             ModbusKeyASCIISerial,
+		ModbusParamNameId:       1,
+		ModbusParamNameDevice:   "/dev/ttyUSB0",
+		ModbusParamNameBaudrate: 9600,
+		ModbusParamNameComset:   "8N1",
+	}))
+
+	var cc modbus.Settings
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &cc))
+	require.Equal(t, modbus.Ascii, cc.Protocol())
+	require.Equal(t, "/dev/ttyUSB0", cc.Device)
+}
+
+func TestModbusTemplateSolarmanV5(t *testing.T) {
+	tmpl, err := template.New("test").Parse(modbusTmpl + `{{ template "modbus" . }}`)
+	require.NoError(t, err)
+
+	out := new(bytes.Buffer)
+	require.NoError(t, tmpl.Execute(out, map[string]any{
+		ParamModbus:         ModbusKeyTCPIP,
+		ModbusParamNameId:   1,
+		ModbusParamNameHost: "192.0.2.2",
+		ModbusParamNamePort: "502",
+		"solarmanv5":        "true",
+		"loggerserial":      "2712345678",
+	}))
+
+	var cc modbus.Settings
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &cc))
+	require.Equal(t, modbus.SolarmanV5, cc.Protocol())
+	require.Equal(t, "192.0.2.2:8899", cc.URI)
+	require.EqualValues(t, 2712345678, cc.SolarmanV5.LoggerSerial)
+}
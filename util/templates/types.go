@@ -20,10 +20,12 @@ const (
 	ModbusChoiceRS485    = "rs485"
 	ModbusChoiceTCPIP    = "tcpip"
 	ModbusChoiceUDP      = "udp"
+	ModbusChoiceASCII    = "ascii"
 	ModbusKeyRS485Serial = "rs485serial"
 	ModbusKeyRS485TCPIP  = "rs485tcpip"
 	ModbusKeyTCPIP       = "tcpip"
 	ModbusKeyUDP         = "udp"
+	ModbusKeyASCIISerial = "asciiserial"
 
 	ModbusParamNameId       = "id"
 	ModbusParamNameDevice   = "device"
@@ -33,6 +35,7 @@ const (
 	ModbusParamNameHost     = "host"
 	ModbusParamNamePort     = "port"
 	ModbusParamNameRTU      = "rtu"
+	ModbusParamNameASCII    = "ascii"
 )
 
 const (
@@ -41,7 +44,7 @@ const (
 	RenderModeInstance
 )
 
-var ValidModbusChoices = []string{ModbusChoiceRS485, ModbusChoiceTCPIP, ModbusChoiceUDP}
+var ValidModbusChoices = []string{ModbusChoiceRS485, ModbusChoiceTCPIP, ModbusChoiceUDP, ModbusChoiceASCII}
 
 const (
 	CapabilityISO151182      = "iso151182"       // ISO 15118-2 support
@@ -66,8 +69,8 @@ var ValidRequirements = []string{RequirementEEBUS, RequirementMQTT, RequirementS
 var predefinedTemplateProperties = []string{
 	"type", "template", "name",
 	ModbusParamNameId, ModbusParamNameDevice, ModbusParamNameBaudrate, ModbusParamNameComset,
-	ModbusParamNameURI, ModbusParamNameHost, ModbusParamNamePort, ModbusParamNameRTU,
-	ModbusKeyTCPIP, ModbusKeyUDP, ModbusKeyRS485Serial, ModbusKeyRS485TCPIP,
+	ModbusParamNameURI, ModbusParamNameHost, ModbusParamNamePort, ModbusParamNameRTU, ModbusParamNameASCII,
+	ModbusKeyTCPIP, ModbusKeyUDP, ModbusKeyRS485Serial, ModbusKeyRS485TCPIP, ModbusKeyASCIISerial,
 }
 
 // TextLanguage contains language-specific texts